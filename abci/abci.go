@@ -2,30 +2,57 @@ package abci
 
 import (
 	"context"
+	"fmt"
+
 	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/fatal-fruit/auction/keeper"
+	auctiontypes "github.com/fatal-fruit/auction/types"
 )
 
 func EndBlocker(ctx context.Context, k keeper.Keeper, log log.Logger) error {
 	logger := log
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	// Transitions are shared across the queues, in the order they are processed below. Part
+	// of the budget is reserved for the Pending and Cancelled queues.
+	budget := keeper.NewBlockBudget(params.MaxTransitionsPerBlock, params.ReservedTransitionsPerBlock)
+
 	logger.Info("EndBlocker :: Processing Active Auctions")
-	err := k.ProcessActiveAuctions(ctx)
+	err = k.ProcessActiveAuctions(ctx, budget)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("EndBlocker :: Processing Reveal Auctions")
+	err = k.ProcessRevealAuctions(ctx, budget)
 	if err != nil {
 		return err
 	}
 
 	logger.Info("EndBlocker :: Processing Expired Auctions")
-	err = k.ProcessExpiredAuctions(ctx)
+	err = k.ProcessExpiredAuctions(ctx, budget)
 	if err != nil {
 		return err
 	}
 
-	logger.Info("EndBlocker :: Calculating Pending Auctions")
-	err = k.GetPending(ctx)
+	logger.Info("EndBlocker :: Processing Pending Auctions")
+	err = k.ProcessPendingAuctions(ctx, budget)
 	if err != nil {
 		return err
 	}
 
+	logger.Info("EndBlocker :: Processing Cancelled Auctions")
+	err = k.ProcessCancelledAuctions(ctx, budget)
+	if err != nil {
+		return err
+	}
+
+	telemetry.ModuleSetGauge(auctiontypes.ModuleName, float32(budget.Deferred()), "transition_backlog")
+	logger.Info(fmt.Sprintf("EndBlocker :: Auctions deferred to a later block: %d", budget.Deferred()))
+
 	logger.Info("EndBlocker :: Done")
 
 	return nil
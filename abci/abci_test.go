@@ -1,12 +1,19 @@
 package abci
 
 import (
+	"context"
+	"cosmossdk.io/collections"
 	"cosmossdk.io/log"
+	"fmt"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/gogoproto/proto"
 	at "github.com/fatal-fruit/auction/auctiontypes"
 	auctiontestutil "github.com/fatal-fruit/auction/testutil"
 	auctiontypes "github.com/fatal-fruit/auction/types"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 	"testing"
 	"time"
 )
@@ -26,18 +33,22 @@ func TestEndBlocker_ActiveToCancelled(t *testing.T) {
 			ReservePrice: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000),
 			StartTime:    time.Now().Add(-30 * time.Second),
 			EndTime:      time.Now().Add(-1 * time.Second),
-			Bids:         []*auctiontypes.Bid{},
-			Strategy: &at.SettleStrategy{
+			SettlementStrategy: codectypes.UnsafePackAny(&at.SettleStrategy{
 				StrategyType:          auctiontypes.SETTLE,
 				EscrowContractId:      1,
 				EscrowContractAddress: f.Addrs[2].String(),
-			},
+			}),
 		},
 	}
 	err = f.K.Auctions.Set(f.Ctx, id, &auction)
 	require.NoError(err)
 	err = f.K.ActiveAuctions.Set(f.Ctx, id)
 	require.NoError(err)
+	err = f.K.ActiveAuctionsByEndTime.Set(f.Ctx, collections.Join(auction.GetEndTime(), id))
+	require.NoError(err)
+
+	// The cancelled auction's escrow is released to the owner in the same block
+	f.MockEscrowService.EXPECT().Release(f.Ctx, uint64(1), f.Addrs[2], f.Addrs[0]).Return(nil).Times(1)
 
 	logger := log.NewNopLogger()
 	err = EndBlocker(f.Ctx, f.K, logger)
@@ -57,7 +68,16 @@ func TestEndBlocker_ActiveToCancelled(t *testing.T) {
 
 	inCancelled, err := f.K.CancelledAuctions.Has(f.Ctx, id)
 	require.NoError(err)
-	require.True(inCancelled)
+	require.False(inCancelled)
+
+	cancelled, err := f.K.Auctions.Get(f.Ctx, id)
+	require.NoError(err)
+	require.Equal(auctiontypes.CANCELLED, cancelled.GetStatus())
+
+	require.Equal([]proto.Message{
+		&auctiontypes.EventAuctionExpired{AuctionId: id, AuctionType: f.ReserveAuctionType, Owner: f.Addrs[0].String()},
+		&auctiontypes.EventAuctionCancelled{AuctionId: id, AuctionType: f.ReserveAuctionType, Owner: f.Addrs[0].String(), Reason: auctiontypes.CancelReasonNoBids},
+	}, typedEvents(t, f.Ctx))
 }
 
 func TestEndBlocker_ActiveToPending(t *testing.T) {
@@ -76,25 +96,26 @@ func TestEndBlocker_ActiveToPending(t *testing.T) {
 			StartTime:    time.Now().Add(-30 * time.Second),
 			EndTime:      time.Now().Add(-1 * time.Second),
 			LastPrice:    sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100),
-			Bids: []*auctiontypes.Bid{
-				{
-					AuctionId: id,
-					Bidder:    f.Addrs[1].String(),
-					BidPrice:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100),
-					Timestamp: time.Now(),
-				},
+			HighestBid: &auctiontypes.Bid{
+				AuctionId: id,
+				Bidder:    f.Addrs[1].String(),
+				BidPrice:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100),
+				Timestamp: time.Now(),
 			},
-			Strategy: &at.SettleStrategy{
+			NumBids: 1,
+			SettlementStrategy: codectypes.UnsafePackAny(&at.SettleStrategy{
 				StrategyType:          auctiontypes.SETTLE,
 				EscrowContractId:      1,
 				EscrowContractAddress: f.Addrs[2].String(),
-			},
+			}),
 		},
 	}
 	err = f.K.Auctions.Set(f.Ctx, id, &auction)
 	require.NoError(err)
 	err = f.K.ActiveAuctions.Set(f.Ctx, id)
 	require.NoError(err)
+	err = f.K.ActiveAuctionsByEndTime.Set(f.Ctx, collections.Join(auction.GetEndTime(), id))
+	require.NoError(err)
 
 	logger := log.NewNopLogger()
 	err = EndBlocker(f.Ctx, f.K, logger)
@@ -115,4 +136,473 @@ func TestEndBlocker_ActiveToPending(t *testing.T) {
 	inCancelled, err := f.K.CancelledAuctions.Has(f.Ctx, id)
 	require.NoError(err)
 	require.False(inCancelled)
+
+	require.Equal([]proto.Message{
+		&auctiontypes.EventAuctionExpired{AuctionId: id, AuctionType: f.ReserveAuctionType, Owner: f.Addrs[0].String()},
+		&auctiontypes.EventAuctionPending{AuctionId: id, AuctionType: f.ReserveAuctionType, Owner: f.Addrs[0].String(), NumBids: 1},
+	}, typedEvents(t, f.Ctx))
+}
+
+func TestEndBlocker_PendingToCancelled(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	require := require.New(t)
+
+	params, err := f.K.Params.Get(f.Ctx)
+	require.NoError(err)
+
+	endTimes := []time.Time{
+		// Ended within the execution window
+		f.Ctx.BlockTime().Add(-1 * time.Second),
+		// Ended before the execution window
+		f.Ctx.BlockTime().Add(-params.ExecutionDuration - time.Second),
+	}
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("uasset", 1))
+	bidPrice := sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100)
+	var ids []uint64
+	for _, end := range endTimes {
+		id, err := f.K.IDs.Next(f.Ctx)
+		require.NoError(err)
+		bid := auctiontypes.Bid{
+			AuctionId: id,
+			Bidder:    f.Addrs[1].String(),
+			BidPrice:  bidPrice,
+			Timestamp: end.Add(-1 * time.Second),
+		}
+		auction := at.ReserveAuction{
+			Id:          id,
+			Status:      auctiontypes.PENDING,
+			Owner:       f.Addrs[0].String(),
+			AuctionType: f.ReserveAuctionType,
+			Deposit:     deposit,
+			Metadata: &at.ReserveAuctionMetadata{
+				ReservePrice: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000),
+				StartTime:    end.Add(-30 * time.Second),
+				EndTime:      end,
+				LastPrice:    bidPrice,
+				HighestBid:   &bid,
+				NumBids:      1,
+				SettlementStrategy: codectypes.UnsafePackAny(&at.SettleStrategy{
+					StrategyType:          auctiontypes.SETTLE,
+					EscrowContractId:      id,
+					EscrowContractAddress: f.Addrs[2].String(),
+				}),
+			},
+		}
+		require.NoError(f.K.Auctions.Set(f.Ctx, id, &auction))
+		require.NoError(f.K.Bids.Set(f.Ctx, collections.Join(id, uint64(0)), bid))
+		require.NoError(f.K.PendingAuctions.Set(f.Ctx, id))
+		ids = append(ids, id)
+	}
+
+	// The timed out auction refunds its bid from escrow and its deposit to the owner
+	f.MockBankKeeper.EXPECT().SendCoins(f.Ctx, f.Addrs[2], f.Addrs[1], sdk.Coins{bidPrice}).Return(nil).Times(1)
+	f.MockEscrowService.EXPECT().Release(f.Ctx, ids[1], f.Addrs[2], f.Addrs[0]).Return(nil).Times(1)
+	f.MockBankKeeper.EXPECT().SendCoinsFromModuleToAccount(f.Ctx, auctiontypes.ModuleName, f.Addrs[0], deposit).Return(nil).Times(1)
+
+	err = EndBlocker(f.Ctx, f.K, log.NewNopLogger())
+	require.NoError(err)
+
+	inPending, err := f.K.PendingAuctions.Has(f.Ctx, ids[0])
+	require.NoError(err)
+	require.True(inPending)
+
+	inPending, err = f.K.PendingAuctions.Has(f.Ctx, ids[1])
+	require.NoError(err)
+	require.False(inPending)
+
+	// Timed out auctions leave the cancelled queue once their funds are returned
+	inCancelled, err := f.K.CancelledAuctions.Has(f.Ctx, ids[1])
+	require.NoError(err)
+	require.False(inCancelled)
+
+	cancelled, err := f.K.Auctions.Get(f.Ctx, ids[1])
+	require.NoError(err)
+	require.Equal(auctiontypes.CANCELLED, cancelled.GetStatus())
+
+	require.Equal([]proto.Message{
+		&auctiontypes.EventAuctionCancelled{AuctionId: ids[1], AuctionType: f.ReserveAuctionType, Owner: f.Addrs[0].String(), Reason: auctiontypes.CancelReasonExecutionTimeout},
+		&auctiontypes.EventFundsRefunded{AuctionId: ids[1], AuctionType: f.ReserveAuctionType, Owner: f.Addrs[0].String(), Recipient: f.Addrs[1].String(), Amount: sdk.Coins{bidPrice}},
+		&auctiontypes.EventFundsRefunded{AuctionId: ids[1], AuctionType: f.ReserveAuctionType, Owner: f.Addrs[0].String(), Recipient: f.Addrs[0].String(), Amount: deposit},
+	}, typedEvents(t, f.Ctx))
+}
+
+func TestEndBlocker_PendingExecutionDeadline(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	require := require.New(t)
+
+	params, err := f.K.Params.Get(f.Ctx)
+	require.NoError(err)
+
+	id, err := f.K.IDs.Next(f.Ctx)
+	require.NoError(err)
+	// A buy-it-now auction closes before its end time
+	end := f.Ctx.BlockTime().Add(time.Hour)
+	auction := at.ReserveAuction{
+		Id:          id,
+		Status:      auctiontypes.ACTIVE,
+		Owner:       f.Addrs[0].String(),
+		AuctionType: f.ReserveAuctionType,
+		Metadata: &at.ReserveAuctionMetadata{
+			ReservePrice: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000),
+			StartTime:    f.Ctx.BlockTime(),
+			EndTime:      end,
+			SettlementStrategy: codectypes.UnsafePackAny(&at.SettleStrategy{
+				StrategyType:          auctiontypes.SETTLE,
+				EscrowContractId:      id,
+				EscrowContractAddress: f.Addrs[2].String(),
+			}),
+		},
+	}
+	require.NoError(f.K.Auctions.Set(f.Ctx, id, &auction))
+	require.NoError(f.K.ActiveAuctions.Set(f.Ctx, id))
+	require.NoError(f.K.CloseAuction(f.Ctx, &auction))
+
+	deadline, err := f.K.ExecutionDeadlines.Get(f.Ctx, id)
+	require.NoError(err)
+	require.Equal(f.Ctx.BlockTime().Add(params.ExecutionDuration), deadline)
+
+	// The window runs from when the auction became pending, not from its end time
+	ctx := f.Ctx.WithBlockTime(deadline)
+	require.NoError(EndBlocker(ctx, f.K, log.NewNopLogger()))
+
+	inPending, err := f.K.PendingAuctions.Has(ctx, id)
+	require.NoError(err)
+	require.True(inPending)
+
+	ctx = f.Ctx.WithBlockTime(deadline.Add(time.Second))
+	f.MockEscrowService.EXPECT().Release(ctx, id, f.Addrs[2], f.Addrs[0]).Return(nil).Times(1)
+	require.NoError(EndBlocker(ctx, f.K, log.NewNopLogger()))
+
+	cancelled, err := f.K.Auctions.Get(ctx, id)
+	require.NoError(err)
+	require.Equal(auctiontypes.CANCELLED, cancelled.GetStatus())
+
+	hasDeadline, err := f.K.ExecutionDeadlines.Has(ctx, id)
+	require.NoError(err)
+	require.False(hasDeadline)
+}
+
+func TestEndBlocker_ExtendedAuctionStaysActive(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	require := require.New(t)
+
+	id, err := f.K.IDs.Next(f.Ctx)
+	require.NoError(err)
+	end := f.Ctx.BlockTime().Add(30 * time.Second)
+	auction := at.ReserveAuction{
+		Id:          id,
+		Status:      auctiontypes.ACTIVE,
+		Owner:       f.Addrs[0].String(),
+		AuctionType: f.ReserveAuctionType,
+		Metadata: &at.ReserveAuctionMetadata{
+			ReservePrice:      sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000),
+			StartTime:         end.Add(-5 * time.Minute),
+			EndTime:           end,
+			ExtensionWindow:   time.Minute,
+			ExtensionDuration: 2 * time.Minute,
+		},
+	}
+	// A bid inside the extension window moves the end time forward
+	require.NoError(auction.SubmitBid(f.Ctx.BlockTime(), &auctiontypes.MsgNewBid{
+		AuctionId: id,
+		Owner:     f.Addrs[1].String(),
+		BidAmount: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100),
+	}))
+	require.NoError(f.K.Auctions.Set(f.Ctx, id, &auction))
+	require.NoError(f.K.ActiveAuctions.Set(f.Ctx, id))
+	require.NoError(f.K.ActiveAuctionsByEndTime.Set(f.Ctx, collections.Join(auction.GetEndTime(), id)))
+
+	// The original end time has passed but the extended one has not
+	ctx := f.Ctx.WithBlockTime(end.Add(time.Second))
+	require.NoError(EndBlocker(ctx, f.K, log.NewNopLogger()))
+
+	inActive, err := f.K.ActiveAuctions.Has(ctx, id)
+	require.NoError(err)
+	require.True(inActive)
+
+	ctx = f.Ctx.WithBlockTime(end.Add(2*time.Minute + time.Second))
+	require.NoError(EndBlocker(ctx, f.K, log.NewNopLogger()))
+
+	inActive, err = f.K.ActiveAuctions.Has(ctx, id)
+	require.NoError(err)
+	require.False(inActive)
+}
+
+func TestEndBlocker_TransitionBudget(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	require := require.New(t)
+
+	params, err := f.K.Params.Get(f.Ctx)
+	require.NoError(err)
+	// Two transitions are left for auctions ending, the other two are reserved
+	params.MaxTransitionsPerBlock = 4
+	params.ReservedTransitionsPerBlock = 2
+	require.NoError(f.K.Params.Set(f.Ctx, params))
+
+	// Auctions with bids move from Active to Expired, then to Pending, one step per transition
+	blockTime := f.Ctx.BlockTime()
+	for _, ago := range []time.Duration{time.Second, 3 * time.Second, 2 * time.Second} {
+		id, err := f.K.IDs.Next(f.Ctx)
+		require.NoError(err)
+		auction := at.ReserveAuction{
+			Id:          id,
+			Status:      auctiontypes.ACTIVE,
+			Owner:       f.Addrs[0].String(),
+			AuctionType: f.ReserveAuctionType,
+			Metadata: &at.ReserveAuctionMetadata{
+				ReservePrice: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000),
+				StartTime:    blockTime.Add(-time.Minute),
+				EndTime:      blockTime.Add(-ago),
+				HighestBid: &auctiontypes.Bid{
+					AuctionId: id,
+					Bidder:    f.Addrs[1].String(),
+					BidPrice:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100),
+				},
+				NumBids: 1,
+			},
+		}
+		require.NoError(f.K.Auctions.Set(f.Ctx, id, &auction))
+		require.NoError(f.K.ActiveAuctions.Set(f.Ctx, id))
+		require.NoError(f.K.ActiveAuctionsByEndTime.Set(f.Ctx, collections.Join(auction.GetEndTime(), id)))
+	}
+
+	// Each block spends its budget in queue order, earliest end time first for active auctions
+	// and by ID for the other queues
+	for i, tc := range []struct {
+		active  []uint64
+		expired []uint64
+		pending []uint64
+	}{
+		{active: []uint64{0}, expired: []uint64{1, 2}},
+		{expired: []uint64{1, 2}, pending: []uint64{0}},
+		{pending: []uint64{0, 1, 2}},
+	} {
+		ctx := f.Ctx.WithBlockTime(blockTime.Add(time.Duration(i) * time.Second))
+		require.NoError(EndBlocker(ctx, f.K, log.NewNopLogger()))
+
+		for _, q := range []struct {
+			ids  []uint64
+			keys collections.KeySet[uint64]
+		}{
+			{tc.active, f.K.ActiveAuctions},
+			{tc.expired, f.K.ExpiredAuctions},
+			{tc.pending, f.K.PendingAuctions},
+		} {
+			var ids []uint64
+			require.NoError(q.keys.Walk(ctx, nil, func(id uint64) (stop bool, err error) {
+				ids = append(ids, id)
+				return false, nil
+			}))
+			require.Equal(q.ids, ids, "block %d", i)
+		}
+	}
+}
+
+func TestEndBlocker_TransitionBudgetReserve(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	require := require.New(t)
+
+	params, err := f.K.Params.Get(f.Ctx)
+	require.NoError(err)
+	params.MaxTransitionsPerBlock = 2
+	params.ReservedTransitionsPerBlock = 1
+	require.NoError(f.K.Params.Set(f.Ctx, params))
+
+	blockTime := f.Ctx.BlockTime()
+	newAuction := func(status auctiontypes.AuctionStatus, numBids uint64) *at.ReserveAuction {
+		id, err := f.K.IDs.Next(f.Ctx)
+		require.NoError(err)
+		return &at.ReserveAuction{
+			Id:          id,
+			Status:      status,
+			Owner:       f.Addrs[0].String(),
+			AuctionType: f.ReserveAuctionType,
+			Metadata: &at.ReserveAuctionMetadata{
+				ReservePrice: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000),
+				StartTime:    blockTime.Add(-time.Minute),
+				EndTime:      blockTime.Add(-time.Second),
+				NumBids:      numBids,
+				SettlementStrategy: codectypes.UnsafePackAny(&at.SettleStrategy{
+					StrategyType:          auctiontypes.SETTLE,
+					EscrowContractId:      id,
+					EscrowContractAddress: f.Addrs[2].String(),
+				}),
+			},
+		}
+	}
+
+	// A backlog of ending auctions is larger than the whole budget
+	for i := 0; i < 3; i++ {
+		auction := newAuction(auctiontypes.ACTIVE, 1)
+		require.NoError(f.K.Auctions.Set(f.Ctx, auction.Id, auction))
+		require.NoError(f.K.ActiveAuctions.Set(f.Ctx, auction.Id))
+		require.NoError(f.K.ActiveAuctionsByEndTime.Set(f.Ctx, collections.Join(auction.GetEndTime(), auction.Id)))
+	}
+	cancelled := newAuction(auctiontypes.CANCELLED, 0)
+	require.NoError(f.K.Auctions.Set(f.Ctx, cancelled.Id, cancelled))
+	require.NoError(f.K.CancelledAuctions.Set(f.Ctx, cancelled.Id))
+
+	// The cancelled auction is refunded from the reserved share
+	f.MockEscrowService.EXPECT().Release(gomock.Any(), cancelled.Id, f.Addrs[2], f.Addrs[0]).Return(nil).Times(1)
+	require.NoError(EndBlocker(f.Ctx, f.K, log.NewNopLogger()))
+
+	isCancelled, err := f.K.CancelledAuctions.Has(f.Ctx, cancelled.Id)
+	require.NoError(err)
+	require.False(isCancelled)
+
+	var active []uint64
+	require.NoError(f.K.ActiveAuctions.Walk(f.Ctx, nil, func(id uint64) (stop bool, err error) {
+		active = append(active, id)
+		return false, nil
+	}))
+	require.Len(active, 2)
+}
+
+func TestEndBlocker_AutoExecute(t *testing.T) {
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("uasset", 1))
+	testCases := []struct {
+		name      string
+		setupMock func(f *auctiontestutil.TestFixture)
+		expStatus auctiontypes.AuctionStatus
+		expError  string
+	}{
+		{
+			name: "executed",
+			setupMock: func(f *auctiontestutil.TestFixture) {
+				f.MockBankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), auctiontypes.ModuleName, f.Addrs[1], deposit).Return(nil).Times(1)
+			},
+			expStatus: auctiontypes.SETTLED,
+		},
+		{
+			name: "execution fails",
+			setupMock: func(f *auctiontestutil.TestFixture) {
+				f.MockBankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), auctiontypes.ModuleName, f.Addrs[1], deposit).Return(fmt.Errorf("insufficient funds")).Times(1)
+			},
+			expStatus: auctiontypes.PENDING,
+			expError:  "insufficient funds",
+		},
+		{
+			name: "execution panics",
+			setupMock: func(f *auctiontestutil.TestFixture) {
+				f.MockBankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), auctiontypes.ModuleName, f.Addrs[1], deposit).DoAndReturn(
+					func(context.Context, string, sdk.AccAddress, sdk.Coins) error { panic("out of funds") }).Times(1)
+			},
+			expStatus: auctiontypes.PENDING,
+			expError:  "auction 0 panicked during execution: out of funds",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := auctiontestutil.InitFixture(t)
+			require := require.New(t)
+
+			bidPrice := sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100)
+			id := setAutoExecuteAuction(t, f, deposit, bidPrice)
+
+			// The winning bid is paid to the owner before the deposit is delivered
+			f.MockBankKeeper.EXPECT().SendCoins(gomock.Any(), f.Addrs[2], f.Addrs[0], sdk.Coins{bidPrice}).Return(nil).Times(1)
+			tc.setupMock(f)
+
+			require.NoError(EndBlocker(f.Ctx, f.K, log.NewNopLogger()))
+
+			auction, err := f.K.Auctions.Get(f.Ctx, id)
+			require.NoError(err)
+			require.Equal(tc.expStatus, auction.GetStatus())
+
+			inPending, err := f.K.PendingAuctions.Has(f.Ctx, id)
+			require.NoError(err)
+			require.Equal(tc.expStatus == auctiontypes.PENDING, inPending)
+
+			events := []proto.Message{
+				&auctiontypes.EventAuctionPending{AuctionId: id, AuctionType: f.ReserveAuctionType, Owner: f.Addrs[0].String(), NumBids: 1},
+			}
+			if tc.expError == "" {
+				events = append(events, &auctiontypes.EventAuctionExecuted{AuctionId: id, AuctionType: f.ReserveAuctionType, Owner: f.Addrs[0].String(), Executor: authtypes.NewModuleAddress(auctiontypes.ModuleName).String(), Deposit: deposit})
+			} else {
+				// Failed auctions wait for MsgExecAuction instead of being retried
+				require.False(auction.GetAutoExecute())
+				events = append(events, &auctiontypes.EventAutoExecuteFailed{AuctionId: id, AuctionType: f.ReserveAuctionType, Owner: f.Addrs[0].String(), Error: tc.expError})
+			}
+			require.Equal(events, typedEvents(t, f.Ctx))
+		})
+	}
+}
+
+func TestEndBlocker_AutoExecuteBudget(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	require := require.New(t)
+
+	params, err := f.K.Params.Get(f.Ctx)
+	require.NoError(err)
+	params.MaxTransitionsPerBlock = 3
+	params.ReservedTransitionsPerBlock = 1
+	require.NoError(f.K.Params.Set(f.Ctx, params))
+
+	bidPrice := sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100)
+	first := setAutoExecuteAuction(t, f, sdk.NewCoins(), bidPrice)
+	second := setAutoExecuteAuction(t, f, sdk.NewCoins(), bidPrice)
+
+	// Moving both auctions to pending leaves the first block enough budget to execute one
+	f.MockBankKeeper.EXPECT().SendCoins(gomock.Any(), f.Addrs[2], f.Addrs[0], sdk.Coins{bidPrice}).Return(nil).Times(1)
+	require.NoError(EndBlocker(f.Ctx, f.K, log.NewNopLogger()))
+	for id, status := range map[uint64]auctiontypes.AuctionStatus{first: auctiontypes.SETTLED, second: auctiontypes.PENDING} {
+		auction, err := f.K.Auctions.Get(f.Ctx, id)
+		require.NoError(err)
+		require.Equal(status, auction.GetStatus())
+	}
+
+	f.MockBankKeeper.EXPECT().SendCoins(gomock.Any(), f.Addrs[2], f.Addrs[0], sdk.Coins{bidPrice}).Return(nil).Times(1)
+	require.NoError(EndBlocker(f.Ctx, f.K, log.NewNopLogger()))
+	auction, err := f.K.Auctions.Get(f.Ctx, second)
+	require.NoError(err)
+	require.Equal(auctiontypes.SETTLED, auction.GetStatus())
+}
+
+// setAutoExecuteAuction stores an expired auction with auto execution enabled and a single
+// bid, escrowed with f.Addrs[2].
+func setAutoExecuteAuction(t *testing.T, f *auctiontestutil.TestFixture, deposit sdk.Coins, bidPrice sdk.Coin) uint64 {
+	id, err := f.K.IDs.Next(f.Ctx)
+	require.NoError(t, err)
+	bid := auctiontypes.Bid{
+		AuctionId: id,
+		Bidder:    f.Addrs[1].String(),
+		BidPrice:  bidPrice,
+	}
+	auction := at.ReserveAuction{
+		Id:          id,
+		Status:      auctiontypes.EXPIRED,
+		Owner:       f.Addrs[0].String(),
+		AuctionType: f.ReserveAuctionType,
+		Deposit:     deposit,
+		Metadata: &at.ReserveAuctionMetadata{
+			ReservePrice: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000),
+			StartTime:    f.Ctx.BlockTime().Add(-30 * time.Second),
+			EndTime:      f.Ctx.BlockTime().Add(-1 * time.Second),
+			LastPrice:    bidPrice,
+			HighestBid:   &bid,
+			NumBids:      1,
+			SettlementStrategy: codectypes.UnsafePackAny(&at.SettleStrategy{
+				StrategyType:          auctiontypes.SETTLE,
+				EscrowContractId:      id,
+				EscrowContractAddress: f.Addrs[2].String(),
+			}),
+			AutoExecute: true,
+		},
+	}
+	require.NoError(t, f.K.Auctions.Set(f.Ctx, id, &auction))
+	require.NoError(t, f.K.Bids.Set(f.Ctx, collections.Join(id, uint64(0)), bid))
+	require.NoError(t, f.K.ExpiredAuctions.Set(f.Ctx, id))
+	return id
+}
+
+func typedEvents(t *testing.T, ctx sdk.Context) []proto.Message {
+	var msgs []proto.Message
+	for _, e := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(e)
+		require.NoError(t, err)
+		msgs = append(msgs, msg)
+	}
+	return msgs
 }
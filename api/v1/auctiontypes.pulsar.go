@@ -11,7 +11,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	_ "google.golang.org/protobuf/types/known/anypb"
+	anypb "google.golang.org/protobuf/types/known/anypb"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
@@ -71,14 +71,27 @@ func (x *_ReserveAuctionMetadata_9_list) IsValid() bool {
 }
 
 var (
-	md_ReserveAuctionMetadata               protoreflect.MessageDescriptor
-	fd_ReserveAuctionMetadata_duration      protoreflect.FieldDescriptor
-	fd_ReserveAuctionMetadata_start_time    protoreflect.FieldDescriptor
-	fd_ReserveAuctionMetadata_end_time      protoreflect.FieldDescriptor
-	fd_ReserveAuctionMetadata_reserve_price protoreflect.FieldDescriptor
-	fd_ReserveAuctionMetadata_bids          protoreflect.FieldDescriptor
-	fd_ReserveAuctionMetadata_last_price    protoreflect.FieldDescriptor
-	fd_ReserveAuctionMetadata_strategy      protoreflect.FieldDescriptor
+	md_ReserveAuctionMetadata                     protoreflect.MessageDescriptor
+	fd_ReserveAuctionMetadata_duration            protoreflect.FieldDescriptor
+	fd_ReserveAuctionMetadata_start_time          protoreflect.FieldDescriptor
+	fd_ReserveAuctionMetadata_end_time            protoreflect.FieldDescriptor
+	fd_ReserveAuctionMetadata_reserve_price       protoreflect.FieldDescriptor
+	fd_ReserveAuctionMetadata_bids                protoreflect.FieldDescriptor
+	fd_ReserveAuctionMetadata_last_price          protoreflect.FieldDescriptor
+	fd_ReserveAuctionMetadata_strategy            protoreflect.FieldDescriptor
+	fd_ReserveAuctionMetadata_refund_on_outbid    protoreflect.FieldDescriptor
+	fd_ReserveAuctionMetadata_highest_bid         protoreflect.FieldDescriptor
+	fd_ReserveAuctionMetadata_num_bids            protoreflect.FieldDescriptor
+	fd_ReserveAuctionMetadata_strategy_type       protoreflect.FieldDescriptor
+	fd_ReserveAuctionMetadata_settlement_strategy protoreflect.FieldDescriptor
+	fd_ReserveAuctionMetadata_extension_window    protoreflect.FieldDescriptor
+	fd_ReserveAuctionMetadata_extension_duration  protoreflect.FieldDescriptor
+	fd_ReserveAuctionMetadata_max_extensions      protoreflect.FieldDescriptor
+	fd_ReserveAuctionMetadata_num_extensions      protoreflect.FieldDescriptor
+	fd_ReserveAuctionMetadata_buy_now_price       protoreflect.FieldDescriptor
+	fd_ReserveAuctionMetadata_min_increment       protoreflect.FieldDescriptor
+	fd_ReserveAuctionMetadata_min_increment_bps   protoreflect.FieldDescriptor
+	fd_ReserveAuctionMetadata_auto_execute        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -91,6 +104,19 @@ func init() {
 	fd_ReserveAuctionMetadata_bids = md_ReserveAuctionMetadata.Fields().ByName("bids")
 	fd_ReserveAuctionMetadata_last_price = md_ReserveAuctionMetadata.Fields().ByName("last_price")
 	fd_ReserveAuctionMetadata_strategy = md_ReserveAuctionMetadata.Fields().ByName("strategy")
+	fd_ReserveAuctionMetadata_refund_on_outbid = md_ReserveAuctionMetadata.Fields().ByName("refund_on_outbid")
+	fd_ReserveAuctionMetadata_highest_bid = md_ReserveAuctionMetadata.Fields().ByName("highest_bid")
+	fd_ReserveAuctionMetadata_num_bids = md_ReserveAuctionMetadata.Fields().ByName("num_bids")
+	fd_ReserveAuctionMetadata_strategy_type = md_ReserveAuctionMetadata.Fields().ByName("strategy_type")
+	fd_ReserveAuctionMetadata_settlement_strategy = md_ReserveAuctionMetadata.Fields().ByName("settlement_strategy")
+	fd_ReserveAuctionMetadata_extension_window = md_ReserveAuctionMetadata.Fields().ByName("extension_window")
+	fd_ReserveAuctionMetadata_extension_duration = md_ReserveAuctionMetadata.Fields().ByName("extension_duration")
+	fd_ReserveAuctionMetadata_max_extensions = md_ReserveAuctionMetadata.Fields().ByName("max_extensions")
+	fd_ReserveAuctionMetadata_num_extensions = md_ReserveAuctionMetadata.Fields().ByName("num_extensions")
+	fd_ReserveAuctionMetadata_buy_now_price = md_ReserveAuctionMetadata.Fields().ByName("buy_now_price")
+	fd_ReserveAuctionMetadata_min_increment = md_ReserveAuctionMetadata.Fields().ByName("min_increment")
+	fd_ReserveAuctionMetadata_min_increment_bps = md_ReserveAuctionMetadata.Fields().ByName("min_increment_bps")
+	fd_ReserveAuctionMetadata_auto_execute = md_ReserveAuctionMetadata.Fields().ByName("auto_execute")
 }
 
 var _ protoreflect.Message = (*fastReflection_ReserveAuctionMetadata)(nil)
@@ -200,6 +226,84 @@ func (x *fastReflection_ReserveAuctionMetadata) Range(f func(protoreflect.FieldD
 			return
 		}
 	}
+	if x.RefundOnOutbid != false {
+		value := protoreflect.ValueOfBool(x.RefundOnOutbid)
+		if !f(fd_ReserveAuctionMetadata_refund_on_outbid, value) {
+			return
+		}
+	}
+	if x.HighestBid != nil {
+		value := protoreflect.ValueOfMessage(x.HighestBid.ProtoReflect())
+		if !f(fd_ReserveAuctionMetadata_highest_bid, value) {
+			return
+		}
+	}
+	if x.NumBids != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NumBids)
+		if !f(fd_ReserveAuctionMetadata_num_bids, value) {
+			return
+		}
+	}
+	if x.StrategyType != "" {
+		value := protoreflect.ValueOfString(x.StrategyType)
+		if !f(fd_ReserveAuctionMetadata_strategy_type, value) {
+			return
+		}
+	}
+	if x.SettlementStrategy != nil {
+		value := protoreflect.ValueOfMessage(x.SettlementStrategy.ProtoReflect())
+		if !f(fd_ReserveAuctionMetadata_settlement_strategy, value) {
+			return
+		}
+	}
+	if x.ExtensionWindow != nil {
+		value := protoreflect.ValueOfMessage(x.ExtensionWindow.ProtoReflect())
+		if !f(fd_ReserveAuctionMetadata_extension_window, value) {
+			return
+		}
+	}
+	if x.ExtensionDuration != nil {
+		value := protoreflect.ValueOfMessage(x.ExtensionDuration.ProtoReflect())
+		if !f(fd_ReserveAuctionMetadata_extension_duration, value) {
+			return
+		}
+	}
+	if x.MaxExtensions != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxExtensions)
+		if !f(fd_ReserveAuctionMetadata_max_extensions, value) {
+			return
+		}
+	}
+	if x.NumExtensions != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.NumExtensions)
+		if !f(fd_ReserveAuctionMetadata_num_extensions, value) {
+			return
+		}
+	}
+	if x.BuyNowPrice != nil {
+		value := protoreflect.ValueOfMessage(x.BuyNowPrice.ProtoReflect())
+		if !f(fd_ReserveAuctionMetadata_buy_now_price, value) {
+			return
+		}
+	}
+	if x.MinIncrement != nil {
+		value := protoreflect.ValueOfMessage(x.MinIncrement.ProtoReflect())
+		if !f(fd_ReserveAuctionMetadata_min_increment, value) {
+			return
+		}
+	}
+	if x.MinIncrementBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MinIncrementBps)
+		if !f(fd_ReserveAuctionMetadata_min_increment_bps, value) {
+			return
+		}
+	}
+	if x.AutoExecute != false {
+		value := protoreflect.ValueOfBool(x.AutoExecute)
+		if !f(fd_ReserveAuctionMetadata_auto_execute, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -229,6 +333,32 @@ func (x *fastReflection_ReserveAuctionMetadata) Has(fd protoreflect.FieldDescrip
 		return x.LastPrice != nil
 	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.strategy":
 		return x.Strategy != nil
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.refund_on_outbid":
+		return x.RefundOnOutbid != false
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.highest_bid":
+		return x.HighestBid != nil
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.num_bids":
+		return x.NumBids != uint64(0)
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.strategy_type":
+		return x.StrategyType != ""
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.settlement_strategy":
+		return x.SettlementStrategy != nil
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.extension_window":
+		return x.ExtensionWindow != nil
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.extension_duration":
+		return x.ExtensionDuration != nil
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.max_extensions":
+		return x.MaxExtensions != uint32(0)
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.num_extensions":
+		return x.NumExtensions != uint32(0)
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.buy_now_price":
+		return x.BuyNowPrice != nil
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.min_increment":
+		return x.MinIncrement != nil
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.min_increment_bps":
+		return x.MinIncrementBps != uint32(0)
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.auto_execute":
+		return x.AutoExecute != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReserveAuctionMetadata"))
@@ -259,6 +389,32 @@ func (x *fastReflection_ReserveAuctionMetadata) Clear(fd protoreflect.FieldDescr
 		x.LastPrice = nil
 	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.strategy":
 		x.Strategy = nil
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.refund_on_outbid":
+		x.RefundOnOutbid = false
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.highest_bid":
+		x.HighestBid = nil
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.num_bids":
+		x.NumBids = uint64(0)
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.strategy_type":
+		x.StrategyType = ""
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.settlement_strategy":
+		x.SettlementStrategy = nil
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.extension_window":
+		x.ExtensionWindow = nil
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.extension_duration":
+		x.ExtensionDuration = nil
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.max_extensions":
+		x.MaxExtensions = uint32(0)
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.num_extensions":
+		x.NumExtensions = uint32(0)
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.buy_now_price":
+		x.BuyNowPrice = nil
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.min_increment":
+		x.MinIncrement = nil
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.min_increment_bps":
+		x.MinIncrementBps = uint32(0)
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.auto_execute":
+		x.AutoExecute = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReserveAuctionMetadata"))
@@ -299,6 +455,45 @@ func (x *fastReflection_ReserveAuctionMetadata) Get(descriptor protoreflect.Fiel
 	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.strategy":
 		value := x.Strategy
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.refund_on_outbid":
+		value := x.RefundOnOutbid
+		return protoreflect.ValueOfBool(value)
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.highest_bid":
+		value := x.HighestBid
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.num_bids":
+		value := x.NumBids
+		return protoreflect.ValueOfUint64(value)
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.strategy_type":
+		value := x.StrategyType
+		return protoreflect.ValueOfString(value)
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.settlement_strategy":
+		value := x.SettlementStrategy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.extension_window":
+		value := x.ExtensionWindow
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.extension_duration":
+		value := x.ExtensionDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.max_extensions":
+		value := x.MaxExtensions
+		return protoreflect.ValueOfUint32(value)
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.num_extensions":
+		value := x.NumExtensions
+		return protoreflect.ValueOfUint32(value)
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.buy_now_price":
+		value := x.BuyNowPrice
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.min_increment":
+		value := x.MinIncrement
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.min_increment_bps":
+		value := x.MinIncrementBps
+		return protoreflect.ValueOfUint32(value)
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.auto_execute":
+		value := x.AutoExecute
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReserveAuctionMetadata"))
@@ -335,6 +530,32 @@ func (x *fastReflection_ReserveAuctionMetadata) Set(fd protoreflect.FieldDescrip
 		x.LastPrice = value.Message().Interface().(*v1beta1.Coin)
 	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.strategy":
 		x.Strategy = value.Message().Interface().(*SettleStrategy)
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.refund_on_outbid":
+		x.RefundOnOutbid = value.Bool()
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.highest_bid":
+		x.HighestBid = value.Message().Interface().(*Bid)
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.num_bids":
+		x.NumBids = value.Uint()
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.strategy_type":
+		x.StrategyType = value.Interface().(string)
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.settlement_strategy":
+		x.SettlementStrategy = value.Message().Interface().(*anypb.Any)
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.extension_window":
+		x.ExtensionWindow = value.Message().Interface().(*durationpb.Duration)
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.extension_duration":
+		x.ExtensionDuration = value.Message().Interface().(*durationpb.Duration)
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.max_extensions":
+		x.MaxExtensions = uint32(value.Uint())
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.num_extensions":
+		x.NumExtensions = uint32(value.Uint())
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.buy_now_price":
+		x.BuyNowPrice = value.Message().Interface().(*v1beta1.Coin)
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.min_increment":
+		x.MinIncrement = value.Message().Interface().(*v1beta1.Coin)
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.min_increment_bps":
+		x.MinIncrementBps = uint32(value.Uint())
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.auto_execute":
+		x.AutoExecute = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReserveAuctionMetadata"))
@@ -391,6 +612,50 @@ func (x *fastReflection_ReserveAuctionMetadata) Mutable(fd protoreflect.FieldDes
 			x.Strategy = new(SettleStrategy)
 		}
 		return protoreflect.ValueOfMessage(x.Strategy.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.highest_bid":
+		if x.HighestBid == nil {
+			x.HighestBid = new(Bid)
+		}
+		return protoreflect.ValueOfMessage(x.HighestBid.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.settlement_strategy":
+		if x.SettlementStrategy == nil {
+			x.SettlementStrategy = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.SettlementStrategy.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.extension_window":
+		if x.ExtensionWindow == nil {
+			x.ExtensionWindow = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.ExtensionWindow.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.extension_duration":
+		if x.ExtensionDuration == nil {
+			x.ExtensionDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.ExtensionDuration.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.buy_now_price":
+		if x.BuyNowPrice == nil {
+			x.BuyNowPrice = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.BuyNowPrice.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.min_increment":
+		if x.MinIncrement == nil {
+			x.MinIncrement = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.MinIncrement.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.refund_on_outbid":
+		panic(fmt.Errorf("field refund_on_outbid of message fatal_fruit.auction.v1.ReserveAuctionMetadata is not mutable"))
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.num_bids":
+		panic(fmt.Errorf("field num_bids of message fatal_fruit.auction.v1.ReserveAuctionMetadata is not mutable"))
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.strategy_type":
+		panic(fmt.Errorf("field strategy_type of message fatal_fruit.auction.v1.ReserveAuctionMetadata is not mutable"))
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.max_extensions":
+		panic(fmt.Errorf("field max_extensions of message fatal_fruit.auction.v1.ReserveAuctionMetadata is not mutable"))
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.num_extensions":
+		panic(fmt.Errorf("field num_extensions of message fatal_fruit.auction.v1.ReserveAuctionMetadata is not mutable"))
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.min_increment_bps":
+		panic(fmt.Errorf("field min_increment_bps of message fatal_fruit.auction.v1.ReserveAuctionMetadata is not mutable"))
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.auto_execute":
+		panic(fmt.Errorf("field auto_execute of message fatal_fruit.auction.v1.ReserveAuctionMetadata is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReserveAuctionMetadata"))
@@ -425,6 +690,38 @@ func (x *fastReflection_ReserveAuctionMetadata) NewField(fd protoreflect.FieldDe
 	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.strategy":
 		m := new(SettleStrategy)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.refund_on_outbid":
+		return protoreflect.ValueOfBool(false)
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.highest_bid":
+		m := new(Bid)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.num_bids":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.strategy_type":
+		return protoreflect.ValueOfString("")
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.settlement_strategy":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.extension_window":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.extension_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.max_extensions":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.num_extensions":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.buy_now_price":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.min_increment":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.min_increment_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "fatal_fruit.auction.v1.ReserveAuctionMetadata.auto_execute":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReserveAuctionMetadata"))
@@ -524,6 +821,52 @@ func (x *fastReflection_ReserveAuctionMetadata) ProtoMethods() *protoiface.Metho
 			l = options.Size(x.Strategy)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.RefundOnOutbid {
+			n += 2
+		}
+		if x.HighestBid != nil {
+			l = options.Size(x.HighestBid)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NumBids != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumBids))
+		}
+		l = len(x.StrategyType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SettlementStrategy != nil {
+			l = options.Size(x.SettlementStrategy)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExtensionWindow != nil {
+			l = options.Size(x.ExtensionWindow)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExtensionDuration != nil {
+			l = options.Size(x.ExtensionDuration)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxExtensions != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxExtensions))
+		}
+		if x.NumExtensions != 0 {
+			n += 2 + runtime.Sov(uint64(x.NumExtensions))
+		}
+		if x.BuyNowPrice != nil {
+			l = options.Size(x.BuyNowPrice)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.MinIncrement != nil {
+			l = options.Size(x.MinIncrement)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.MinIncrementBps != 0 {
+			n += 2 + runtime.Sov(uint64(x.MinIncrementBps))
+		}
+		if x.AutoExecute {
+			n += 3
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -553,6 +896,155 @@ func (x *fastReflection_ReserveAuctionMetadata) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.AutoExecute {
+			i--
+			if x.AutoExecute {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc0
+		}
+		if x.MinIncrementBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinIncrementBps))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb8
+		}
+		if x.MinIncrement != nil {
+			encoded, err := options.Marshal(x.MinIncrement)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+		if x.BuyNowPrice != nil {
+			encoded, err := options.Marshal(x.BuyNowPrice)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+		if x.NumExtensions != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumExtensions))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
+		}
+		if x.MaxExtensions != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxExtensions))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x98
+		}
+		if x.ExtensionDuration != nil {
+			encoded, err := options.Marshal(x.ExtensionDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if x.ExtensionWindow != nil {
+			encoded, err := options.Marshal(x.ExtensionWindow)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if x.SettlementStrategy != nil {
+			encoded, err := options.Marshal(x.SettlementStrategy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if len(x.StrategyType) > 0 {
+			i -= len(x.StrategyType)
+			copy(dAtA[i:], x.StrategyType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StrategyType)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if x.NumBids != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumBids))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.HighestBid != nil {
+			encoded, err := options.Marshal(x.HighestBid)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.RefundOnOutbid {
+			i--
+			if x.RefundOnOutbid {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x60
+		}
 		if x.Strategy != nil {
 			encoded, err := options.Marshal(x.Strategy)
 			if err != nil {
@@ -952,1460 +1444,13130 @@ func (x *fastReflection_ReserveAuctionMetadata) ProtoMethods() *protoiface.Metho
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RefundOnOutbid", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RefundOnOutbid = bool(v != 0)
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HighestBid", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if x.HighestBid == nil {
+					x.HighestBid = &Bid{}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_ReserveAuction              protoreflect.MessageDescriptor
-	fd_ReserveAuction_id           protoreflect.FieldDescriptor
-	fd_ReserveAuction_status       protoreflect.FieldDescriptor
-	fd_ReserveAuction_owner        protoreflect.FieldDescriptor
-	fd_ReserveAuction_auction_type protoreflect.FieldDescriptor
-	fd_ReserveAuction_metadata     protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_fatal_fruit_auction_v1_auctiontypes_proto_init()
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.HighestBid); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumBids", wireType)
+				}
+				x.NumBids = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumBids |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StrategyType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StrategyType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SettlementStrategy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SettlementStrategy == nil {
+					x.SettlementStrategy = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SettlementStrategy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExtensionWindow", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExtensionWindow == nil {
+					x.ExtensionWindow = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExtensionWindow); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExtensionDuration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExtensionDuration == nil {
+					x.ExtensionDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExtensionDuration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 19:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxExtensions", wireType)
+				}
+				x.MaxExtensions = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxExtensions |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 20:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumExtensions", wireType)
+				}
+				x.NumExtensions = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumExtensions |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 21:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BuyNowPrice", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BuyNowPrice == nil {
+					x.BuyNowPrice = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BuyNowPrice); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinIncrement", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MinIncrement == nil {
+					x.MinIncrement = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinIncrement); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 23:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinIncrementBps", wireType)
+				}
+				x.MinIncrementBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinIncrementBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 24:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoExecute", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AutoExecute = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ReserveAuction_6_list)(nil)
+
+type _ReserveAuction_6_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_ReserveAuction_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ReserveAuction_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ReserveAuction_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ReserveAuction_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ReserveAuction_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ReserveAuction_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ReserveAuction_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ReserveAuction_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ReserveAuction               protoreflect.MessageDescriptor
+	fd_ReserveAuction_id            protoreflect.FieldDescriptor
+	fd_ReserveAuction_legacy_status protoreflect.FieldDescriptor
+	fd_ReserveAuction_owner         protoreflect.FieldDescriptor
+	fd_ReserveAuction_auction_type  protoreflect.FieldDescriptor
+	fd_ReserveAuction_metadata      protoreflect.FieldDescriptor
+	fd_ReserveAuction_deposit       protoreflect.FieldDescriptor
+	fd_ReserveAuction_status        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fatal_fruit_auction_v1_auctiontypes_proto_init()
 	md_ReserveAuction = File_fatal_fruit_auction_v1_auctiontypes_proto.Messages().ByName("ReserveAuction")
 	fd_ReserveAuction_id = md_ReserveAuction.Fields().ByName("id")
-	fd_ReserveAuction_status = md_ReserveAuction.Fields().ByName("status")
+	fd_ReserveAuction_legacy_status = md_ReserveAuction.Fields().ByName("legacy_status")
 	fd_ReserveAuction_owner = md_ReserveAuction.Fields().ByName("owner")
 	fd_ReserveAuction_auction_type = md_ReserveAuction.Fields().ByName("auction_type")
 	fd_ReserveAuction_metadata = md_ReserveAuction.Fields().ByName("metadata")
+	fd_ReserveAuction_deposit = md_ReserveAuction.Fields().ByName("deposit")
+	fd_ReserveAuction_status = md_ReserveAuction.Fields().ByName("status")
+}
+
+var _ protoreflect.Message = (*fastReflection_ReserveAuction)(nil)
+
+type fastReflection_ReserveAuction ReserveAuction
+
+func (x *ReserveAuction) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ReserveAuction)(x)
+}
+
+func (x *ReserveAuction) slowProtoReflect() protoreflect.Message {
+	mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ReserveAuction_messageType fastReflection_ReserveAuction_messageType
+var _ protoreflect.MessageType = fastReflection_ReserveAuction_messageType{}
+
+type fastReflection_ReserveAuction_messageType struct{}
+
+func (x fastReflection_ReserveAuction_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ReserveAuction)(nil)
+}
+func (x fastReflection_ReserveAuction_messageType) New() protoreflect.Message {
+	return new(fastReflection_ReserveAuction)
+}
+func (x fastReflection_ReserveAuction_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReserveAuction
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ReserveAuction) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReserveAuction
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ReserveAuction) Type() protoreflect.MessageType {
+	return _fastReflection_ReserveAuction_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ReserveAuction) New() protoreflect.Message {
+	return new(fastReflection_ReserveAuction)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ReserveAuction) Interface() protoreflect.ProtoMessage {
+	return (*ReserveAuction)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ReserveAuction) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_ReserveAuction_id, value) {
+			return
+		}
+	}
+	if x.LegacyStatus != "" {
+		value := protoreflect.ValueOfString(x.LegacyStatus)
+		if !f(fd_ReserveAuction_legacy_status, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_ReserveAuction_owner, value) {
+			return
+		}
+	}
+	if x.AuctionType != "" {
+		value := protoreflect.ValueOfString(x.AuctionType)
+		if !f(fd_ReserveAuction_auction_type, value) {
+			return
+		}
+	}
+	if x.Metadata != nil {
+		value := protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
+		if !f(fd_ReserveAuction_metadata, value) {
+			return
+		}
+	}
+	if len(x.Deposit) != 0 {
+		value := protoreflect.ValueOfList(&_ReserveAuction_6_list{list: &x.Deposit})
+		if !f(fd_ReserveAuction_deposit, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_ReserveAuction_status, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ReserveAuction) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.ReserveAuction.id":
+		return x.Id != uint64(0)
+	case "fatal_fruit.auction.v1.ReserveAuction.legacy_status":
+		return x.LegacyStatus != ""
+	case "fatal_fruit.auction.v1.ReserveAuction.owner":
+		return x.Owner != ""
+	case "fatal_fruit.auction.v1.ReserveAuction.auction_type":
+		return x.AuctionType != ""
+	case "fatal_fruit.auction.v1.ReserveAuction.metadata":
+		return x.Metadata != nil
+	case "fatal_fruit.auction.v1.ReserveAuction.deposit":
+		return len(x.Deposit) != 0
+	case "fatal_fruit.auction.v1.ReserveAuction.status":
+		return x.Status != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReserveAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.ReserveAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReserveAuction) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.ReserveAuction.id":
+		x.Id = uint64(0)
+	case "fatal_fruit.auction.v1.ReserveAuction.legacy_status":
+		x.LegacyStatus = ""
+	case "fatal_fruit.auction.v1.ReserveAuction.owner":
+		x.Owner = ""
+	case "fatal_fruit.auction.v1.ReserveAuction.auction_type":
+		x.AuctionType = ""
+	case "fatal_fruit.auction.v1.ReserveAuction.metadata":
+		x.Metadata = nil
+	case "fatal_fruit.auction.v1.ReserveAuction.deposit":
+		x.Deposit = nil
+	case "fatal_fruit.auction.v1.ReserveAuction.status":
+		x.Status = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReserveAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.ReserveAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ReserveAuction) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fatal_fruit.auction.v1.ReserveAuction.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "fatal_fruit.auction.v1.ReserveAuction.legacy_status":
+		value := x.LegacyStatus
+		return protoreflect.ValueOfString(value)
+	case "fatal_fruit.auction.v1.ReserveAuction.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "fatal_fruit.auction.v1.ReserveAuction.auction_type":
+		value := x.AuctionType
+		return protoreflect.ValueOfString(value)
+	case "fatal_fruit.auction.v1.ReserveAuction.metadata":
+		value := x.Metadata
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReserveAuction.deposit":
+		if len(x.Deposit) == 0 {
+			return protoreflect.ValueOfList(&_ReserveAuction_6_list{})
+		}
+		listValue := &_ReserveAuction_6_list{list: &x.Deposit}
+		return protoreflect.ValueOfList(listValue)
+	case "fatal_fruit.auction.v1.ReserveAuction.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReserveAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.ReserveAuction does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReserveAuction) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.ReserveAuction.id":
+		x.Id = value.Uint()
+	case "fatal_fruit.auction.v1.ReserveAuction.legacy_status":
+		x.LegacyStatus = value.Interface().(string)
+	case "fatal_fruit.auction.v1.ReserveAuction.owner":
+		x.Owner = value.Interface().(string)
+	case "fatal_fruit.auction.v1.ReserveAuction.auction_type":
+		x.AuctionType = value.Interface().(string)
+	case "fatal_fruit.auction.v1.ReserveAuction.metadata":
+		x.Metadata = value.Message().Interface().(*ReserveAuctionMetadata)
+	case "fatal_fruit.auction.v1.ReserveAuction.deposit":
+		lv := value.List()
+		clv := lv.(*_ReserveAuction_6_list)
+		x.Deposit = *clv.list
+	case "fatal_fruit.auction.v1.ReserveAuction.status":
+		x.Status = (AuctionStatus)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReserveAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.ReserveAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReserveAuction) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.ReserveAuction.metadata":
+		if x.Metadata == nil {
+			x.Metadata = new(ReserveAuctionMetadata)
+		}
+		return protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReserveAuction.deposit":
+		if x.Deposit == nil {
+			x.Deposit = []*v1beta1.Coin{}
+		}
+		value := &_ReserveAuction_6_list{list: &x.Deposit}
+		return protoreflect.ValueOfList(value)
+	case "fatal_fruit.auction.v1.ReserveAuction.id":
+		panic(fmt.Errorf("field id of message fatal_fruit.auction.v1.ReserveAuction is not mutable"))
+	case "fatal_fruit.auction.v1.ReserveAuction.legacy_status":
+		panic(fmt.Errorf("field legacy_status of message fatal_fruit.auction.v1.ReserveAuction is not mutable"))
+	case "fatal_fruit.auction.v1.ReserveAuction.owner":
+		panic(fmt.Errorf("field owner of message fatal_fruit.auction.v1.ReserveAuction is not mutable"))
+	case "fatal_fruit.auction.v1.ReserveAuction.auction_type":
+		panic(fmt.Errorf("field auction_type of message fatal_fruit.auction.v1.ReserveAuction is not mutable"))
+	case "fatal_fruit.auction.v1.ReserveAuction.status":
+		panic(fmt.Errorf("field status of message fatal_fruit.auction.v1.ReserveAuction is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReserveAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.ReserveAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ReserveAuction) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.ReserveAuction.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fatal_fruit.auction.v1.ReserveAuction.legacy_status":
+		return protoreflect.ValueOfString("")
+	case "fatal_fruit.auction.v1.ReserveAuction.owner":
+		return protoreflect.ValueOfString("")
+	case "fatal_fruit.auction.v1.ReserveAuction.auction_type":
+		return protoreflect.ValueOfString("")
+	case "fatal_fruit.auction.v1.ReserveAuction.metadata":
+		m := new(ReserveAuctionMetadata)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReserveAuction.deposit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_ReserveAuction_6_list{list: &list})
+	case "fatal_fruit.auction.v1.ReserveAuction.status":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReserveAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.ReserveAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ReserveAuction) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fatal_fruit.auction.v1.ReserveAuction", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ReserveAuction) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReserveAuction) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ReserveAuction) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ReserveAuction) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ReserveAuction)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.LegacyStatus)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AuctionType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Metadata != nil {
+			l = options.Size(x.Metadata)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Deposit) > 0 {
+			for _, e := range x.Deposit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ReserveAuction)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.Deposit) > 0 {
+			for iNdEx := len(x.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Deposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.Metadata != nil {
+			encoded, err := options.Marshal(x.Metadata)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.AuctionType) > 0 {
+			i -= len(x.AuctionType)
+			copy(dAtA[i:], x.AuctionType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AuctionType)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.LegacyStatus) > 0 {
+			i -= len(x.LegacyStatus)
+			copy(dAtA[i:], x.LegacyStatus)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LegacyStatus)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ReserveAuction)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReserveAuction: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReserveAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LegacyStatus", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LegacyStatus = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AuctionType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Metadata == nil {
+					x.Metadata = &ReserveAuctionMetadata{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Metadata); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Deposit = append(x.Deposit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Deposit[len(x.Deposit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= AuctionStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SettleStrategy                         protoreflect.MessageDescriptor
+	fd_SettleStrategy_strategy_type           protoreflect.FieldDescriptor
+	fd_SettleStrategy_escrow_contract_id      protoreflect.FieldDescriptor
+	fd_SettleStrategy_escrow_contract_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fatal_fruit_auction_v1_auctiontypes_proto_init()
+	md_SettleStrategy = File_fatal_fruit_auction_v1_auctiontypes_proto.Messages().ByName("SettleStrategy")
+	fd_SettleStrategy_strategy_type = md_SettleStrategy.Fields().ByName("strategy_type")
+	fd_SettleStrategy_escrow_contract_id = md_SettleStrategy.Fields().ByName("escrow_contract_id")
+	fd_SettleStrategy_escrow_contract_address = md_SettleStrategy.Fields().ByName("escrow_contract_address")
+}
+
+var _ protoreflect.Message = (*fastReflection_SettleStrategy)(nil)
+
+type fastReflection_SettleStrategy SettleStrategy
+
+func (x *SettleStrategy) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SettleStrategy)(x)
+}
+
+func (x *SettleStrategy) slowProtoReflect() protoreflect.Message {
+	mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SettleStrategy_messageType fastReflection_SettleStrategy_messageType
+var _ protoreflect.MessageType = fastReflection_SettleStrategy_messageType{}
+
+type fastReflection_SettleStrategy_messageType struct{}
+
+func (x fastReflection_SettleStrategy_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SettleStrategy)(nil)
+}
+func (x fastReflection_SettleStrategy_messageType) New() protoreflect.Message {
+	return new(fastReflection_SettleStrategy)
+}
+func (x fastReflection_SettleStrategy_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SettleStrategy
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SettleStrategy) Descriptor() protoreflect.MessageDescriptor {
+	return md_SettleStrategy
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SettleStrategy) Type() protoreflect.MessageType {
+	return _fastReflection_SettleStrategy_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SettleStrategy) New() protoreflect.Message {
+	return new(fastReflection_SettleStrategy)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SettleStrategy) Interface() protoreflect.ProtoMessage {
+	return (*SettleStrategy)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SettleStrategy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StrategyType != "" {
+		value := protoreflect.ValueOfString(x.StrategyType)
+		if !f(fd_SettleStrategy_strategy_type, value) {
+			return
+		}
+	}
+	if x.EscrowContractId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EscrowContractId)
+		if !f(fd_SettleStrategy_escrow_contract_id, value) {
+			return
+		}
+	}
+	if x.EscrowContractAddress != "" {
+		value := protoreflect.ValueOfString(x.EscrowContractAddress)
+		if !f(fd_SettleStrategy_escrow_contract_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SettleStrategy) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.SettleStrategy.strategy_type":
+		return x.StrategyType != ""
+	case "fatal_fruit.auction.v1.SettleStrategy.escrow_contract_id":
+		return x.EscrowContractId != uint64(0)
+	case "fatal_fruit.auction.v1.SettleStrategy.escrow_contract_address":
+		return x.EscrowContractAddress != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SettleStrategy"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.SettleStrategy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SettleStrategy) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.SettleStrategy.strategy_type":
+		x.StrategyType = ""
+	case "fatal_fruit.auction.v1.SettleStrategy.escrow_contract_id":
+		x.EscrowContractId = uint64(0)
+	case "fatal_fruit.auction.v1.SettleStrategy.escrow_contract_address":
+		x.EscrowContractAddress = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SettleStrategy"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.SettleStrategy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SettleStrategy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fatal_fruit.auction.v1.SettleStrategy.strategy_type":
+		value := x.StrategyType
+		return protoreflect.ValueOfString(value)
+	case "fatal_fruit.auction.v1.SettleStrategy.escrow_contract_id":
+		value := x.EscrowContractId
+		return protoreflect.ValueOfUint64(value)
+	case "fatal_fruit.auction.v1.SettleStrategy.escrow_contract_address":
+		value := x.EscrowContractAddress
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SettleStrategy"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.SettleStrategy does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SettleStrategy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.SettleStrategy.strategy_type":
+		x.StrategyType = value.Interface().(string)
+	case "fatal_fruit.auction.v1.SettleStrategy.escrow_contract_id":
+		x.EscrowContractId = value.Uint()
+	case "fatal_fruit.auction.v1.SettleStrategy.escrow_contract_address":
+		x.EscrowContractAddress = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SettleStrategy"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.SettleStrategy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SettleStrategy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.SettleStrategy.strategy_type":
+		panic(fmt.Errorf("field strategy_type of message fatal_fruit.auction.v1.SettleStrategy is not mutable"))
+	case "fatal_fruit.auction.v1.SettleStrategy.escrow_contract_id":
+		panic(fmt.Errorf("field escrow_contract_id of message fatal_fruit.auction.v1.SettleStrategy is not mutable"))
+	case "fatal_fruit.auction.v1.SettleStrategy.escrow_contract_address":
+		panic(fmt.Errorf("field escrow_contract_address of message fatal_fruit.auction.v1.SettleStrategy is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SettleStrategy"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.SettleStrategy does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SettleStrategy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.SettleStrategy.strategy_type":
+		return protoreflect.ValueOfString("")
+	case "fatal_fruit.auction.v1.SettleStrategy.escrow_contract_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fatal_fruit.auction.v1.SettleStrategy.escrow_contract_address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SettleStrategy"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.SettleStrategy does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SettleStrategy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fatal_fruit.auction.v1.SettleStrategy", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SettleStrategy) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SettleStrategy) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SettleStrategy) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SettleStrategy) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SettleStrategy)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.StrategyType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EscrowContractId != 0 {
+			n += 1 + runtime.Sov(uint64(x.EscrowContractId))
+		}
+		l = len(x.EscrowContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SettleStrategy)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.EscrowContractAddress) > 0 {
+			i -= len(x.EscrowContractAddress)
+			copy(dAtA[i:], x.EscrowContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EscrowContractAddress)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.EscrowContractId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EscrowContractId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.StrategyType) > 0 {
+			i -= len(x.StrategyType)
+			copy(dAtA[i:], x.StrategyType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StrategyType)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SettleStrategy)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SettleStrategy: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SettleStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StrategyType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StrategyType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EscrowContractId", wireType)
+				}
+				x.EscrowContractId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EscrowContractId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EscrowContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EscrowContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DutchAuctionMetadata                     protoreflect.MessageDescriptor
+	fd_DutchAuctionMetadata_duration            protoreflect.FieldDescriptor
+	fd_DutchAuctionMetadata_start_time          protoreflect.FieldDescriptor
+	fd_DutchAuctionMetadata_end_time            protoreflect.FieldDescriptor
+	fd_DutchAuctionMetadata_start_price         protoreflect.FieldDescriptor
+	fd_DutchAuctionMetadata_floor_price         protoreflect.FieldDescriptor
+	fd_DutchAuctionMetadata_schedule            protoreflect.FieldDescriptor
+	fd_DutchAuctionMetadata_decrement           protoreflect.FieldDescriptor
+	fd_DutchAuctionMetadata_step_interval       protoreflect.FieldDescriptor
+	fd_DutchAuctionMetadata_winning_bid         protoreflect.FieldDescriptor
+	fd_DutchAuctionMetadata_strategy            protoreflect.FieldDescriptor
+	fd_DutchAuctionMetadata_settlement_strategy protoreflect.FieldDescriptor
+	fd_DutchAuctionMetadata_auto_execute        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fatal_fruit_auction_v1_auctiontypes_proto_init()
+	md_DutchAuctionMetadata = File_fatal_fruit_auction_v1_auctiontypes_proto.Messages().ByName("DutchAuctionMetadata")
+	fd_DutchAuctionMetadata_duration = md_DutchAuctionMetadata.Fields().ByName("duration")
+	fd_DutchAuctionMetadata_start_time = md_DutchAuctionMetadata.Fields().ByName("start_time")
+	fd_DutchAuctionMetadata_end_time = md_DutchAuctionMetadata.Fields().ByName("end_time")
+	fd_DutchAuctionMetadata_start_price = md_DutchAuctionMetadata.Fields().ByName("start_price")
+	fd_DutchAuctionMetadata_floor_price = md_DutchAuctionMetadata.Fields().ByName("floor_price")
+	fd_DutchAuctionMetadata_schedule = md_DutchAuctionMetadata.Fields().ByName("schedule")
+	fd_DutchAuctionMetadata_decrement = md_DutchAuctionMetadata.Fields().ByName("decrement")
+	fd_DutchAuctionMetadata_step_interval = md_DutchAuctionMetadata.Fields().ByName("step_interval")
+	fd_DutchAuctionMetadata_winning_bid = md_DutchAuctionMetadata.Fields().ByName("winning_bid")
+	fd_DutchAuctionMetadata_strategy = md_DutchAuctionMetadata.Fields().ByName("strategy")
+	fd_DutchAuctionMetadata_settlement_strategy = md_DutchAuctionMetadata.Fields().ByName("settlement_strategy")
+	fd_DutchAuctionMetadata_auto_execute = md_DutchAuctionMetadata.Fields().ByName("auto_execute")
+}
+
+var _ protoreflect.Message = (*fastReflection_DutchAuctionMetadata)(nil)
+
+type fastReflection_DutchAuctionMetadata DutchAuctionMetadata
+
+func (x *DutchAuctionMetadata) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DutchAuctionMetadata)(x)
+}
+
+func (x *DutchAuctionMetadata) slowProtoReflect() protoreflect.Message {
+	mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DutchAuctionMetadata_messageType fastReflection_DutchAuctionMetadata_messageType
+var _ protoreflect.MessageType = fastReflection_DutchAuctionMetadata_messageType{}
+
+type fastReflection_DutchAuctionMetadata_messageType struct{}
+
+func (x fastReflection_DutchAuctionMetadata_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DutchAuctionMetadata)(nil)
+}
+func (x fastReflection_DutchAuctionMetadata_messageType) New() protoreflect.Message {
+	return new(fastReflection_DutchAuctionMetadata)
+}
+func (x fastReflection_DutchAuctionMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DutchAuctionMetadata
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DutchAuctionMetadata) Descriptor() protoreflect.MessageDescriptor {
+	return md_DutchAuctionMetadata
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DutchAuctionMetadata) Type() protoreflect.MessageType {
+	return _fastReflection_DutchAuctionMetadata_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DutchAuctionMetadata) New() protoreflect.Message {
+	return new(fastReflection_DutchAuctionMetadata)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DutchAuctionMetadata) Interface() protoreflect.ProtoMessage {
+	return (*DutchAuctionMetadata)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DutchAuctionMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Duration != nil {
+		value := protoreflect.ValueOfMessage(x.Duration.ProtoReflect())
+		if !f(fd_DutchAuctionMetadata_duration, value) {
+			return
+		}
+	}
+	if x.StartTime != nil {
+		value := protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+		if !f(fd_DutchAuctionMetadata_start_time, value) {
+			return
+		}
+	}
+	if x.EndTime != nil {
+		value := protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+		if !f(fd_DutchAuctionMetadata_end_time, value) {
+			return
+		}
+	}
+	if x.StartPrice != nil {
+		value := protoreflect.ValueOfMessage(x.StartPrice.ProtoReflect())
+		if !f(fd_DutchAuctionMetadata_start_price, value) {
+			return
+		}
+	}
+	if x.FloorPrice != nil {
+		value := protoreflect.ValueOfMessage(x.FloorPrice.ProtoReflect())
+		if !f(fd_DutchAuctionMetadata_floor_price, value) {
+			return
+		}
+	}
+	if x.Schedule != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Schedule))
+		if !f(fd_DutchAuctionMetadata_schedule, value) {
+			return
+		}
+	}
+	if x.Decrement != nil {
+		value := protoreflect.ValueOfMessage(x.Decrement.ProtoReflect())
+		if !f(fd_DutchAuctionMetadata_decrement, value) {
+			return
+		}
+	}
+	if x.StepInterval != nil {
+		value := protoreflect.ValueOfMessage(x.StepInterval.ProtoReflect())
+		if !f(fd_DutchAuctionMetadata_step_interval, value) {
+			return
+		}
+	}
+	if x.WinningBid != nil {
+		value := protoreflect.ValueOfMessage(x.WinningBid.ProtoReflect())
+		if !f(fd_DutchAuctionMetadata_winning_bid, value) {
+			return
+		}
+	}
+	if x.Strategy != nil {
+		value := protoreflect.ValueOfMessage(x.Strategy.ProtoReflect())
+		if !f(fd_DutchAuctionMetadata_strategy, value) {
+			return
+		}
+	}
+	if x.SettlementStrategy != nil {
+		value := protoreflect.ValueOfMessage(x.SettlementStrategy.ProtoReflect())
+		if !f(fd_DutchAuctionMetadata_settlement_strategy, value) {
+			return
+		}
+	}
+	if x.AutoExecute != false {
+		value := protoreflect.ValueOfBool(x.AutoExecute)
+		if !f(fd_DutchAuctionMetadata_auto_execute, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DutchAuctionMetadata) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.duration":
+		return x.Duration != nil
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.start_time":
+		return x.StartTime != nil
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.end_time":
+		return x.EndTime != nil
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.start_price":
+		return x.StartPrice != nil
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.floor_price":
+		return x.FloorPrice != nil
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.schedule":
+		return x.Schedule != 0
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.decrement":
+		return x.Decrement != nil
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.step_interval":
+		return x.StepInterval != nil
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.winning_bid":
+		return x.WinningBid != nil
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.strategy":
+		return x.Strategy != nil
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.settlement_strategy":
+		return x.SettlementStrategy != nil
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.auto_execute":
+		return x.AutoExecute != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.DutchAuctionMetadata"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.DutchAuctionMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DutchAuctionMetadata) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.duration":
+		x.Duration = nil
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.start_time":
+		x.StartTime = nil
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.end_time":
+		x.EndTime = nil
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.start_price":
+		x.StartPrice = nil
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.floor_price":
+		x.FloorPrice = nil
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.schedule":
+		x.Schedule = 0
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.decrement":
+		x.Decrement = nil
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.step_interval":
+		x.StepInterval = nil
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.winning_bid":
+		x.WinningBid = nil
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.strategy":
+		x.Strategy = nil
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.settlement_strategy":
+		x.SettlementStrategy = nil
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.auto_execute":
+		x.AutoExecute = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.DutchAuctionMetadata"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.DutchAuctionMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DutchAuctionMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.duration":
+		value := x.Duration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.start_time":
+		value := x.StartTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.end_time":
+		value := x.EndTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.start_price":
+		value := x.StartPrice
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.floor_price":
+		value := x.FloorPrice
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.schedule":
+		value := x.Schedule
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.decrement":
+		value := x.Decrement
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.step_interval":
+		value := x.StepInterval
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.winning_bid":
+		value := x.WinningBid
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.strategy":
+		value := x.Strategy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.settlement_strategy":
+		value := x.SettlementStrategy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.auto_execute":
+		value := x.AutoExecute
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.DutchAuctionMetadata"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.DutchAuctionMetadata does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DutchAuctionMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.duration":
+		x.Duration = value.Message().Interface().(*durationpb.Duration)
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.start_time":
+		x.StartTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.end_time":
+		x.EndTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.start_price":
+		x.StartPrice = value.Message().Interface().(*v1beta1.Coin)
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.floor_price":
+		x.FloorPrice = value.Message().Interface().(*v1beta1.Coin)
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.schedule":
+		x.Schedule = (DecrementSchedule)(value.Enum())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.decrement":
+		x.Decrement = value.Message().Interface().(*v1beta1.Coin)
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.step_interval":
+		x.StepInterval = value.Message().Interface().(*durationpb.Duration)
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.winning_bid":
+		x.WinningBid = value.Message().Interface().(*Bid)
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.strategy":
+		x.Strategy = value.Message().Interface().(*SettleStrategy)
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.settlement_strategy":
+		x.SettlementStrategy = value.Message().Interface().(*anypb.Any)
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.auto_execute":
+		x.AutoExecute = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.DutchAuctionMetadata"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.DutchAuctionMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DutchAuctionMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.duration":
+		if x.Duration == nil {
+			x.Duration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.Duration.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.start_time":
+		if x.StartTime == nil {
+			x.StartTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.end_time":
+		if x.EndTime == nil {
+			x.EndTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.start_price":
+		if x.StartPrice == nil {
+			x.StartPrice = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.StartPrice.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.floor_price":
+		if x.FloorPrice == nil {
+			x.FloorPrice = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.FloorPrice.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.decrement":
+		if x.Decrement == nil {
+			x.Decrement = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Decrement.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.step_interval":
+		if x.StepInterval == nil {
+			x.StepInterval = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.StepInterval.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.winning_bid":
+		if x.WinningBid == nil {
+			x.WinningBid = new(Bid)
+		}
+		return protoreflect.ValueOfMessage(x.WinningBid.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.strategy":
+		if x.Strategy == nil {
+			x.Strategy = new(SettleStrategy)
+		}
+		return protoreflect.ValueOfMessage(x.Strategy.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.settlement_strategy":
+		if x.SettlementStrategy == nil {
+			x.SettlementStrategy = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.SettlementStrategy.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.schedule":
+		panic(fmt.Errorf("field schedule of message fatal_fruit.auction.v1.DutchAuctionMetadata is not mutable"))
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.auto_execute":
+		panic(fmt.Errorf("field auto_execute of message fatal_fruit.auction.v1.DutchAuctionMetadata is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.DutchAuctionMetadata"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.DutchAuctionMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DutchAuctionMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.start_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.end_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.start_price":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.floor_price":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.schedule":
+		return protoreflect.ValueOfEnum(0)
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.decrement":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.step_interval":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.winning_bid":
+		m := new(Bid)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.strategy":
+		m := new(SettleStrategy)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.settlement_strategy":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuctionMetadata.auto_execute":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.DutchAuctionMetadata"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.DutchAuctionMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DutchAuctionMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fatal_fruit.auction.v1.DutchAuctionMetadata", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DutchAuctionMetadata) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DutchAuctionMetadata) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DutchAuctionMetadata) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DutchAuctionMetadata) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DutchAuctionMetadata)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Duration != nil {
+			l = options.Size(x.Duration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StartTime != nil {
+			l = options.Size(x.StartTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EndTime != nil {
+			l = options.Size(x.EndTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StartPrice != nil {
+			l = options.Size(x.StartPrice)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FloorPrice != nil {
+			l = options.Size(x.FloorPrice)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Schedule != 0 {
+			n += 1 + runtime.Sov(uint64(x.Schedule))
+		}
+		if x.Decrement != nil {
+			l = options.Size(x.Decrement)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StepInterval != nil {
+			l = options.Size(x.StepInterval)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.WinningBid != nil {
+			l = options.Size(x.WinningBid)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Strategy != nil {
+			l = options.Size(x.Strategy)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SettlementStrategy != nil {
+			l = options.Size(x.SettlementStrategy)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AutoExecute {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DutchAuctionMetadata)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AutoExecute {
+			i--
+			if x.AutoExecute {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.SettlementStrategy != nil {
+			encoded, err := options.Marshal(x.SettlementStrategy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.Strategy != nil {
+			encoded, err := options.Marshal(x.Strategy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.WinningBid != nil {
+			encoded, err := options.Marshal(x.WinningBid)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.StepInterval != nil {
+			encoded, err := options.Marshal(x.StepInterval)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.Decrement != nil {
+			encoded, err := options.Marshal(x.Decrement)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Schedule != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Schedule))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.FloorPrice != nil {
+			encoded, err := options.Marshal(x.FloorPrice)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.StartPrice != nil {
+			encoded, err := options.Marshal(x.StartPrice)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.EndTime != nil {
+			encoded, err := options.Marshal(x.EndTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.StartTime != nil {
+			encoded, err := options.Marshal(x.StartTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Duration != nil {
+			encoded, err := options.Marshal(x.Duration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DutchAuctionMetadata)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DutchAuctionMetadata: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DutchAuctionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Duration == nil {
+					x.Duration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Duration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StartTime == nil {
+					x.StartTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StartTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EndTime == nil {
+					x.EndTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EndTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StartPrice == nil {
+					x.StartPrice = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StartPrice); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FloorPrice", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FloorPrice == nil {
+					x.FloorPrice = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FloorPrice); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+				}
+				x.Schedule = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Schedule |= DecrementSchedule(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Decrement", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Decrement == nil {
+					x.Decrement = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Decrement); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StepInterval", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StepInterval == nil {
+					x.StepInterval = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StepInterval); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WinningBid", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.WinningBid == nil {
+					x.WinningBid = &Bid{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.WinningBid); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Strategy == nil {
+					x.Strategy = &SettleStrategy{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Strategy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SettlementStrategy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SettlementStrategy == nil {
+					x.SettlementStrategy = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SettlementStrategy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoExecute", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AutoExecute = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_DutchAuction_6_list)(nil)
+
+type _DutchAuction_6_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_DutchAuction_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DutchAuction_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_DutchAuction_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DutchAuction_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DutchAuction_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DutchAuction_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DutchAuction_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DutchAuction_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_DutchAuction               protoreflect.MessageDescriptor
+	fd_DutchAuction_id            protoreflect.FieldDescriptor
+	fd_DutchAuction_legacy_status protoreflect.FieldDescriptor
+	fd_DutchAuction_owner         protoreflect.FieldDescriptor
+	fd_DutchAuction_auction_type  protoreflect.FieldDescriptor
+	fd_DutchAuction_metadata      protoreflect.FieldDescriptor
+	fd_DutchAuction_deposit       protoreflect.FieldDescriptor
+	fd_DutchAuction_status        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fatal_fruit_auction_v1_auctiontypes_proto_init()
+	md_DutchAuction = File_fatal_fruit_auction_v1_auctiontypes_proto.Messages().ByName("DutchAuction")
+	fd_DutchAuction_id = md_DutchAuction.Fields().ByName("id")
+	fd_DutchAuction_legacy_status = md_DutchAuction.Fields().ByName("legacy_status")
+	fd_DutchAuction_owner = md_DutchAuction.Fields().ByName("owner")
+	fd_DutchAuction_auction_type = md_DutchAuction.Fields().ByName("auction_type")
+	fd_DutchAuction_metadata = md_DutchAuction.Fields().ByName("metadata")
+	fd_DutchAuction_deposit = md_DutchAuction.Fields().ByName("deposit")
+	fd_DutchAuction_status = md_DutchAuction.Fields().ByName("status")
+}
+
+var _ protoreflect.Message = (*fastReflection_DutchAuction)(nil)
+
+type fastReflection_DutchAuction DutchAuction
+
+func (x *DutchAuction) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DutchAuction)(x)
+}
+
+func (x *DutchAuction) slowProtoReflect() protoreflect.Message {
+	mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DutchAuction_messageType fastReflection_DutchAuction_messageType
+var _ protoreflect.MessageType = fastReflection_DutchAuction_messageType{}
+
+type fastReflection_DutchAuction_messageType struct{}
+
+func (x fastReflection_DutchAuction_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DutchAuction)(nil)
+}
+func (x fastReflection_DutchAuction_messageType) New() protoreflect.Message {
+	return new(fastReflection_DutchAuction)
+}
+func (x fastReflection_DutchAuction_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DutchAuction
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DutchAuction) Descriptor() protoreflect.MessageDescriptor {
+	return md_DutchAuction
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DutchAuction) Type() protoreflect.MessageType {
+	return _fastReflection_DutchAuction_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DutchAuction) New() protoreflect.Message {
+	return new(fastReflection_DutchAuction)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DutchAuction) Interface() protoreflect.ProtoMessage {
+	return (*DutchAuction)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DutchAuction) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_DutchAuction_id, value) {
+			return
+		}
+	}
+	if x.LegacyStatus != "" {
+		value := protoreflect.ValueOfString(x.LegacyStatus)
+		if !f(fd_DutchAuction_legacy_status, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_DutchAuction_owner, value) {
+			return
+		}
+	}
+	if x.AuctionType != "" {
+		value := protoreflect.ValueOfString(x.AuctionType)
+		if !f(fd_DutchAuction_auction_type, value) {
+			return
+		}
+	}
+	if x.Metadata != nil {
+		value := protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
+		if !f(fd_DutchAuction_metadata, value) {
+			return
+		}
+	}
+	if len(x.Deposit) != 0 {
+		value := protoreflect.ValueOfList(&_DutchAuction_6_list{list: &x.Deposit})
+		if !f(fd_DutchAuction_deposit, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_DutchAuction_status, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DutchAuction) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.DutchAuction.id":
+		return x.Id != uint64(0)
+	case "fatal_fruit.auction.v1.DutchAuction.legacy_status":
+		return x.LegacyStatus != ""
+	case "fatal_fruit.auction.v1.DutchAuction.owner":
+		return x.Owner != ""
+	case "fatal_fruit.auction.v1.DutchAuction.auction_type":
+		return x.AuctionType != ""
+	case "fatal_fruit.auction.v1.DutchAuction.metadata":
+		return x.Metadata != nil
+	case "fatal_fruit.auction.v1.DutchAuction.deposit":
+		return len(x.Deposit) != 0
+	case "fatal_fruit.auction.v1.DutchAuction.status":
+		return x.Status != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.DutchAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.DutchAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DutchAuction) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.DutchAuction.id":
+		x.Id = uint64(0)
+	case "fatal_fruit.auction.v1.DutchAuction.legacy_status":
+		x.LegacyStatus = ""
+	case "fatal_fruit.auction.v1.DutchAuction.owner":
+		x.Owner = ""
+	case "fatal_fruit.auction.v1.DutchAuction.auction_type":
+		x.AuctionType = ""
+	case "fatal_fruit.auction.v1.DutchAuction.metadata":
+		x.Metadata = nil
+	case "fatal_fruit.auction.v1.DutchAuction.deposit":
+		x.Deposit = nil
+	case "fatal_fruit.auction.v1.DutchAuction.status":
+		x.Status = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.DutchAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.DutchAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DutchAuction) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fatal_fruit.auction.v1.DutchAuction.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "fatal_fruit.auction.v1.DutchAuction.legacy_status":
+		value := x.LegacyStatus
+		return protoreflect.ValueOfString(value)
+	case "fatal_fruit.auction.v1.DutchAuction.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "fatal_fruit.auction.v1.DutchAuction.auction_type":
+		value := x.AuctionType
+		return protoreflect.ValueOfString(value)
+	case "fatal_fruit.auction.v1.DutchAuction.metadata":
+		value := x.Metadata
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuction.deposit":
+		if len(x.Deposit) == 0 {
+			return protoreflect.ValueOfList(&_DutchAuction_6_list{})
+		}
+		listValue := &_DutchAuction_6_list{list: &x.Deposit}
+		return protoreflect.ValueOfList(listValue)
+	case "fatal_fruit.auction.v1.DutchAuction.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.DutchAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.DutchAuction does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DutchAuction) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.DutchAuction.id":
+		x.Id = value.Uint()
+	case "fatal_fruit.auction.v1.DutchAuction.legacy_status":
+		x.LegacyStatus = value.Interface().(string)
+	case "fatal_fruit.auction.v1.DutchAuction.owner":
+		x.Owner = value.Interface().(string)
+	case "fatal_fruit.auction.v1.DutchAuction.auction_type":
+		x.AuctionType = value.Interface().(string)
+	case "fatal_fruit.auction.v1.DutchAuction.metadata":
+		x.Metadata = value.Message().Interface().(*DutchAuctionMetadata)
+	case "fatal_fruit.auction.v1.DutchAuction.deposit":
+		lv := value.List()
+		clv := lv.(*_DutchAuction_6_list)
+		x.Deposit = *clv.list
+	case "fatal_fruit.auction.v1.DutchAuction.status":
+		x.Status = (AuctionStatus)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.DutchAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.DutchAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DutchAuction) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.DutchAuction.metadata":
+		if x.Metadata == nil {
+			x.Metadata = new(DutchAuctionMetadata)
+		}
+		return protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuction.deposit":
+		if x.Deposit == nil {
+			x.Deposit = []*v1beta1.Coin{}
+		}
+		value := &_DutchAuction_6_list{list: &x.Deposit}
+		return protoreflect.ValueOfList(value)
+	case "fatal_fruit.auction.v1.DutchAuction.id":
+		panic(fmt.Errorf("field id of message fatal_fruit.auction.v1.DutchAuction is not mutable"))
+	case "fatal_fruit.auction.v1.DutchAuction.legacy_status":
+		panic(fmt.Errorf("field legacy_status of message fatal_fruit.auction.v1.DutchAuction is not mutable"))
+	case "fatal_fruit.auction.v1.DutchAuction.owner":
+		panic(fmt.Errorf("field owner of message fatal_fruit.auction.v1.DutchAuction is not mutable"))
+	case "fatal_fruit.auction.v1.DutchAuction.auction_type":
+		panic(fmt.Errorf("field auction_type of message fatal_fruit.auction.v1.DutchAuction is not mutable"))
+	case "fatal_fruit.auction.v1.DutchAuction.status":
+		panic(fmt.Errorf("field status of message fatal_fruit.auction.v1.DutchAuction is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.DutchAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.DutchAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DutchAuction) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.DutchAuction.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fatal_fruit.auction.v1.DutchAuction.legacy_status":
+		return protoreflect.ValueOfString("")
+	case "fatal_fruit.auction.v1.DutchAuction.owner":
+		return protoreflect.ValueOfString("")
+	case "fatal_fruit.auction.v1.DutchAuction.auction_type":
+		return protoreflect.ValueOfString("")
+	case "fatal_fruit.auction.v1.DutchAuction.metadata":
+		m := new(DutchAuctionMetadata)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.DutchAuction.deposit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_DutchAuction_6_list{list: &list})
+	case "fatal_fruit.auction.v1.DutchAuction.status":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.DutchAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.DutchAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DutchAuction) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fatal_fruit.auction.v1.DutchAuction", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DutchAuction) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DutchAuction) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DutchAuction) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DutchAuction) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DutchAuction)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.LegacyStatus)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AuctionType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Metadata != nil {
+			l = options.Size(x.Metadata)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Deposit) > 0 {
+			for _, e := range x.Deposit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DutchAuction)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.Deposit) > 0 {
+			for iNdEx := len(x.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Deposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.Metadata != nil {
+			encoded, err := options.Marshal(x.Metadata)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.AuctionType) > 0 {
+			i -= len(x.AuctionType)
+			copy(dAtA[i:], x.AuctionType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AuctionType)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.LegacyStatus) > 0 {
+			i -= len(x.LegacyStatus)
+			copy(dAtA[i:], x.LegacyStatus)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LegacyStatus)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DutchAuction)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DutchAuction: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DutchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LegacyStatus", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LegacyStatus = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AuctionType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Metadata == nil {
+					x.Metadata = &DutchAuctionMetadata{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Metadata); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Deposit = append(x.Deposit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Deposit[len(x.Deposit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= AuctionStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SealedBidAuctionMetadata                       protoreflect.MessageDescriptor
+	fd_SealedBidAuctionMetadata_duration              protoreflect.FieldDescriptor
+	fd_SealedBidAuctionMetadata_reveal_duration       protoreflect.FieldDescriptor
+	fd_SealedBidAuctionMetadata_start_time            protoreflect.FieldDescriptor
+	fd_SealedBidAuctionMetadata_end_time              protoreflect.FieldDescriptor
+	fd_SealedBidAuctionMetadata_reveal_end_time       protoreflect.FieldDescriptor
+	fd_SealedBidAuctionMetadata_reserve_price         protoreflect.FieldDescriptor
+	fd_SealedBidAuctionMetadata_unrevealed_bid_policy protoreflect.FieldDescriptor
+	fd_SealedBidAuctionMetadata_highest_bid           protoreflect.FieldDescriptor
+	fd_SealedBidAuctionMetadata_num_bids              protoreflect.FieldDescriptor
+	fd_SealedBidAuctionMetadata_num_revealed          protoreflect.FieldDescriptor
+	fd_SealedBidAuctionMetadata_strategy              protoreflect.FieldDescriptor
+	fd_SealedBidAuctionMetadata_strategy_type         protoreflect.FieldDescriptor
+	fd_SealedBidAuctionMetadata_settlement_strategy   protoreflect.FieldDescriptor
+	fd_SealedBidAuctionMetadata_auto_execute          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fatal_fruit_auction_v1_auctiontypes_proto_init()
+	md_SealedBidAuctionMetadata = File_fatal_fruit_auction_v1_auctiontypes_proto.Messages().ByName("SealedBidAuctionMetadata")
+	fd_SealedBidAuctionMetadata_duration = md_SealedBidAuctionMetadata.Fields().ByName("duration")
+	fd_SealedBidAuctionMetadata_reveal_duration = md_SealedBidAuctionMetadata.Fields().ByName("reveal_duration")
+	fd_SealedBidAuctionMetadata_start_time = md_SealedBidAuctionMetadata.Fields().ByName("start_time")
+	fd_SealedBidAuctionMetadata_end_time = md_SealedBidAuctionMetadata.Fields().ByName("end_time")
+	fd_SealedBidAuctionMetadata_reveal_end_time = md_SealedBidAuctionMetadata.Fields().ByName("reveal_end_time")
+	fd_SealedBidAuctionMetadata_reserve_price = md_SealedBidAuctionMetadata.Fields().ByName("reserve_price")
+	fd_SealedBidAuctionMetadata_unrevealed_bid_policy = md_SealedBidAuctionMetadata.Fields().ByName("unrevealed_bid_policy")
+	fd_SealedBidAuctionMetadata_highest_bid = md_SealedBidAuctionMetadata.Fields().ByName("highest_bid")
+	fd_SealedBidAuctionMetadata_num_bids = md_SealedBidAuctionMetadata.Fields().ByName("num_bids")
+	fd_SealedBidAuctionMetadata_num_revealed = md_SealedBidAuctionMetadata.Fields().ByName("num_revealed")
+	fd_SealedBidAuctionMetadata_strategy = md_SealedBidAuctionMetadata.Fields().ByName("strategy")
+	fd_SealedBidAuctionMetadata_strategy_type = md_SealedBidAuctionMetadata.Fields().ByName("strategy_type")
+	fd_SealedBidAuctionMetadata_settlement_strategy = md_SealedBidAuctionMetadata.Fields().ByName("settlement_strategy")
+	fd_SealedBidAuctionMetadata_auto_execute = md_SealedBidAuctionMetadata.Fields().ByName("auto_execute")
+}
+
+var _ protoreflect.Message = (*fastReflection_SealedBidAuctionMetadata)(nil)
+
+type fastReflection_SealedBidAuctionMetadata SealedBidAuctionMetadata
+
+func (x *SealedBidAuctionMetadata) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SealedBidAuctionMetadata)(x)
+}
+
+func (x *SealedBidAuctionMetadata) slowProtoReflect() protoreflect.Message {
+	mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SealedBidAuctionMetadata_messageType fastReflection_SealedBidAuctionMetadata_messageType
+var _ protoreflect.MessageType = fastReflection_SealedBidAuctionMetadata_messageType{}
+
+type fastReflection_SealedBidAuctionMetadata_messageType struct{}
+
+func (x fastReflection_SealedBidAuctionMetadata_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SealedBidAuctionMetadata)(nil)
+}
+func (x fastReflection_SealedBidAuctionMetadata_messageType) New() protoreflect.Message {
+	return new(fastReflection_SealedBidAuctionMetadata)
+}
+func (x fastReflection_SealedBidAuctionMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SealedBidAuctionMetadata
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SealedBidAuctionMetadata) Descriptor() protoreflect.MessageDescriptor {
+	return md_SealedBidAuctionMetadata
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SealedBidAuctionMetadata) Type() protoreflect.MessageType {
+	return _fastReflection_SealedBidAuctionMetadata_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SealedBidAuctionMetadata) New() protoreflect.Message {
+	return new(fastReflection_SealedBidAuctionMetadata)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SealedBidAuctionMetadata) Interface() protoreflect.ProtoMessage {
+	return (*SealedBidAuctionMetadata)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SealedBidAuctionMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Duration != nil {
+		value := protoreflect.ValueOfMessage(x.Duration.ProtoReflect())
+		if !f(fd_SealedBidAuctionMetadata_duration, value) {
+			return
+		}
+	}
+	if x.RevealDuration != nil {
+		value := protoreflect.ValueOfMessage(x.RevealDuration.ProtoReflect())
+		if !f(fd_SealedBidAuctionMetadata_reveal_duration, value) {
+			return
+		}
+	}
+	if x.StartTime != nil {
+		value := protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+		if !f(fd_SealedBidAuctionMetadata_start_time, value) {
+			return
+		}
+	}
+	if x.EndTime != nil {
+		value := protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+		if !f(fd_SealedBidAuctionMetadata_end_time, value) {
+			return
+		}
+	}
+	if x.RevealEndTime != nil {
+		value := protoreflect.ValueOfMessage(x.RevealEndTime.ProtoReflect())
+		if !f(fd_SealedBidAuctionMetadata_reveal_end_time, value) {
+			return
+		}
+	}
+	if x.ReservePrice != nil {
+		value := protoreflect.ValueOfMessage(x.ReservePrice.ProtoReflect())
+		if !f(fd_SealedBidAuctionMetadata_reserve_price, value) {
+			return
+		}
+	}
+	if x.UnrevealedBidPolicy != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.UnrevealedBidPolicy))
+		if !f(fd_SealedBidAuctionMetadata_unrevealed_bid_policy, value) {
+			return
+		}
+	}
+	if x.HighestBid != nil {
+		value := protoreflect.ValueOfMessage(x.HighestBid.ProtoReflect())
+		if !f(fd_SealedBidAuctionMetadata_highest_bid, value) {
+			return
+		}
+	}
+	if x.NumBids != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NumBids)
+		if !f(fd_SealedBidAuctionMetadata_num_bids, value) {
+			return
+		}
+	}
+	if x.NumRevealed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NumRevealed)
+		if !f(fd_SealedBidAuctionMetadata_num_revealed, value) {
+			return
+		}
+	}
+	if x.Strategy != nil {
+		value := protoreflect.ValueOfMessage(x.Strategy.ProtoReflect())
+		if !f(fd_SealedBidAuctionMetadata_strategy, value) {
+			return
+		}
+	}
+	if x.StrategyType != "" {
+		value := protoreflect.ValueOfString(x.StrategyType)
+		if !f(fd_SealedBidAuctionMetadata_strategy_type, value) {
+			return
+		}
+	}
+	if x.SettlementStrategy != nil {
+		value := protoreflect.ValueOfMessage(x.SettlementStrategy.ProtoReflect())
+		if !f(fd_SealedBidAuctionMetadata_settlement_strategy, value) {
+			return
+		}
+	}
+	if x.AutoExecute != false {
+		value := protoreflect.ValueOfBool(x.AutoExecute)
+		if !f(fd_SealedBidAuctionMetadata_auto_execute, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SealedBidAuctionMetadata) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.duration":
+		return x.Duration != nil
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.reveal_duration":
+		return x.RevealDuration != nil
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.start_time":
+		return x.StartTime != nil
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.end_time":
+		return x.EndTime != nil
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.reveal_end_time":
+		return x.RevealEndTime != nil
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.reserve_price":
+		return x.ReservePrice != nil
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.unrevealed_bid_policy":
+		return x.UnrevealedBidPolicy != 0
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.highest_bid":
+		return x.HighestBid != nil
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.num_bids":
+		return x.NumBids != uint64(0)
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.num_revealed":
+		return x.NumRevealed != uint64(0)
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.strategy":
+		return x.Strategy != nil
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.strategy_type":
+		return x.StrategyType != ""
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.settlement_strategy":
+		return x.SettlementStrategy != nil
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.auto_execute":
+		return x.AutoExecute != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SealedBidAuctionMetadata"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.SealedBidAuctionMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SealedBidAuctionMetadata) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.duration":
+		x.Duration = nil
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.reveal_duration":
+		x.RevealDuration = nil
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.start_time":
+		x.StartTime = nil
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.end_time":
+		x.EndTime = nil
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.reveal_end_time":
+		x.RevealEndTime = nil
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.reserve_price":
+		x.ReservePrice = nil
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.unrevealed_bid_policy":
+		x.UnrevealedBidPolicy = 0
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.highest_bid":
+		x.HighestBid = nil
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.num_bids":
+		x.NumBids = uint64(0)
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.num_revealed":
+		x.NumRevealed = uint64(0)
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.strategy":
+		x.Strategy = nil
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.strategy_type":
+		x.StrategyType = ""
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.settlement_strategy":
+		x.SettlementStrategy = nil
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.auto_execute":
+		x.AutoExecute = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SealedBidAuctionMetadata"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.SealedBidAuctionMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SealedBidAuctionMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.duration":
+		value := x.Duration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.reveal_duration":
+		value := x.RevealDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.start_time":
+		value := x.StartTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.end_time":
+		value := x.EndTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.reveal_end_time":
+		value := x.RevealEndTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.reserve_price":
+		value := x.ReservePrice
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.unrevealed_bid_policy":
+		value := x.UnrevealedBidPolicy
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.highest_bid":
+		value := x.HighestBid
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.num_bids":
+		value := x.NumBids
+		return protoreflect.ValueOfUint64(value)
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.num_revealed":
+		value := x.NumRevealed
+		return protoreflect.ValueOfUint64(value)
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.strategy":
+		value := x.Strategy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.strategy_type":
+		value := x.StrategyType
+		return protoreflect.ValueOfString(value)
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.settlement_strategy":
+		value := x.SettlementStrategy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.auto_execute":
+		value := x.AutoExecute
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SealedBidAuctionMetadata"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.SealedBidAuctionMetadata does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SealedBidAuctionMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.duration":
+		x.Duration = value.Message().Interface().(*durationpb.Duration)
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.reveal_duration":
+		x.RevealDuration = value.Message().Interface().(*durationpb.Duration)
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.start_time":
+		x.StartTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.end_time":
+		x.EndTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.reveal_end_time":
+		x.RevealEndTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.reserve_price":
+		x.ReservePrice = value.Message().Interface().(*v1beta1.Coin)
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.unrevealed_bid_policy":
+		x.UnrevealedBidPolicy = (UnrevealedBidPolicy)(value.Enum())
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.highest_bid":
+		x.HighestBid = value.Message().Interface().(*Bid)
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.num_bids":
+		x.NumBids = value.Uint()
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.num_revealed":
+		x.NumRevealed = value.Uint()
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.strategy":
+		x.Strategy = value.Message().Interface().(*SettleStrategy)
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.strategy_type":
+		x.StrategyType = value.Interface().(string)
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.settlement_strategy":
+		x.SettlementStrategy = value.Message().Interface().(*anypb.Any)
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.auto_execute":
+		x.AutoExecute = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SealedBidAuctionMetadata"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.SealedBidAuctionMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SealedBidAuctionMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.duration":
+		if x.Duration == nil {
+			x.Duration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.Duration.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.reveal_duration":
+		if x.RevealDuration == nil {
+			x.RevealDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.RevealDuration.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.start_time":
+		if x.StartTime == nil {
+			x.StartTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.end_time":
+		if x.EndTime == nil {
+			x.EndTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.reveal_end_time":
+		if x.RevealEndTime == nil {
+			x.RevealEndTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.RevealEndTime.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.reserve_price":
+		if x.ReservePrice == nil {
+			x.ReservePrice = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.ReservePrice.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.highest_bid":
+		if x.HighestBid == nil {
+			x.HighestBid = new(Bid)
+		}
+		return protoreflect.ValueOfMessage(x.HighestBid.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.strategy":
+		if x.Strategy == nil {
+			x.Strategy = new(SettleStrategy)
+		}
+		return protoreflect.ValueOfMessage(x.Strategy.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.settlement_strategy":
+		if x.SettlementStrategy == nil {
+			x.SettlementStrategy = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.SettlementStrategy.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.unrevealed_bid_policy":
+		panic(fmt.Errorf("field unrevealed_bid_policy of message fatal_fruit.auction.v1.SealedBidAuctionMetadata is not mutable"))
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.num_bids":
+		panic(fmt.Errorf("field num_bids of message fatal_fruit.auction.v1.SealedBidAuctionMetadata is not mutable"))
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.num_revealed":
+		panic(fmt.Errorf("field num_revealed of message fatal_fruit.auction.v1.SealedBidAuctionMetadata is not mutable"))
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.strategy_type":
+		panic(fmt.Errorf("field strategy_type of message fatal_fruit.auction.v1.SealedBidAuctionMetadata is not mutable"))
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.auto_execute":
+		panic(fmt.Errorf("field auto_execute of message fatal_fruit.auction.v1.SealedBidAuctionMetadata is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SealedBidAuctionMetadata"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.SealedBidAuctionMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SealedBidAuctionMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.reveal_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.start_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.end_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.reveal_end_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.reserve_price":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.unrevealed_bid_policy":
+		return protoreflect.ValueOfEnum(0)
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.highest_bid":
+		m := new(Bid)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.num_bids":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.num_revealed":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.strategy":
+		m := new(SettleStrategy)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.strategy_type":
+		return protoreflect.ValueOfString("")
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.settlement_strategy":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuctionMetadata.auto_execute":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SealedBidAuctionMetadata"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.SealedBidAuctionMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SealedBidAuctionMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fatal_fruit.auction.v1.SealedBidAuctionMetadata", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SealedBidAuctionMetadata) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SealedBidAuctionMetadata) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SealedBidAuctionMetadata) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SealedBidAuctionMetadata) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SealedBidAuctionMetadata)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Duration != nil {
+			l = options.Size(x.Duration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RevealDuration != nil {
+			l = options.Size(x.RevealDuration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StartTime != nil {
+			l = options.Size(x.StartTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EndTime != nil {
+			l = options.Size(x.EndTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RevealEndTime != nil {
+			l = options.Size(x.RevealEndTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ReservePrice != nil {
+			l = options.Size(x.ReservePrice)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.UnrevealedBidPolicy != 0 {
+			n += 1 + runtime.Sov(uint64(x.UnrevealedBidPolicy))
+		}
+		if x.HighestBid != nil {
+			l = options.Size(x.HighestBid)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NumBids != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumBids))
+		}
+		if x.NumRevealed != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumRevealed))
+		}
+		if x.Strategy != nil {
+			l = options.Size(x.Strategy)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.StrategyType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SettlementStrategy != nil {
+			l = options.Size(x.SettlementStrategy)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AutoExecute {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SealedBidAuctionMetadata)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AutoExecute {
+			i--
+			if x.AutoExecute {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.SettlementStrategy != nil {
+			encoded, err := options.Marshal(x.SettlementStrategy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.StrategyType) > 0 {
+			i -= len(x.StrategyType)
+			copy(dAtA[i:], x.StrategyType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StrategyType)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.Strategy != nil {
+			encoded, err := options.Marshal(x.Strategy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.NumRevealed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumRevealed))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.NumBids != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumBids))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.HighestBid != nil {
+			encoded, err := options.Marshal(x.HighestBid)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.UnrevealedBidPolicy != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UnrevealedBidPolicy))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.ReservePrice != nil {
+			encoded, err := options.Marshal(x.ReservePrice)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.RevealEndTime != nil {
+			encoded, err := options.Marshal(x.RevealEndTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.EndTime != nil {
+			encoded, err := options.Marshal(x.EndTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.StartTime != nil {
+			encoded, err := options.Marshal(x.StartTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.RevealDuration != nil {
+			encoded, err := options.Marshal(x.RevealDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Duration != nil {
+			encoded, err := options.Marshal(x.Duration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SealedBidAuctionMetadata)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SealedBidAuctionMetadata: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SealedBidAuctionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Duration == nil {
+					x.Duration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Duration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevealDuration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RevealDuration == nil {
+					x.RevealDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RevealDuration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StartTime == nil {
+					x.StartTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StartTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EndTime == nil {
+					x.EndTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EndTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevealEndTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RevealEndTime == nil {
+					x.RevealEndTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RevealEndTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReservePrice", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ReservePrice == nil {
+					x.ReservePrice = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReservePrice); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnrevealedBidPolicy", wireType)
+				}
+				x.UnrevealedBidPolicy = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UnrevealedBidPolicy |= UnrevealedBidPolicy(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HighestBid", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.HighestBid == nil {
+					x.HighestBid = &Bid{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.HighestBid); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumBids", wireType)
+				}
+				x.NumBids = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumBids |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumRevealed", wireType)
+				}
+				x.NumRevealed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumRevealed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Strategy == nil {
+					x.Strategy = &SettleStrategy{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Strategy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StrategyType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StrategyType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SettlementStrategy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SettlementStrategy == nil {
+					x.SettlementStrategy = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SettlementStrategy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoExecute", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AutoExecute = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_SealedBidAuction_6_list)(nil)
+
+type _SealedBidAuction_6_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_SealedBidAuction_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SealedBidAuction_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_SealedBidAuction_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SealedBidAuction_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SealedBidAuction_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SealedBidAuction_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SealedBidAuction_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SealedBidAuction_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_SealedBidAuction               protoreflect.MessageDescriptor
+	fd_SealedBidAuction_id            protoreflect.FieldDescriptor
+	fd_SealedBidAuction_legacy_status protoreflect.FieldDescriptor
+	fd_SealedBidAuction_owner         protoreflect.FieldDescriptor
+	fd_SealedBidAuction_auction_type  protoreflect.FieldDescriptor
+	fd_SealedBidAuction_metadata      protoreflect.FieldDescriptor
+	fd_SealedBidAuction_deposit       protoreflect.FieldDescriptor
+	fd_SealedBidAuction_status        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fatal_fruit_auction_v1_auctiontypes_proto_init()
+	md_SealedBidAuction = File_fatal_fruit_auction_v1_auctiontypes_proto.Messages().ByName("SealedBidAuction")
+	fd_SealedBidAuction_id = md_SealedBidAuction.Fields().ByName("id")
+	fd_SealedBidAuction_legacy_status = md_SealedBidAuction.Fields().ByName("legacy_status")
+	fd_SealedBidAuction_owner = md_SealedBidAuction.Fields().ByName("owner")
+	fd_SealedBidAuction_auction_type = md_SealedBidAuction.Fields().ByName("auction_type")
+	fd_SealedBidAuction_metadata = md_SealedBidAuction.Fields().ByName("metadata")
+	fd_SealedBidAuction_deposit = md_SealedBidAuction.Fields().ByName("deposit")
+	fd_SealedBidAuction_status = md_SealedBidAuction.Fields().ByName("status")
+}
+
+var _ protoreflect.Message = (*fastReflection_SealedBidAuction)(nil)
+
+type fastReflection_SealedBidAuction SealedBidAuction
+
+func (x *SealedBidAuction) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SealedBidAuction)(x)
+}
+
+func (x *SealedBidAuction) slowProtoReflect() protoreflect.Message {
+	mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SealedBidAuction_messageType fastReflection_SealedBidAuction_messageType
+var _ protoreflect.MessageType = fastReflection_SealedBidAuction_messageType{}
+
+type fastReflection_SealedBidAuction_messageType struct{}
+
+func (x fastReflection_SealedBidAuction_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SealedBidAuction)(nil)
+}
+func (x fastReflection_SealedBidAuction_messageType) New() protoreflect.Message {
+	return new(fastReflection_SealedBidAuction)
+}
+func (x fastReflection_SealedBidAuction_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SealedBidAuction
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SealedBidAuction) Descriptor() protoreflect.MessageDescriptor {
+	return md_SealedBidAuction
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SealedBidAuction) Type() protoreflect.MessageType {
+	return _fastReflection_SealedBidAuction_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SealedBidAuction) New() protoreflect.Message {
+	return new(fastReflection_SealedBidAuction)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SealedBidAuction) Interface() protoreflect.ProtoMessage {
+	return (*SealedBidAuction)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SealedBidAuction) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_SealedBidAuction_id, value) {
+			return
+		}
+	}
+	if x.LegacyStatus != "" {
+		value := protoreflect.ValueOfString(x.LegacyStatus)
+		if !f(fd_SealedBidAuction_legacy_status, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_SealedBidAuction_owner, value) {
+			return
+		}
+	}
+	if x.AuctionType != "" {
+		value := protoreflect.ValueOfString(x.AuctionType)
+		if !f(fd_SealedBidAuction_auction_type, value) {
+			return
+		}
+	}
+	if x.Metadata != nil {
+		value := protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
+		if !f(fd_SealedBidAuction_metadata, value) {
+			return
+		}
+	}
+	if len(x.Deposit) != 0 {
+		value := protoreflect.ValueOfList(&_SealedBidAuction_6_list{list: &x.Deposit})
+		if !f(fd_SealedBidAuction_deposit, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_SealedBidAuction_status, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SealedBidAuction) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.SealedBidAuction.id":
+		return x.Id != uint64(0)
+	case "fatal_fruit.auction.v1.SealedBidAuction.legacy_status":
+		return x.LegacyStatus != ""
+	case "fatal_fruit.auction.v1.SealedBidAuction.owner":
+		return x.Owner != ""
+	case "fatal_fruit.auction.v1.SealedBidAuction.auction_type":
+		return x.AuctionType != ""
+	case "fatal_fruit.auction.v1.SealedBidAuction.metadata":
+		return x.Metadata != nil
+	case "fatal_fruit.auction.v1.SealedBidAuction.deposit":
+		return len(x.Deposit) != 0
+	case "fatal_fruit.auction.v1.SealedBidAuction.status":
+		return x.Status != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SealedBidAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.SealedBidAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SealedBidAuction) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.SealedBidAuction.id":
+		x.Id = uint64(0)
+	case "fatal_fruit.auction.v1.SealedBidAuction.legacy_status":
+		x.LegacyStatus = ""
+	case "fatal_fruit.auction.v1.SealedBidAuction.owner":
+		x.Owner = ""
+	case "fatal_fruit.auction.v1.SealedBidAuction.auction_type":
+		x.AuctionType = ""
+	case "fatal_fruit.auction.v1.SealedBidAuction.metadata":
+		x.Metadata = nil
+	case "fatal_fruit.auction.v1.SealedBidAuction.deposit":
+		x.Deposit = nil
+	case "fatal_fruit.auction.v1.SealedBidAuction.status":
+		x.Status = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SealedBidAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.SealedBidAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SealedBidAuction) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fatal_fruit.auction.v1.SealedBidAuction.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "fatal_fruit.auction.v1.SealedBidAuction.legacy_status":
+		value := x.LegacyStatus
+		return protoreflect.ValueOfString(value)
+	case "fatal_fruit.auction.v1.SealedBidAuction.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "fatal_fruit.auction.v1.SealedBidAuction.auction_type":
+		value := x.AuctionType
+		return protoreflect.ValueOfString(value)
+	case "fatal_fruit.auction.v1.SealedBidAuction.metadata":
+		value := x.Metadata
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuction.deposit":
+		if len(x.Deposit) == 0 {
+			return protoreflect.ValueOfList(&_SealedBidAuction_6_list{})
+		}
+		listValue := &_SealedBidAuction_6_list{list: &x.Deposit}
+		return protoreflect.ValueOfList(listValue)
+	case "fatal_fruit.auction.v1.SealedBidAuction.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SealedBidAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.SealedBidAuction does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SealedBidAuction) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.SealedBidAuction.id":
+		x.Id = value.Uint()
+	case "fatal_fruit.auction.v1.SealedBidAuction.legacy_status":
+		x.LegacyStatus = value.Interface().(string)
+	case "fatal_fruit.auction.v1.SealedBidAuction.owner":
+		x.Owner = value.Interface().(string)
+	case "fatal_fruit.auction.v1.SealedBidAuction.auction_type":
+		x.AuctionType = value.Interface().(string)
+	case "fatal_fruit.auction.v1.SealedBidAuction.metadata":
+		x.Metadata = value.Message().Interface().(*SealedBidAuctionMetadata)
+	case "fatal_fruit.auction.v1.SealedBidAuction.deposit":
+		lv := value.List()
+		clv := lv.(*_SealedBidAuction_6_list)
+		x.Deposit = *clv.list
+	case "fatal_fruit.auction.v1.SealedBidAuction.status":
+		x.Status = (AuctionStatus)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SealedBidAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.SealedBidAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SealedBidAuction) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.SealedBidAuction.metadata":
+		if x.Metadata == nil {
+			x.Metadata = new(SealedBidAuctionMetadata)
+		}
+		return protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuction.deposit":
+		if x.Deposit == nil {
+			x.Deposit = []*v1beta1.Coin{}
+		}
+		value := &_SealedBidAuction_6_list{list: &x.Deposit}
+		return protoreflect.ValueOfList(value)
+	case "fatal_fruit.auction.v1.SealedBidAuction.id":
+		panic(fmt.Errorf("field id of message fatal_fruit.auction.v1.SealedBidAuction is not mutable"))
+	case "fatal_fruit.auction.v1.SealedBidAuction.legacy_status":
+		panic(fmt.Errorf("field legacy_status of message fatal_fruit.auction.v1.SealedBidAuction is not mutable"))
+	case "fatal_fruit.auction.v1.SealedBidAuction.owner":
+		panic(fmt.Errorf("field owner of message fatal_fruit.auction.v1.SealedBidAuction is not mutable"))
+	case "fatal_fruit.auction.v1.SealedBidAuction.auction_type":
+		panic(fmt.Errorf("field auction_type of message fatal_fruit.auction.v1.SealedBidAuction is not mutable"))
+	case "fatal_fruit.auction.v1.SealedBidAuction.status":
+		panic(fmt.Errorf("field status of message fatal_fruit.auction.v1.SealedBidAuction is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SealedBidAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.SealedBidAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SealedBidAuction) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.SealedBidAuction.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fatal_fruit.auction.v1.SealedBidAuction.legacy_status":
+		return protoreflect.ValueOfString("")
+	case "fatal_fruit.auction.v1.SealedBidAuction.owner":
+		return protoreflect.ValueOfString("")
+	case "fatal_fruit.auction.v1.SealedBidAuction.auction_type":
+		return protoreflect.ValueOfString("")
+	case "fatal_fruit.auction.v1.SealedBidAuction.metadata":
+		m := new(SealedBidAuctionMetadata)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBidAuction.deposit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_SealedBidAuction_6_list{list: &list})
+	case "fatal_fruit.auction.v1.SealedBidAuction.status":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SealedBidAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.SealedBidAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SealedBidAuction) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fatal_fruit.auction.v1.SealedBidAuction", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SealedBidAuction) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SealedBidAuction) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SealedBidAuction) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SealedBidAuction) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SealedBidAuction)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.LegacyStatus)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AuctionType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Metadata != nil {
+			l = options.Size(x.Metadata)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Deposit) > 0 {
+			for _, e := range x.Deposit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SealedBidAuction)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.Deposit) > 0 {
+			for iNdEx := len(x.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Deposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.Metadata != nil {
+			encoded, err := options.Marshal(x.Metadata)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.AuctionType) > 0 {
+			i -= len(x.AuctionType)
+			copy(dAtA[i:], x.AuctionType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AuctionType)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.LegacyStatus) > 0 {
+			i -= len(x.LegacyStatus)
+			copy(dAtA[i:], x.LegacyStatus)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LegacyStatus)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SealedBidAuction)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SealedBidAuction: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SealedBidAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LegacyStatus", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LegacyStatus = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AuctionType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Metadata == nil {
+					x.Metadata = &SealedBidAuctionMetadata{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Metadata); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Deposit = append(x.Deposit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Deposit[len(x.Deposit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= AuctionStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SealedBid            protoreflect.MessageDescriptor
+	fd_SealedBid_commitment protoreflect.FieldDescriptor
+	fd_SealedBid_collateral protoreflect.FieldDescriptor
+	fd_SealedBid_revealed   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fatal_fruit_auction_v1_auctiontypes_proto_init()
+	md_SealedBid = File_fatal_fruit_auction_v1_auctiontypes_proto.Messages().ByName("SealedBid")
+	fd_SealedBid_commitment = md_SealedBid.Fields().ByName("commitment")
+	fd_SealedBid_collateral = md_SealedBid.Fields().ByName("collateral")
+	fd_SealedBid_revealed = md_SealedBid.Fields().ByName("revealed")
+}
+
+var _ protoreflect.Message = (*fastReflection_SealedBid)(nil)
+
+type fastReflection_SealedBid SealedBid
+
+func (x *SealedBid) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SealedBid)(x)
+}
+
+func (x *SealedBid) slowProtoReflect() protoreflect.Message {
+	mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SealedBid_messageType fastReflection_SealedBid_messageType
+var _ protoreflect.MessageType = fastReflection_SealedBid_messageType{}
+
+type fastReflection_SealedBid_messageType struct{}
+
+func (x fastReflection_SealedBid_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SealedBid)(nil)
+}
+func (x fastReflection_SealedBid_messageType) New() protoreflect.Message {
+	return new(fastReflection_SealedBid)
+}
+func (x fastReflection_SealedBid_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SealedBid
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SealedBid) Descriptor() protoreflect.MessageDescriptor {
+	return md_SealedBid
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SealedBid) Type() protoreflect.MessageType {
+	return _fastReflection_SealedBid_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SealedBid) New() protoreflect.Message {
+	return new(fastReflection_SealedBid)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SealedBid) Interface() protoreflect.ProtoMessage {
+	return (*SealedBid)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SealedBid) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Commitment) != 0 {
+		value := protoreflect.ValueOfBytes(x.Commitment)
+		if !f(fd_SealedBid_commitment, value) {
+			return
+		}
+	}
+	if x.Collateral != nil {
+		value := protoreflect.ValueOfMessage(x.Collateral.ProtoReflect())
+		if !f(fd_SealedBid_collateral, value) {
+			return
+		}
+	}
+	if x.Revealed != false {
+		value := protoreflect.ValueOfBool(x.Revealed)
+		if !f(fd_SealedBid_revealed, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SealedBid) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.SealedBid.commitment":
+		return len(x.Commitment) != 0
+	case "fatal_fruit.auction.v1.SealedBid.collateral":
+		return x.Collateral != nil
+	case "fatal_fruit.auction.v1.SealedBid.revealed":
+		return x.Revealed != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SealedBid"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.SealedBid does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SealedBid) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.SealedBid.commitment":
+		x.Commitment = nil
+	case "fatal_fruit.auction.v1.SealedBid.collateral":
+		x.Collateral = nil
+	case "fatal_fruit.auction.v1.SealedBid.revealed":
+		x.Revealed = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SealedBid"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.SealedBid does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SealedBid) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fatal_fruit.auction.v1.SealedBid.commitment":
+		value := x.Commitment
+		return protoreflect.ValueOfBytes(value)
+	case "fatal_fruit.auction.v1.SealedBid.collateral":
+		value := x.Collateral
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBid.revealed":
+		value := x.Revealed
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SealedBid"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.SealedBid does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SealedBid) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.SealedBid.commitment":
+		x.Commitment = value.Bytes()
+	case "fatal_fruit.auction.v1.SealedBid.collateral":
+		x.Collateral = value.Message().Interface().(*v1beta1.Coin)
+	case "fatal_fruit.auction.v1.SealedBid.revealed":
+		x.Revealed = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SealedBid"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.SealedBid does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SealedBid) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.SealedBid.collateral":
+		if x.Collateral == nil {
+			x.Collateral = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Collateral.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBid.commitment":
+		panic(fmt.Errorf("field commitment of message fatal_fruit.auction.v1.SealedBid is not mutable"))
+	case "fatal_fruit.auction.v1.SealedBid.revealed":
+		panic(fmt.Errorf("field revealed of message fatal_fruit.auction.v1.SealedBid is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SealedBid"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.SealedBid does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SealedBid) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.SealedBid.commitment":
+		return protoreflect.ValueOfBytes(nil)
+	case "fatal_fruit.auction.v1.SealedBid.collateral":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.SealedBid.revealed":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SealedBid"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.SealedBid does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SealedBid) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fatal_fruit.auction.v1.SealedBid", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SealedBid) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SealedBid) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SealedBid) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SealedBid) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SealedBid)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Commitment)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Collateral != nil {
+			l = options.Size(x.Collateral)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Revealed {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SealedBid)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Revealed {
+			i--
+			if x.Revealed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Collateral != nil {
+			encoded, err := options.Marshal(x.Collateral)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Commitment) > 0 {
+			i -= len(x.Commitment)
+			copy(dAtA[i:], x.Commitment)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Commitment)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SealedBid)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SealedBid: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SealedBid: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Commitment = append(x.Commitment[:0], dAtA[iNdEx:postIndex]...)
+				if x.Commitment == nil {
+					x.Commitment = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Collateral == nil {
+					x.Collateral = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Collateral); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Revealed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Revealed = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BatchAuctionMetadata                     protoreflect.MessageDescriptor
+	fd_BatchAuctionMetadata_duration            protoreflect.FieldDescriptor
+	fd_BatchAuctionMetadata_start_time          protoreflect.FieldDescriptor
+	fd_BatchAuctionMetadata_end_time            protoreflect.FieldDescriptor
+	fd_BatchAuctionMetadata_reserve_price       protoreflect.FieldDescriptor
+	fd_BatchAuctionMetadata_highest_bid         protoreflect.FieldDescriptor
+	fd_BatchAuctionMetadata_num_bids            protoreflect.FieldDescriptor
+	fd_BatchAuctionMetadata_settlement_strategy protoreflect.FieldDescriptor
+	fd_BatchAuctionMetadata_auto_execute        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fatal_fruit_auction_v1_auctiontypes_proto_init()
+	md_BatchAuctionMetadata = File_fatal_fruit_auction_v1_auctiontypes_proto.Messages().ByName("BatchAuctionMetadata")
+	fd_BatchAuctionMetadata_duration = md_BatchAuctionMetadata.Fields().ByName("duration")
+	fd_BatchAuctionMetadata_start_time = md_BatchAuctionMetadata.Fields().ByName("start_time")
+	fd_BatchAuctionMetadata_end_time = md_BatchAuctionMetadata.Fields().ByName("end_time")
+	fd_BatchAuctionMetadata_reserve_price = md_BatchAuctionMetadata.Fields().ByName("reserve_price")
+	fd_BatchAuctionMetadata_highest_bid = md_BatchAuctionMetadata.Fields().ByName("highest_bid")
+	fd_BatchAuctionMetadata_num_bids = md_BatchAuctionMetadata.Fields().ByName("num_bids")
+	fd_BatchAuctionMetadata_settlement_strategy = md_BatchAuctionMetadata.Fields().ByName("settlement_strategy")
+	fd_BatchAuctionMetadata_auto_execute = md_BatchAuctionMetadata.Fields().ByName("auto_execute")
+}
+
+var _ protoreflect.Message = (*fastReflection_BatchAuctionMetadata)(nil)
+
+type fastReflection_BatchAuctionMetadata BatchAuctionMetadata
+
+func (x *BatchAuctionMetadata) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BatchAuctionMetadata)(x)
+}
+
+func (x *BatchAuctionMetadata) slowProtoReflect() protoreflect.Message {
+	mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BatchAuctionMetadata_messageType fastReflection_BatchAuctionMetadata_messageType
+var _ protoreflect.MessageType = fastReflection_BatchAuctionMetadata_messageType{}
+
+type fastReflection_BatchAuctionMetadata_messageType struct{}
+
+func (x fastReflection_BatchAuctionMetadata_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BatchAuctionMetadata)(nil)
+}
+func (x fastReflection_BatchAuctionMetadata_messageType) New() protoreflect.Message {
+	return new(fastReflection_BatchAuctionMetadata)
+}
+func (x fastReflection_BatchAuctionMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BatchAuctionMetadata
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BatchAuctionMetadata) Descriptor() protoreflect.MessageDescriptor {
+	return md_BatchAuctionMetadata
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BatchAuctionMetadata) Type() protoreflect.MessageType {
+	return _fastReflection_BatchAuctionMetadata_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BatchAuctionMetadata) New() protoreflect.Message {
+	return new(fastReflection_BatchAuctionMetadata)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BatchAuctionMetadata) Interface() protoreflect.ProtoMessage {
+	return (*BatchAuctionMetadata)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BatchAuctionMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Duration != nil {
+		value := protoreflect.ValueOfMessage(x.Duration.ProtoReflect())
+		if !f(fd_BatchAuctionMetadata_duration, value) {
+			return
+		}
+	}
+	if x.StartTime != nil {
+		value := protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+		if !f(fd_BatchAuctionMetadata_start_time, value) {
+			return
+		}
+	}
+	if x.EndTime != nil {
+		value := protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+		if !f(fd_BatchAuctionMetadata_end_time, value) {
+			return
+		}
+	}
+	if x.ReservePrice != nil {
+		value := protoreflect.ValueOfMessage(x.ReservePrice.ProtoReflect())
+		if !f(fd_BatchAuctionMetadata_reserve_price, value) {
+			return
+		}
+	}
+	if x.HighestBid != nil {
+		value := protoreflect.ValueOfMessage(x.HighestBid.ProtoReflect())
+		if !f(fd_BatchAuctionMetadata_highest_bid, value) {
+			return
+		}
+	}
+	if x.NumBids != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NumBids)
+		if !f(fd_BatchAuctionMetadata_num_bids, value) {
+			return
+		}
+	}
+	if x.SettlementStrategy != nil {
+		value := protoreflect.ValueOfMessage(x.SettlementStrategy.ProtoReflect())
+		if !f(fd_BatchAuctionMetadata_settlement_strategy, value) {
+			return
+		}
+	}
+	if x.AutoExecute != false {
+		value := protoreflect.ValueOfBool(x.AutoExecute)
+		if !f(fd_BatchAuctionMetadata_auto_execute, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BatchAuctionMetadata) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.duration":
+		return x.Duration != nil
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.start_time":
+		return x.StartTime != nil
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.end_time":
+		return x.EndTime != nil
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.reserve_price":
+		return x.ReservePrice != nil
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.highest_bid":
+		return x.HighestBid != nil
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.num_bids":
+		return x.NumBids != uint64(0)
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.settlement_strategy":
+		return x.SettlementStrategy != nil
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.auto_execute":
+		return x.AutoExecute != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.BatchAuctionMetadata"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.BatchAuctionMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BatchAuctionMetadata) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.duration":
+		x.Duration = nil
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.start_time":
+		x.StartTime = nil
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.end_time":
+		x.EndTime = nil
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.reserve_price":
+		x.ReservePrice = nil
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.highest_bid":
+		x.HighestBid = nil
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.num_bids":
+		x.NumBids = uint64(0)
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.settlement_strategy":
+		x.SettlementStrategy = nil
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.auto_execute":
+		x.AutoExecute = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.BatchAuctionMetadata"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.BatchAuctionMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BatchAuctionMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.duration":
+		value := x.Duration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.start_time":
+		value := x.StartTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.end_time":
+		value := x.EndTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.reserve_price":
+		value := x.ReservePrice
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.highest_bid":
+		value := x.HighestBid
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.num_bids":
+		value := x.NumBids
+		return protoreflect.ValueOfUint64(value)
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.settlement_strategy":
+		value := x.SettlementStrategy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.auto_execute":
+		value := x.AutoExecute
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.BatchAuctionMetadata"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.BatchAuctionMetadata does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BatchAuctionMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.duration":
+		x.Duration = value.Message().Interface().(*durationpb.Duration)
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.start_time":
+		x.StartTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.end_time":
+		x.EndTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.reserve_price":
+		x.ReservePrice = value.Message().Interface().(*v1beta1.Coin)
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.highest_bid":
+		x.HighestBid = value.Message().Interface().(*Bid)
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.num_bids":
+		x.NumBids = value.Uint()
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.settlement_strategy":
+		x.SettlementStrategy = value.Message().Interface().(*anypb.Any)
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.auto_execute":
+		x.AutoExecute = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.BatchAuctionMetadata"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.BatchAuctionMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BatchAuctionMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.duration":
+		if x.Duration == nil {
+			x.Duration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.Duration.ProtoReflect())
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.start_time":
+		if x.StartTime == nil {
+			x.StartTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.end_time":
+		if x.EndTime == nil {
+			x.EndTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.reserve_price":
+		if x.ReservePrice == nil {
+			x.ReservePrice = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.ReservePrice.ProtoReflect())
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.highest_bid":
+		if x.HighestBid == nil {
+			x.HighestBid = new(Bid)
+		}
+		return protoreflect.ValueOfMessage(x.HighestBid.ProtoReflect())
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.settlement_strategy":
+		if x.SettlementStrategy == nil {
+			x.SettlementStrategy = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.SettlementStrategy.ProtoReflect())
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.num_bids":
+		panic(fmt.Errorf("field num_bids of message fatal_fruit.auction.v1.BatchAuctionMetadata is not mutable"))
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.auto_execute":
+		panic(fmt.Errorf("field auto_execute of message fatal_fruit.auction.v1.BatchAuctionMetadata is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.BatchAuctionMetadata"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.BatchAuctionMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BatchAuctionMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.start_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.end_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.reserve_price":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.highest_bid":
+		m := new(Bid)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.num_bids":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.settlement_strategy":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.BatchAuctionMetadata.auto_execute":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.BatchAuctionMetadata"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.BatchAuctionMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BatchAuctionMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fatal_fruit.auction.v1.BatchAuctionMetadata", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BatchAuctionMetadata) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BatchAuctionMetadata) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BatchAuctionMetadata) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BatchAuctionMetadata) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BatchAuctionMetadata)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Duration != nil {
+			l = options.Size(x.Duration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StartTime != nil {
+			l = options.Size(x.StartTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EndTime != nil {
+			l = options.Size(x.EndTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ReservePrice != nil {
+			l = options.Size(x.ReservePrice)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.HighestBid != nil {
+			l = options.Size(x.HighestBid)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NumBids != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumBids))
+		}
+		if x.SettlementStrategy != nil {
+			l = options.Size(x.SettlementStrategy)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AutoExecute {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BatchAuctionMetadata)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AutoExecute {
+			i--
+			if x.AutoExecute {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.SettlementStrategy != nil {
+			encoded, err := options.Marshal(x.SettlementStrategy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.NumBids != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumBids))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.HighestBid != nil {
+			encoded, err := options.Marshal(x.HighestBid)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.ReservePrice != nil {
+			encoded, err := options.Marshal(x.ReservePrice)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.EndTime != nil {
+			encoded, err := options.Marshal(x.EndTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.StartTime != nil {
+			encoded, err := options.Marshal(x.StartTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Duration != nil {
+			encoded, err := options.Marshal(x.Duration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BatchAuctionMetadata)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BatchAuctionMetadata: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BatchAuctionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Duration == nil {
+					x.Duration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Duration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StartTime == nil {
+					x.StartTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StartTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EndTime == nil {
+					x.EndTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EndTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReservePrice", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ReservePrice == nil {
+					x.ReservePrice = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReservePrice); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HighestBid", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.HighestBid == nil {
+					x.HighestBid = &Bid{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.HighestBid); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumBids", wireType)
+				}
+				x.NumBids = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumBids |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SettlementStrategy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SettlementStrategy == nil {
+					x.SettlementStrategy = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SettlementStrategy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoExecute", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AutoExecute = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_BatchAuction_6_list)(nil)
+
+type _BatchAuction_6_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_BatchAuction_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_BatchAuction_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_BatchAuction_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_BatchAuction_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_BatchAuction_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BatchAuction_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_BatchAuction_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BatchAuction_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_BatchAuction               protoreflect.MessageDescriptor
+	fd_BatchAuction_id            protoreflect.FieldDescriptor
+	fd_BatchAuction_legacy_status protoreflect.FieldDescriptor
+	fd_BatchAuction_owner         protoreflect.FieldDescriptor
+	fd_BatchAuction_auction_type  protoreflect.FieldDescriptor
+	fd_BatchAuction_metadata      protoreflect.FieldDescriptor
+	fd_BatchAuction_deposit       protoreflect.FieldDescriptor
+	fd_BatchAuction_status        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fatal_fruit_auction_v1_auctiontypes_proto_init()
+	md_BatchAuction = File_fatal_fruit_auction_v1_auctiontypes_proto.Messages().ByName("BatchAuction")
+	fd_BatchAuction_id = md_BatchAuction.Fields().ByName("id")
+	fd_BatchAuction_legacy_status = md_BatchAuction.Fields().ByName("legacy_status")
+	fd_BatchAuction_owner = md_BatchAuction.Fields().ByName("owner")
+	fd_BatchAuction_auction_type = md_BatchAuction.Fields().ByName("auction_type")
+	fd_BatchAuction_metadata = md_BatchAuction.Fields().ByName("metadata")
+	fd_BatchAuction_deposit = md_BatchAuction.Fields().ByName("deposit")
+	fd_BatchAuction_status = md_BatchAuction.Fields().ByName("status")
+}
+
+var _ protoreflect.Message = (*fastReflection_BatchAuction)(nil)
+
+type fastReflection_BatchAuction BatchAuction
+
+func (x *BatchAuction) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BatchAuction)(x)
+}
+
+func (x *BatchAuction) slowProtoReflect() protoreflect.Message {
+	mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BatchAuction_messageType fastReflection_BatchAuction_messageType
+var _ protoreflect.MessageType = fastReflection_BatchAuction_messageType{}
+
+type fastReflection_BatchAuction_messageType struct{}
+
+func (x fastReflection_BatchAuction_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BatchAuction)(nil)
+}
+func (x fastReflection_BatchAuction_messageType) New() protoreflect.Message {
+	return new(fastReflection_BatchAuction)
+}
+func (x fastReflection_BatchAuction_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BatchAuction
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BatchAuction) Descriptor() protoreflect.MessageDescriptor {
+	return md_BatchAuction
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BatchAuction) Type() protoreflect.MessageType {
+	return _fastReflection_BatchAuction_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BatchAuction) New() protoreflect.Message {
+	return new(fastReflection_BatchAuction)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BatchAuction) Interface() protoreflect.ProtoMessage {
+	return (*BatchAuction)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BatchAuction) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_BatchAuction_id, value) {
+			return
+		}
+	}
+	if x.LegacyStatus != "" {
+		value := protoreflect.ValueOfString(x.LegacyStatus)
+		if !f(fd_BatchAuction_legacy_status, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_BatchAuction_owner, value) {
+			return
+		}
+	}
+	if x.AuctionType != "" {
+		value := protoreflect.ValueOfString(x.AuctionType)
+		if !f(fd_BatchAuction_auction_type, value) {
+			return
+		}
+	}
+	if x.Metadata != nil {
+		value := protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
+		if !f(fd_BatchAuction_metadata, value) {
+			return
+		}
+	}
+	if len(x.Deposit) != 0 {
+		value := protoreflect.ValueOfList(&_BatchAuction_6_list{list: &x.Deposit})
+		if !f(fd_BatchAuction_deposit, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_BatchAuction_status, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BatchAuction) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.BatchAuction.id":
+		return x.Id != uint64(0)
+	case "fatal_fruit.auction.v1.BatchAuction.legacy_status":
+		return x.LegacyStatus != ""
+	case "fatal_fruit.auction.v1.BatchAuction.owner":
+		return x.Owner != ""
+	case "fatal_fruit.auction.v1.BatchAuction.auction_type":
+		return x.AuctionType != ""
+	case "fatal_fruit.auction.v1.BatchAuction.metadata":
+		return x.Metadata != nil
+	case "fatal_fruit.auction.v1.BatchAuction.deposit":
+		return len(x.Deposit) != 0
+	case "fatal_fruit.auction.v1.BatchAuction.status":
+		return x.Status != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.BatchAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.BatchAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BatchAuction) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.BatchAuction.id":
+		x.Id = uint64(0)
+	case "fatal_fruit.auction.v1.BatchAuction.legacy_status":
+		x.LegacyStatus = ""
+	case "fatal_fruit.auction.v1.BatchAuction.owner":
+		x.Owner = ""
+	case "fatal_fruit.auction.v1.BatchAuction.auction_type":
+		x.AuctionType = ""
+	case "fatal_fruit.auction.v1.BatchAuction.metadata":
+		x.Metadata = nil
+	case "fatal_fruit.auction.v1.BatchAuction.deposit":
+		x.Deposit = nil
+	case "fatal_fruit.auction.v1.BatchAuction.status":
+		x.Status = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.BatchAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.BatchAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BatchAuction) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fatal_fruit.auction.v1.BatchAuction.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "fatal_fruit.auction.v1.BatchAuction.legacy_status":
+		value := x.LegacyStatus
+		return protoreflect.ValueOfString(value)
+	case "fatal_fruit.auction.v1.BatchAuction.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "fatal_fruit.auction.v1.BatchAuction.auction_type":
+		value := x.AuctionType
+		return protoreflect.ValueOfString(value)
+	case "fatal_fruit.auction.v1.BatchAuction.metadata":
+		value := x.Metadata
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.BatchAuction.deposit":
+		if len(x.Deposit) == 0 {
+			return protoreflect.ValueOfList(&_BatchAuction_6_list{})
+		}
+		listValue := &_BatchAuction_6_list{list: &x.Deposit}
+		return protoreflect.ValueOfList(listValue)
+	case "fatal_fruit.auction.v1.BatchAuction.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.BatchAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.BatchAuction does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BatchAuction) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.BatchAuction.id":
+		x.Id = value.Uint()
+	case "fatal_fruit.auction.v1.BatchAuction.legacy_status":
+		x.LegacyStatus = value.Interface().(string)
+	case "fatal_fruit.auction.v1.BatchAuction.owner":
+		x.Owner = value.Interface().(string)
+	case "fatal_fruit.auction.v1.BatchAuction.auction_type":
+		x.AuctionType = value.Interface().(string)
+	case "fatal_fruit.auction.v1.BatchAuction.metadata":
+		x.Metadata = value.Message().Interface().(*BatchAuctionMetadata)
+	case "fatal_fruit.auction.v1.BatchAuction.deposit":
+		lv := value.List()
+		clv := lv.(*_BatchAuction_6_list)
+		x.Deposit = *clv.list
+	case "fatal_fruit.auction.v1.BatchAuction.status":
+		x.Status = (AuctionStatus)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.BatchAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.BatchAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BatchAuction) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.BatchAuction.metadata":
+		if x.Metadata == nil {
+			x.Metadata = new(BatchAuctionMetadata)
+		}
+		return protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
+	case "fatal_fruit.auction.v1.BatchAuction.deposit":
+		if x.Deposit == nil {
+			x.Deposit = []*v1beta1.Coin{}
+		}
+		value := &_BatchAuction_6_list{list: &x.Deposit}
+		return protoreflect.ValueOfList(value)
+	case "fatal_fruit.auction.v1.BatchAuction.id":
+		panic(fmt.Errorf("field id of message fatal_fruit.auction.v1.BatchAuction is not mutable"))
+	case "fatal_fruit.auction.v1.BatchAuction.legacy_status":
+		panic(fmt.Errorf("field legacy_status of message fatal_fruit.auction.v1.BatchAuction is not mutable"))
+	case "fatal_fruit.auction.v1.BatchAuction.owner":
+		panic(fmt.Errorf("field owner of message fatal_fruit.auction.v1.BatchAuction is not mutable"))
+	case "fatal_fruit.auction.v1.BatchAuction.auction_type":
+		panic(fmt.Errorf("field auction_type of message fatal_fruit.auction.v1.BatchAuction is not mutable"))
+	case "fatal_fruit.auction.v1.BatchAuction.status":
+		panic(fmt.Errorf("field status of message fatal_fruit.auction.v1.BatchAuction is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.BatchAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.BatchAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BatchAuction) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.BatchAuction.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fatal_fruit.auction.v1.BatchAuction.legacy_status":
+		return protoreflect.ValueOfString("")
+	case "fatal_fruit.auction.v1.BatchAuction.owner":
+		return protoreflect.ValueOfString("")
+	case "fatal_fruit.auction.v1.BatchAuction.auction_type":
+		return protoreflect.ValueOfString("")
+	case "fatal_fruit.auction.v1.BatchAuction.metadata":
+		m := new(BatchAuctionMetadata)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.BatchAuction.deposit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_BatchAuction_6_list{list: &list})
+	case "fatal_fruit.auction.v1.BatchAuction.status":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.BatchAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.BatchAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BatchAuction) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fatal_fruit.auction.v1.BatchAuction", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BatchAuction) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BatchAuction) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BatchAuction) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BatchAuction) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BatchAuction)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.LegacyStatus)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AuctionType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Metadata != nil {
+			l = options.Size(x.Metadata)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Deposit) > 0 {
+			for _, e := range x.Deposit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BatchAuction)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.Deposit) > 0 {
+			for iNdEx := len(x.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Deposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.Metadata != nil {
+			encoded, err := options.Marshal(x.Metadata)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.AuctionType) > 0 {
+			i -= len(x.AuctionType)
+			copy(dAtA[i:], x.AuctionType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AuctionType)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.LegacyStatus) > 0 {
+			i -= len(x.LegacyStatus)
+			copy(dAtA[i:], x.LegacyStatus)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LegacyStatus)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BatchAuction)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BatchAuction: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BatchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LegacyStatus", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LegacyStatus = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AuctionType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Metadata == nil {
+					x.Metadata = &BatchAuctionMetadata{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Metadata); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Deposit = append(x.Deposit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Deposit[len(x.Deposit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= AuctionStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BatchBid          protoreflect.MessageDescriptor
+	fd_BatchBid_quantity protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fatal_fruit_auction_v1_auctiontypes_proto_init()
+	md_BatchBid = File_fatal_fruit_auction_v1_auctiontypes_proto.Messages().ByName("BatchBid")
+	fd_BatchBid_quantity = md_BatchBid.Fields().ByName("quantity")
+}
+
+var _ protoreflect.Message = (*fastReflection_BatchBid)(nil)
+
+type fastReflection_BatchBid BatchBid
+
+func (x *BatchBid) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BatchBid)(x)
+}
+
+func (x *BatchBid) slowProtoReflect() protoreflect.Message {
+	mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BatchBid_messageType fastReflection_BatchBid_messageType
+var _ protoreflect.MessageType = fastReflection_BatchBid_messageType{}
+
+type fastReflection_BatchBid_messageType struct{}
+
+func (x fastReflection_BatchBid_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BatchBid)(nil)
+}
+func (x fastReflection_BatchBid_messageType) New() protoreflect.Message {
+	return new(fastReflection_BatchBid)
+}
+func (x fastReflection_BatchBid_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BatchBid
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BatchBid) Descriptor() protoreflect.MessageDescriptor {
+	return md_BatchBid
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BatchBid) Type() protoreflect.MessageType {
+	return _fastReflection_BatchBid_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BatchBid) New() protoreflect.Message {
+	return new(fastReflection_BatchBid)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BatchBid) Interface() protoreflect.ProtoMessage {
+	return (*BatchBid)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BatchBid) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Quantity != "" {
+		value := protoreflect.ValueOfString(x.Quantity)
+		if !f(fd_BatchBid_quantity, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BatchBid) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.BatchBid.quantity":
+		return x.Quantity != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.BatchBid"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.BatchBid does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BatchBid) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.BatchBid.quantity":
+		x.Quantity = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.BatchBid"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.BatchBid does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BatchBid) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fatal_fruit.auction.v1.BatchBid.quantity":
+		value := x.Quantity
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.BatchBid"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.BatchBid does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BatchBid) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.BatchBid.quantity":
+		x.Quantity = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.BatchBid"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.BatchBid does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BatchBid) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.BatchBid.quantity":
+		panic(fmt.Errorf("field quantity of message fatal_fruit.auction.v1.BatchBid is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.BatchBid"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.BatchBid does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BatchBid) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.BatchBid.quantity":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.BatchBid"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.BatchBid does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BatchBid) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fatal_fruit.auction.v1.BatchBid", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BatchBid) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BatchBid) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BatchBid) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BatchBid) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BatchBid)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Quantity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BatchBid)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Quantity) > 0 {
+			i -= len(x.Quantity)
+			copy(dAtA[i:], x.Quantity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quantity)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BatchBid)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BatchBid: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BatchBid: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Quantity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_UniformPriceStrategy                         protoreflect.MessageDescriptor
+	fd_UniformPriceStrategy_escrow_contract_id      protoreflect.FieldDescriptor
+	fd_UniformPriceStrategy_escrow_contract_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fatal_fruit_auction_v1_auctiontypes_proto_init()
+	md_UniformPriceStrategy = File_fatal_fruit_auction_v1_auctiontypes_proto.Messages().ByName("UniformPriceStrategy")
+	fd_UniformPriceStrategy_escrow_contract_id = md_UniformPriceStrategy.Fields().ByName("escrow_contract_id")
+	fd_UniformPriceStrategy_escrow_contract_address = md_UniformPriceStrategy.Fields().ByName("escrow_contract_address")
+}
+
+var _ protoreflect.Message = (*fastReflection_UniformPriceStrategy)(nil)
+
+type fastReflection_UniformPriceStrategy UniformPriceStrategy
+
+func (x *UniformPriceStrategy) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_UniformPriceStrategy)(x)
+}
+
+func (x *UniformPriceStrategy) slowProtoReflect() protoreflect.Message {
+	mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_UniformPriceStrategy_messageType fastReflection_UniformPriceStrategy_messageType
+var _ protoreflect.MessageType = fastReflection_UniformPriceStrategy_messageType{}
+
+type fastReflection_UniformPriceStrategy_messageType struct{}
+
+func (x fastReflection_UniformPriceStrategy_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_UniformPriceStrategy)(nil)
+}
+func (x fastReflection_UniformPriceStrategy_messageType) New() protoreflect.Message {
+	return new(fastReflection_UniformPriceStrategy)
+}
+func (x fastReflection_UniformPriceStrategy_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_UniformPriceStrategy
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_UniformPriceStrategy) Descriptor() protoreflect.MessageDescriptor {
+	return md_UniformPriceStrategy
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_UniformPriceStrategy) Type() protoreflect.MessageType {
+	return _fastReflection_UniformPriceStrategy_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_UniformPriceStrategy) New() protoreflect.Message {
+	return new(fastReflection_UniformPriceStrategy)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_UniformPriceStrategy) Interface() protoreflect.ProtoMessage {
+	return (*UniformPriceStrategy)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_UniformPriceStrategy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.EscrowContractId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EscrowContractId)
+		if !f(fd_UniformPriceStrategy_escrow_contract_id, value) {
+			return
+		}
+	}
+	if x.EscrowContractAddress != "" {
+		value := protoreflect.ValueOfString(x.EscrowContractAddress)
+		if !f(fd_UniformPriceStrategy_escrow_contract_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_UniformPriceStrategy) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.UniformPriceStrategy.escrow_contract_id":
+		return x.EscrowContractId != uint64(0)
+	case "fatal_fruit.auction.v1.UniformPriceStrategy.escrow_contract_address":
+		return x.EscrowContractAddress != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.UniformPriceStrategy"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.UniformPriceStrategy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UniformPriceStrategy) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.UniformPriceStrategy.escrow_contract_id":
+		x.EscrowContractId = uint64(0)
+	case "fatal_fruit.auction.v1.UniformPriceStrategy.escrow_contract_address":
+		x.EscrowContractAddress = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.UniformPriceStrategy"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.UniformPriceStrategy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_UniformPriceStrategy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fatal_fruit.auction.v1.UniformPriceStrategy.escrow_contract_id":
+		value := x.EscrowContractId
+		return protoreflect.ValueOfUint64(value)
+	case "fatal_fruit.auction.v1.UniformPriceStrategy.escrow_contract_address":
+		value := x.EscrowContractAddress
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.UniformPriceStrategy"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.UniformPriceStrategy does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UniformPriceStrategy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.UniformPriceStrategy.escrow_contract_id":
+		x.EscrowContractId = value.Uint()
+	case "fatal_fruit.auction.v1.UniformPriceStrategy.escrow_contract_address":
+		x.EscrowContractAddress = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.UniformPriceStrategy"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.UniformPriceStrategy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UniformPriceStrategy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.UniformPriceStrategy.escrow_contract_id":
+		panic(fmt.Errorf("field escrow_contract_id of message fatal_fruit.auction.v1.UniformPriceStrategy is not mutable"))
+	case "fatal_fruit.auction.v1.UniformPriceStrategy.escrow_contract_address":
+		panic(fmt.Errorf("field escrow_contract_address of message fatal_fruit.auction.v1.UniformPriceStrategy is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.UniformPriceStrategy"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.UniformPriceStrategy does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_UniformPriceStrategy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.UniformPriceStrategy.escrow_contract_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fatal_fruit.auction.v1.UniformPriceStrategy.escrow_contract_address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.UniformPriceStrategy"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.UniformPriceStrategy does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_UniformPriceStrategy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fatal_fruit.auction.v1.UniformPriceStrategy", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_UniformPriceStrategy) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UniformPriceStrategy) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_UniformPriceStrategy) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_UniformPriceStrategy) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*UniformPriceStrategy)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.EscrowContractId != 0 {
+			n += 1 + runtime.Sov(uint64(x.EscrowContractId))
+		}
+		l = len(x.EscrowContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*UniformPriceStrategy)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.EscrowContractAddress) > 0 {
+			i -= len(x.EscrowContractAddress)
+			copy(dAtA[i:], x.EscrowContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EscrowContractAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.EscrowContractId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EscrowContractId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*UniformPriceStrategy)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UniformPriceStrategy: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UniformPriceStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EscrowContractId", wireType)
+				}
+				x.EscrowContractId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EscrowContractId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EscrowContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EscrowContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ReverseAuctionMetadata                     protoreflect.MessageDescriptor
+	fd_ReverseAuctionMetadata_duration            protoreflect.FieldDescriptor
+	fd_ReverseAuctionMetadata_start_time          protoreflect.FieldDescriptor
+	fd_ReverseAuctionMetadata_end_time            protoreflect.FieldDescriptor
+	fd_ReverseAuctionMetadata_ceiling_price       protoreflect.FieldDescriptor
+	fd_ReverseAuctionMetadata_lowest_bid          protoreflect.FieldDescriptor
+	fd_ReverseAuctionMetadata_num_bids            protoreflect.FieldDescriptor
+	fd_ReverseAuctionMetadata_settlement_strategy protoreflect.FieldDescriptor
+	fd_ReverseAuctionMetadata_auto_execute        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fatal_fruit_auction_v1_auctiontypes_proto_init()
+	md_ReverseAuctionMetadata = File_fatal_fruit_auction_v1_auctiontypes_proto.Messages().ByName("ReverseAuctionMetadata")
+	fd_ReverseAuctionMetadata_duration = md_ReverseAuctionMetadata.Fields().ByName("duration")
+	fd_ReverseAuctionMetadata_start_time = md_ReverseAuctionMetadata.Fields().ByName("start_time")
+	fd_ReverseAuctionMetadata_end_time = md_ReverseAuctionMetadata.Fields().ByName("end_time")
+	fd_ReverseAuctionMetadata_ceiling_price = md_ReverseAuctionMetadata.Fields().ByName("ceiling_price")
+	fd_ReverseAuctionMetadata_lowest_bid = md_ReverseAuctionMetadata.Fields().ByName("lowest_bid")
+	fd_ReverseAuctionMetadata_num_bids = md_ReverseAuctionMetadata.Fields().ByName("num_bids")
+	fd_ReverseAuctionMetadata_settlement_strategy = md_ReverseAuctionMetadata.Fields().ByName("settlement_strategy")
+	fd_ReverseAuctionMetadata_auto_execute = md_ReverseAuctionMetadata.Fields().ByName("auto_execute")
+}
+
+var _ protoreflect.Message = (*fastReflection_ReverseAuctionMetadata)(nil)
+
+type fastReflection_ReverseAuctionMetadata ReverseAuctionMetadata
+
+func (x *ReverseAuctionMetadata) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ReverseAuctionMetadata)(x)
+}
+
+func (x *ReverseAuctionMetadata) slowProtoReflect() protoreflect.Message {
+	mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ReverseAuctionMetadata_messageType fastReflection_ReverseAuctionMetadata_messageType
+var _ protoreflect.MessageType = fastReflection_ReverseAuctionMetadata_messageType{}
+
+type fastReflection_ReverseAuctionMetadata_messageType struct{}
+
+func (x fastReflection_ReverseAuctionMetadata_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ReverseAuctionMetadata)(nil)
+}
+func (x fastReflection_ReverseAuctionMetadata_messageType) New() protoreflect.Message {
+	return new(fastReflection_ReverseAuctionMetadata)
+}
+func (x fastReflection_ReverseAuctionMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReverseAuctionMetadata
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ReverseAuctionMetadata) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReverseAuctionMetadata
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ReverseAuctionMetadata) Type() protoreflect.MessageType {
+	return _fastReflection_ReverseAuctionMetadata_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ReverseAuctionMetadata) New() protoreflect.Message {
+	return new(fastReflection_ReverseAuctionMetadata)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ReverseAuctionMetadata) Interface() protoreflect.ProtoMessage {
+	return (*ReverseAuctionMetadata)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ReverseAuctionMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Duration != nil {
+		value := protoreflect.ValueOfMessage(x.Duration.ProtoReflect())
+		if !f(fd_ReverseAuctionMetadata_duration, value) {
+			return
+		}
+	}
+	if x.StartTime != nil {
+		value := protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+		if !f(fd_ReverseAuctionMetadata_start_time, value) {
+			return
+		}
+	}
+	if x.EndTime != nil {
+		value := protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+		if !f(fd_ReverseAuctionMetadata_end_time, value) {
+			return
+		}
+	}
+	if x.CeilingPrice != nil {
+		value := protoreflect.ValueOfMessage(x.CeilingPrice.ProtoReflect())
+		if !f(fd_ReverseAuctionMetadata_ceiling_price, value) {
+			return
+		}
+	}
+	if x.LowestBid != nil {
+		value := protoreflect.ValueOfMessage(x.LowestBid.ProtoReflect())
+		if !f(fd_ReverseAuctionMetadata_lowest_bid, value) {
+			return
+		}
+	}
+	if x.NumBids != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NumBids)
+		if !f(fd_ReverseAuctionMetadata_num_bids, value) {
+			return
+		}
+	}
+	if x.SettlementStrategy != nil {
+		value := protoreflect.ValueOfMessage(x.SettlementStrategy.ProtoReflect())
+		if !f(fd_ReverseAuctionMetadata_settlement_strategy, value) {
+			return
+		}
+	}
+	if x.AutoExecute != false {
+		value := protoreflect.ValueOfBool(x.AutoExecute)
+		if !f(fd_ReverseAuctionMetadata_auto_execute, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ReverseAuctionMetadata) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.duration":
+		return x.Duration != nil
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.start_time":
+		return x.StartTime != nil
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.end_time":
+		return x.EndTime != nil
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.ceiling_price":
+		return x.CeilingPrice != nil
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.lowest_bid":
+		return x.LowestBid != nil
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.num_bids":
+		return x.NumBids != uint64(0)
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.settlement_strategy":
+		return x.SettlementStrategy != nil
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.auto_execute":
+		return x.AutoExecute != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReverseAuctionMetadata"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.ReverseAuctionMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReverseAuctionMetadata) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.duration":
+		x.Duration = nil
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.start_time":
+		x.StartTime = nil
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.end_time":
+		x.EndTime = nil
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.ceiling_price":
+		x.CeilingPrice = nil
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.lowest_bid":
+		x.LowestBid = nil
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.num_bids":
+		x.NumBids = uint64(0)
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.settlement_strategy":
+		x.SettlementStrategy = nil
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.auto_execute":
+		x.AutoExecute = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReverseAuctionMetadata"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.ReverseAuctionMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ReverseAuctionMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.duration":
+		value := x.Duration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.start_time":
+		value := x.StartTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.end_time":
+		value := x.EndTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.ceiling_price":
+		value := x.CeilingPrice
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.lowest_bid":
+		value := x.LowestBid
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.num_bids":
+		value := x.NumBids
+		return protoreflect.ValueOfUint64(value)
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.settlement_strategy":
+		value := x.SettlementStrategy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.auto_execute":
+		value := x.AutoExecute
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReverseAuctionMetadata"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.ReverseAuctionMetadata does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReverseAuctionMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.duration":
+		x.Duration = value.Message().Interface().(*durationpb.Duration)
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.start_time":
+		x.StartTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.end_time":
+		x.EndTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.ceiling_price":
+		x.CeilingPrice = value.Message().Interface().(*v1beta1.Coin)
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.lowest_bid":
+		x.LowestBid = value.Message().Interface().(*Bid)
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.num_bids":
+		x.NumBids = value.Uint()
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.settlement_strategy":
+		x.SettlementStrategy = value.Message().Interface().(*anypb.Any)
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.auto_execute":
+		x.AutoExecute = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReverseAuctionMetadata"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.ReverseAuctionMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReverseAuctionMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.duration":
+		if x.Duration == nil {
+			x.Duration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.Duration.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.start_time":
+		if x.StartTime == nil {
+			x.StartTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.end_time":
+		if x.EndTime == nil {
+			x.EndTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.ceiling_price":
+		if x.CeilingPrice == nil {
+			x.CeilingPrice = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.CeilingPrice.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.lowest_bid":
+		if x.LowestBid == nil {
+			x.LowestBid = new(Bid)
+		}
+		return protoreflect.ValueOfMessage(x.LowestBid.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.settlement_strategy":
+		if x.SettlementStrategy == nil {
+			x.SettlementStrategy = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.SettlementStrategy.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.num_bids":
+		panic(fmt.Errorf("field num_bids of message fatal_fruit.auction.v1.ReverseAuctionMetadata is not mutable"))
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.auto_execute":
+		panic(fmt.Errorf("field auto_execute of message fatal_fruit.auction.v1.ReverseAuctionMetadata is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReverseAuctionMetadata"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.ReverseAuctionMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ReverseAuctionMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.start_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.end_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.ceiling_price":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.lowest_bid":
+		m := new(Bid)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.num_bids":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.settlement_strategy":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReverseAuctionMetadata.auto_execute":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReverseAuctionMetadata"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.ReverseAuctionMetadata does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ReverseAuctionMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fatal_fruit.auction.v1.ReverseAuctionMetadata", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ReverseAuctionMetadata) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReverseAuctionMetadata) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ReverseAuctionMetadata) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ReverseAuctionMetadata) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ReverseAuctionMetadata)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Duration != nil {
+			l = options.Size(x.Duration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StartTime != nil {
+			l = options.Size(x.StartTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EndTime != nil {
+			l = options.Size(x.EndTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CeilingPrice != nil {
+			l = options.Size(x.CeilingPrice)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LowestBid != nil {
+			l = options.Size(x.LowestBid)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NumBids != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumBids))
+		}
+		if x.SettlementStrategy != nil {
+			l = options.Size(x.SettlementStrategy)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AutoExecute {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ReverseAuctionMetadata)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AutoExecute {
+			i--
+			if x.AutoExecute {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.SettlementStrategy != nil {
+			encoded, err := options.Marshal(x.SettlementStrategy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.NumBids != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumBids))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.LowestBid != nil {
+			encoded, err := options.Marshal(x.LowestBid)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.CeilingPrice != nil {
+			encoded, err := options.Marshal(x.CeilingPrice)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.EndTime != nil {
+			encoded, err := options.Marshal(x.EndTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.StartTime != nil {
+			encoded, err := options.Marshal(x.StartTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Duration != nil {
+			encoded, err := options.Marshal(x.Duration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ReverseAuctionMetadata)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReverseAuctionMetadata: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReverseAuctionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Duration == nil {
+					x.Duration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Duration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StartTime == nil {
+					x.StartTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StartTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EndTime == nil {
+					x.EndTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EndTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CeilingPrice", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CeilingPrice == nil {
+					x.CeilingPrice = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CeilingPrice); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LowestBid", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LowestBid == nil {
+					x.LowestBid = &Bid{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LowestBid); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumBids", wireType)
+				}
+				x.NumBids = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumBids |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SettlementStrategy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SettlementStrategy == nil {
+					x.SettlementStrategy = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SettlementStrategy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoExecute", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AutoExecute = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ReverseAuction_6_list)(nil)
+
+type _ReverseAuction_6_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_ReverseAuction_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ReverseAuction_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ReverseAuction_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ReverseAuction_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ReverseAuction_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ReverseAuction_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ReverseAuction_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ReverseAuction_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ReverseAuction               protoreflect.MessageDescriptor
+	fd_ReverseAuction_id            protoreflect.FieldDescriptor
+	fd_ReverseAuction_legacy_status protoreflect.FieldDescriptor
+	fd_ReverseAuction_owner         protoreflect.FieldDescriptor
+	fd_ReverseAuction_auction_type  protoreflect.FieldDescriptor
+	fd_ReverseAuction_metadata      protoreflect.FieldDescriptor
+	fd_ReverseAuction_deposit       protoreflect.FieldDescriptor
+	fd_ReverseAuction_status        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fatal_fruit_auction_v1_auctiontypes_proto_init()
+	md_ReverseAuction = File_fatal_fruit_auction_v1_auctiontypes_proto.Messages().ByName("ReverseAuction")
+	fd_ReverseAuction_id = md_ReverseAuction.Fields().ByName("id")
+	fd_ReverseAuction_legacy_status = md_ReverseAuction.Fields().ByName("legacy_status")
+	fd_ReverseAuction_owner = md_ReverseAuction.Fields().ByName("owner")
+	fd_ReverseAuction_auction_type = md_ReverseAuction.Fields().ByName("auction_type")
+	fd_ReverseAuction_metadata = md_ReverseAuction.Fields().ByName("metadata")
+	fd_ReverseAuction_deposit = md_ReverseAuction.Fields().ByName("deposit")
+	fd_ReverseAuction_status = md_ReverseAuction.Fields().ByName("status")
+}
+
+var _ protoreflect.Message = (*fastReflection_ReverseAuction)(nil)
+
+type fastReflection_ReverseAuction ReverseAuction
+
+func (x *ReverseAuction) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ReverseAuction)(x)
+}
+
+func (x *ReverseAuction) slowProtoReflect() protoreflect.Message {
+	mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ReverseAuction_messageType fastReflection_ReverseAuction_messageType
+var _ protoreflect.MessageType = fastReflection_ReverseAuction_messageType{}
+
+type fastReflection_ReverseAuction_messageType struct{}
+
+func (x fastReflection_ReverseAuction_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ReverseAuction)(nil)
+}
+func (x fastReflection_ReverseAuction_messageType) New() protoreflect.Message {
+	return new(fastReflection_ReverseAuction)
+}
+func (x fastReflection_ReverseAuction_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReverseAuction
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ReverseAuction) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReverseAuction
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ReverseAuction) Type() protoreflect.MessageType {
+	return _fastReflection_ReverseAuction_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ReverseAuction) New() protoreflect.Message {
+	return new(fastReflection_ReverseAuction)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ReverseAuction) Interface() protoreflect.ProtoMessage {
+	return (*ReverseAuction)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ReverseAuction) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_ReverseAuction_id, value) {
+			return
+		}
+	}
+	if x.LegacyStatus != "" {
+		value := protoreflect.ValueOfString(x.LegacyStatus)
+		if !f(fd_ReverseAuction_legacy_status, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_ReverseAuction_owner, value) {
+			return
+		}
+	}
+	if x.AuctionType != "" {
+		value := protoreflect.ValueOfString(x.AuctionType)
+		if !f(fd_ReverseAuction_auction_type, value) {
+			return
+		}
+	}
+	if x.Metadata != nil {
+		value := protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
+		if !f(fd_ReverseAuction_metadata, value) {
+			return
+		}
+	}
+	if len(x.Deposit) != 0 {
+		value := protoreflect.ValueOfList(&_ReverseAuction_6_list{list: &x.Deposit})
+		if !f(fd_ReverseAuction_deposit, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_ReverseAuction_status, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ReverseAuction) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.ReverseAuction.id":
+		return x.Id != uint64(0)
+	case "fatal_fruit.auction.v1.ReverseAuction.legacy_status":
+		return x.LegacyStatus != ""
+	case "fatal_fruit.auction.v1.ReverseAuction.owner":
+		return x.Owner != ""
+	case "fatal_fruit.auction.v1.ReverseAuction.auction_type":
+		return x.AuctionType != ""
+	case "fatal_fruit.auction.v1.ReverseAuction.metadata":
+		return x.Metadata != nil
+	case "fatal_fruit.auction.v1.ReverseAuction.deposit":
+		return len(x.Deposit) != 0
+	case "fatal_fruit.auction.v1.ReverseAuction.status":
+		return x.Status != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReverseAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.ReverseAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReverseAuction) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.ReverseAuction.id":
+		x.Id = uint64(0)
+	case "fatal_fruit.auction.v1.ReverseAuction.legacy_status":
+		x.LegacyStatus = ""
+	case "fatal_fruit.auction.v1.ReverseAuction.owner":
+		x.Owner = ""
+	case "fatal_fruit.auction.v1.ReverseAuction.auction_type":
+		x.AuctionType = ""
+	case "fatal_fruit.auction.v1.ReverseAuction.metadata":
+		x.Metadata = nil
+	case "fatal_fruit.auction.v1.ReverseAuction.deposit":
+		x.Deposit = nil
+	case "fatal_fruit.auction.v1.ReverseAuction.status":
+		x.Status = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReverseAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.ReverseAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ReverseAuction) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fatal_fruit.auction.v1.ReverseAuction.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "fatal_fruit.auction.v1.ReverseAuction.legacy_status":
+		value := x.LegacyStatus
+		return protoreflect.ValueOfString(value)
+	case "fatal_fruit.auction.v1.ReverseAuction.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "fatal_fruit.auction.v1.ReverseAuction.auction_type":
+		value := x.AuctionType
+		return protoreflect.ValueOfString(value)
+	case "fatal_fruit.auction.v1.ReverseAuction.metadata":
+		value := x.Metadata
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReverseAuction.deposit":
+		if len(x.Deposit) == 0 {
+			return protoreflect.ValueOfList(&_ReverseAuction_6_list{})
+		}
+		listValue := &_ReverseAuction_6_list{list: &x.Deposit}
+		return protoreflect.ValueOfList(listValue)
+	case "fatal_fruit.auction.v1.ReverseAuction.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReverseAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.ReverseAuction does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReverseAuction) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.ReverseAuction.id":
+		x.Id = value.Uint()
+	case "fatal_fruit.auction.v1.ReverseAuction.legacy_status":
+		x.LegacyStatus = value.Interface().(string)
+	case "fatal_fruit.auction.v1.ReverseAuction.owner":
+		x.Owner = value.Interface().(string)
+	case "fatal_fruit.auction.v1.ReverseAuction.auction_type":
+		x.AuctionType = value.Interface().(string)
+	case "fatal_fruit.auction.v1.ReverseAuction.metadata":
+		x.Metadata = value.Message().Interface().(*ReverseAuctionMetadata)
+	case "fatal_fruit.auction.v1.ReverseAuction.deposit":
+		lv := value.List()
+		clv := lv.(*_ReverseAuction_6_list)
+		x.Deposit = *clv.list
+	case "fatal_fruit.auction.v1.ReverseAuction.status":
+		x.Status = (AuctionStatus)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReverseAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.ReverseAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReverseAuction) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.ReverseAuction.metadata":
+		if x.Metadata == nil {
+			x.Metadata = new(ReverseAuctionMetadata)
+		}
+		return protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReverseAuction.deposit":
+		if x.Deposit == nil {
+			x.Deposit = []*v1beta1.Coin{}
+		}
+		value := &_ReverseAuction_6_list{list: &x.Deposit}
+		return protoreflect.ValueOfList(value)
+	case "fatal_fruit.auction.v1.ReverseAuction.id":
+		panic(fmt.Errorf("field id of message fatal_fruit.auction.v1.ReverseAuction is not mutable"))
+	case "fatal_fruit.auction.v1.ReverseAuction.legacy_status":
+		panic(fmt.Errorf("field legacy_status of message fatal_fruit.auction.v1.ReverseAuction is not mutable"))
+	case "fatal_fruit.auction.v1.ReverseAuction.owner":
+		panic(fmt.Errorf("field owner of message fatal_fruit.auction.v1.ReverseAuction is not mutable"))
+	case "fatal_fruit.auction.v1.ReverseAuction.auction_type":
+		panic(fmt.Errorf("field auction_type of message fatal_fruit.auction.v1.ReverseAuction is not mutable"))
+	case "fatal_fruit.auction.v1.ReverseAuction.status":
+		panic(fmt.Errorf("field status of message fatal_fruit.auction.v1.ReverseAuction is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReverseAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.ReverseAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ReverseAuction) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.ReverseAuction.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fatal_fruit.auction.v1.ReverseAuction.legacy_status":
+		return protoreflect.ValueOfString("")
+	case "fatal_fruit.auction.v1.ReverseAuction.owner":
+		return protoreflect.ValueOfString("")
+	case "fatal_fruit.auction.v1.ReverseAuction.auction_type":
+		return protoreflect.ValueOfString("")
+	case "fatal_fruit.auction.v1.ReverseAuction.metadata":
+		m := new(ReverseAuctionMetadata)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.ReverseAuction.deposit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_ReverseAuction_6_list{list: &list})
+	case "fatal_fruit.auction.v1.ReverseAuction.status":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReverseAuction"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.ReverseAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ReverseAuction) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fatal_fruit.auction.v1.ReverseAuction", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ReverseAuction) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReverseAuction) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ReverseAuction) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ReverseAuction) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ReverseAuction)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.LegacyStatus)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AuctionType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Metadata != nil {
+			l = options.Size(x.Metadata)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Deposit) > 0 {
+			for _, e := range x.Deposit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ReverseAuction)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.Deposit) > 0 {
+			for iNdEx := len(x.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Deposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.Metadata != nil {
+			encoded, err := options.Marshal(x.Metadata)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.AuctionType) > 0 {
+			i -= len(x.AuctionType)
+			copy(dAtA[i:], x.AuctionType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AuctionType)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.LegacyStatus) > 0 {
+			i -= len(x.LegacyStatus)
+			copy(dAtA[i:], x.LegacyStatus)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LegacyStatus)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ReverseAuction)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReverseAuction: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReverseAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LegacyStatus", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LegacyStatus = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AuctionType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Metadata == nil {
+					x.Metadata = &ReverseAuctionMetadata{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Metadata); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Deposit = append(x.Deposit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Deposit[len(x.Deposit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= AuctionStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ProcurementStrategy                         protoreflect.MessageDescriptor
+	fd_ProcurementStrategy_escrow_contract_id      protoreflect.FieldDescriptor
+	fd_ProcurementStrategy_escrow_contract_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_fatal_fruit_auction_v1_auctiontypes_proto_init()
+	md_ProcurementStrategy = File_fatal_fruit_auction_v1_auctiontypes_proto.Messages().ByName("ProcurementStrategy")
+	fd_ProcurementStrategy_escrow_contract_id = md_ProcurementStrategy.Fields().ByName("escrow_contract_id")
+	fd_ProcurementStrategy_escrow_contract_address = md_ProcurementStrategy.Fields().ByName("escrow_contract_address")
+}
+
+var _ protoreflect.Message = (*fastReflection_ProcurementStrategy)(nil)
+
+type fastReflection_ProcurementStrategy ProcurementStrategy
+
+func (x *ProcurementStrategy) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ProcurementStrategy)(x)
+}
+
+func (x *ProcurementStrategy) slowProtoReflect() protoreflect.Message {
+	mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ProcurementStrategy_messageType fastReflection_ProcurementStrategy_messageType
+var _ protoreflect.MessageType = fastReflection_ProcurementStrategy_messageType{}
+
+type fastReflection_ProcurementStrategy_messageType struct{}
+
+func (x fastReflection_ProcurementStrategy_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ProcurementStrategy)(nil)
+}
+func (x fastReflection_ProcurementStrategy_messageType) New() protoreflect.Message {
+	return new(fastReflection_ProcurementStrategy)
+}
+func (x fastReflection_ProcurementStrategy_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProcurementStrategy
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ProcurementStrategy) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProcurementStrategy
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ProcurementStrategy) Type() protoreflect.MessageType {
+	return _fastReflection_ProcurementStrategy_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ProcurementStrategy) New() protoreflect.Message {
+	return new(fastReflection_ProcurementStrategy)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ProcurementStrategy) Interface() protoreflect.ProtoMessage {
+	return (*ProcurementStrategy)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ProcurementStrategy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.EscrowContractId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EscrowContractId)
+		if !f(fd_ProcurementStrategy_escrow_contract_id, value) {
+			return
+		}
+	}
+	if x.EscrowContractAddress != "" {
+		value := protoreflect.ValueOfString(x.EscrowContractAddress)
+		if !f(fd_ProcurementStrategy_escrow_contract_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ProcurementStrategy) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.ProcurementStrategy.escrow_contract_id":
+		return x.EscrowContractId != uint64(0)
+	case "fatal_fruit.auction.v1.ProcurementStrategy.escrow_contract_address":
+		return x.EscrowContractAddress != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ProcurementStrategy"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.ProcurementStrategy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProcurementStrategy) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.ProcurementStrategy.escrow_contract_id":
+		x.EscrowContractId = uint64(0)
+	case "fatal_fruit.auction.v1.ProcurementStrategy.escrow_contract_address":
+		x.EscrowContractAddress = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ProcurementStrategy"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.ProcurementStrategy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ProcurementStrategy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "fatal_fruit.auction.v1.ProcurementStrategy.escrow_contract_id":
+		value := x.EscrowContractId
+		return protoreflect.ValueOfUint64(value)
+	case "fatal_fruit.auction.v1.ProcurementStrategy.escrow_contract_address":
+		value := x.EscrowContractAddress
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ProcurementStrategy"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.ProcurementStrategy does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProcurementStrategy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.ProcurementStrategy.escrow_contract_id":
+		x.EscrowContractId = value.Uint()
+	case "fatal_fruit.auction.v1.ProcurementStrategy.escrow_contract_address":
+		x.EscrowContractAddress = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ProcurementStrategy"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.ProcurementStrategy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProcurementStrategy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.ProcurementStrategy.escrow_contract_id":
+		panic(fmt.Errorf("field escrow_contract_id of message fatal_fruit.auction.v1.ProcurementStrategy is not mutable"))
+	case "fatal_fruit.auction.v1.ProcurementStrategy.escrow_contract_address":
+		panic(fmt.Errorf("field escrow_contract_address of message fatal_fruit.auction.v1.ProcurementStrategy is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ProcurementStrategy"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.ProcurementStrategy does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ProcurementStrategy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "fatal_fruit.auction.v1.ProcurementStrategy.escrow_contract_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "fatal_fruit.auction.v1.ProcurementStrategy.escrow_contract_address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ProcurementStrategy"))
+		}
+		panic(fmt.Errorf("message fatal_fruit.auction.v1.ProcurementStrategy does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ProcurementStrategy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in fatal_fruit.auction.v1.ProcurementStrategy", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ProcurementStrategy) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProcurementStrategy) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ProcurementStrategy) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ProcurementStrategy) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ProcurementStrategy)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.EscrowContractId != 0 {
+			n += 1 + runtime.Sov(uint64(x.EscrowContractId))
+		}
+		l = len(x.EscrowContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ProcurementStrategy)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.EscrowContractAddress) > 0 {
+			i -= len(x.EscrowContractAddress)
+			copy(dAtA[i:], x.EscrowContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EscrowContractAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.EscrowContractId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EscrowContractId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ProcurementStrategy)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProcurementStrategy: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProcurementStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EscrowContractId", wireType)
+				}
+				x.EscrowContractId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EscrowContractId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EscrowContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EscrowContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: fatal_fruit/auction/v1/auctiontypes.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DecrementSchedule defines how the price of a Dutch auction falls over its duration.
+type DecrementSchedule int32
+
+const (
+	// DECREMENT_SCHEDULE_UNSPECIFIED is an invalid schedule.
+	DecrementSchedule_DECREMENT_SCHEDULE_UNSPECIFIED DecrementSchedule = 0
+	// DECREMENT_SCHEDULE_LINEAR lowers the price continuously from the start price to the
+	// floor price over the auction duration.
+	DecrementSchedule_DECREMENT_SCHEDULE_LINEAR DecrementSchedule = 1
+	// DECREMENT_SCHEDULE_STEPWISE lowers the price by a fixed decrement every step interval
+	// until it reaches the floor price.
+	DecrementSchedule_DECREMENT_SCHEDULE_STEPWISE DecrementSchedule = 2
+)
+
+// Enum value maps for DecrementSchedule.
+var (
+	DecrementSchedule_name = map[int32]string{
+		0: "DECREMENT_SCHEDULE_UNSPECIFIED",
+		1: "DECREMENT_SCHEDULE_LINEAR",
+		2: "DECREMENT_SCHEDULE_STEPWISE",
+	}
+	DecrementSchedule_value = map[string]int32{
+		"DECREMENT_SCHEDULE_UNSPECIFIED": 0,
+		"DECREMENT_SCHEDULE_LINEAR":      1,
+		"DECREMENT_SCHEDULE_STEPWISE":    2,
+	}
+)
+
+func (x DecrementSchedule) Enum() *DecrementSchedule {
+	p := new(DecrementSchedule)
+	*p = x
+	return p
+}
+
+func (x DecrementSchedule) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DecrementSchedule) Descriptor() protoreflect.EnumDescriptor {
+	return file_fatal_fruit_auction_v1_auctiontypes_proto_enumTypes[0].Descriptor()
+}
+
+func (DecrementSchedule) Type() protoreflect.EnumType {
+	return &file_fatal_fruit_auction_v1_auctiontypes_proto_enumTypes[0]
+}
+
+func (x DecrementSchedule) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DecrementSchedule.Descriptor instead.
+func (DecrementSchedule) EnumDescriptor() ([]byte, []int) {
+	return file_fatal_fruit_auction_v1_auctiontypes_proto_rawDescGZIP(), []int{0}
+}
+
+// UnrevealedBidPolicy defines what happens to the collateral of sealed bids that are not
+// revealed before the reveal phase ends.
+type UnrevealedBidPolicy int32
+
+const (
+	// UNREVEALED_BID_POLICY_UNSPECIFIED is an invalid policy.
+	UnrevealedBidPolicy_UNREVEALED_BID_POLICY_UNSPECIFIED UnrevealedBidPolicy = 0
+	// UNREVEALED_BID_POLICY_REFUND returns the collateral to the bidder.
+	UnrevealedBidPolicy_UNREVEALED_BID_POLICY_REFUND UnrevealedBidPolicy = 1
+	// UNREVEALED_BID_POLICY_FORFEIT pays the collateral to the auction owner.
+	UnrevealedBidPolicy_UNREVEALED_BID_POLICY_FORFEIT UnrevealedBidPolicy = 2
+)
+
+// Enum value maps for UnrevealedBidPolicy.
+var (
+	UnrevealedBidPolicy_name = map[int32]string{
+		0: "UNREVEALED_BID_POLICY_UNSPECIFIED",
+		1: "UNREVEALED_BID_POLICY_REFUND",
+		2: "UNREVEALED_BID_POLICY_FORFEIT",
+	}
+	UnrevealedBidPolicy_value = map[string]int32{
+		"UNREVEALED_BID_POLICY_UNSPECIFIED": 0,
+		"UNREVEALED_BID_POLICY_REFUND":      1,
+		"UNREVEALED_BID_POLICY_FORFEIT":     2,
+	}
+)
+
+func (x UnrevealedBidPolicy) Enum() *UnrevealedBidPolicy {
+	p := new(UnrevealedBidPolicy)
+	*p = x
+	return p
+}
+
+func (x UnrevealedBidPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnrevealedBidPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_fatal_fruit_auction_v1_auctiontypes_proto_enumTypes[1].Descriptor()
+}
+
+func (UnrevealedBidPolicy) Type() protoreflect.EnumType {
+	return &file_fatal_fruit_auction_v1_auctiontypes_proto_enumTypes[1]
+}
+
+func (x UnrevealedBidPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnrevealedBidPolicy.Descriptor instead.
+func (UnrevealedBidPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_fatal_fruit_auction_v1_auctiontypes_proto_rawDescGZIP(), []int{1}
+}
+
+type ReserveAuctionMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// duration specifies the time duration of the auction.
+	Duration *durationpb.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	// start_time and end_time are calculated from the contract duration
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// reserve_price is the minimum price for the auction.
+	ReservePrice *v1beta1.Coin `protobuf:"bytes,3,opt,name=reserve_price,json=reservePrice,proto3" json:"reserve_price,omitempty"`
+	// Deprecated: bids are stored in the module's bids collection. This field is
+	// only read when migrating auctions created before bids were moved out.
+	//
+	// Deprecated: Do not use.
+	Bids      []*Bid        `protobuf:"bytes,9,rep,name=bids,proto3" json:"bids,omitempty"`
+	LastPrice *v1beta1.Coin `protobuf:"bytes,10,opt,name=last_price,json=lastPrice,proto3" json:"last_price,omitempty"`
+	// Deprecated: replaced by settlement_strategy. This field is only read when migrating
+	// auctions created before strategies were stored as Anys.
+	//
+	// Deprecated: Do not use.
+	Strategy *SettleStrategy `protobuf:"bytes,11,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// refund_on_outbid returns a bidder's escrowed funds as soon as a higher bid is
+	// accepted. When false, all losing bids are refunded when the auction is settled.
+	RefundOnOutbid bool `protobuf:"varint,12,opt,name=refund_on_outbid,json=refundOnOutbid,proto3" json:"refund_on_outbid,omitempty"`
+	// highest_bid is the currently leading bid.
+	HighestBid *Bid `protobuf:"bytes,13,opt,name=highest_bid,json=highestBid,proto3" json:"highest_bid,omitempty"`
+	// num_bids is the number of accepted bids and the sequence of the next bid.
+	NumBids uint64 `protobuf:"varint,14,opt,name=num_bids,json=numBids,proto3" json:"num_bids,omitempty"`
+	// strategy_type selects how the auction is settled when no settlement_strategy is
+	// given: SETTLE (the default) charges the winner their own bid, SECOND_PRICE charges the
+	// highest competing bid.
+	StrategyType string `protobuf:"bytes,15,opt,name=strategy_type,json=strategyType,proto3" json:"strategy_type,omitempty"`
+	// settlement_strategy is the strategy used to settle the auction, selected by its type
+	// URL. When creating an auction it may be left empty to use a SettleStrategy.
+	SettlementStrategy *anypb.Any `protobuf:"bytes,16,opt,name=settlement_strategy,json=settlementStrategy,proto3" json:"settlement_strategy,omitempty"`
+	// extension_window is the period before end_time in which an accepted bid extends the
+	// auction. A zero window disables extensions.
+	ExtensionWindow *durationpb.Duration `protobuf:"bytes,17,opt,name=extension_window,json=extensionWindow,proto3" json:"extension_window,omitempty"`
+	// extension_duration is the amount of time end_time is pushed forward by a bid placed
+	// within the extension window.
+	ExtensionDuration *durationpb.Duration `protobuf:"bytes,18,opt,name=extension_duration,json=extensionDuration,proto3" json:"extension_duration,omitempty"`
+	// max_extensions caps the number of times the auction can be extended. Zero allows
+	// unlimited extensions.
+	MaxExtensions uint32 `protobuf:"varint,19,opt,name=max_extensions,json=maxExtensions,proto3" json:"max_extensions,omitempty"`
+	// num_extensions is the number of times the auction has been extended.
+	NumExtensions uint32 `protobuf:"varint,20,opt,name=num_extensions,json=numExtensions,proto3" json:"num_extensions,omitempty"`
+	// buy_now_price is an optional price at which a bid wins the auction immediately. The
+	// auction closes on the first bid at or above it and skips the expired queue. It is
+	// disabled when left empty.
+	BuyNowPrice *v1beta1.Coin `protobuf:"bytes,21,opt,name=buy_now_price,json=buyNowPrice,proto3" json:"buy_now_price,omitempty"`
+	// min_increment is the minimum amount a bid must exceed the last price by. It is
+	// disabled when left empty.
+	MinIncrement *v1beta1.Coin `protobuf:"bytes,22,opt,name=min_increment,json=minIncrement,proto3" json:"min_increment,omitempty"`
+	// min_increment_bps is the minimum amount a bid must exceed the last price by, in basis
+	// points of the last price. It cannot be combined with min_increment.
+	MinIncrementBps uint32 `protobuf:"varint,23,opt,name=min_increment_bps,json=minIncrementBps,proto3" json:"min_increment_bps,omitempty"`
+	// auto_execute executes the auction in the EndBlocker once it is pending, instead of
+	// waiting for MsgExecAuction.
+	AutoExecute bool `protobuf:"varint,24,opt,name=auto_execute,json=autoExecute,proto3" json:"auto_execute,omitempty"`
+}
+
+func (x *ReserveAuctionMetadata) Reset() {
+	*x = ReserveAuctionMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReserveAuctionMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveAuctionMetadata) ProtoMessage() {}
+
+// Deprecated: Use ReserveAuctionMetadata.ProtoReflect.Descriptor instead.
+func (*ReserveAuctionMetadata) Descriptor() ([]byte, []int) {
+	return file_fatal_fruit_auction_v1_auctiontypes_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReserveAuctionMetadata) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *ReserveAuctionMetadata) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ReserveAuctionMetadata) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ReserveAuctionMetadata) GetReservePrice() *v1beta1.Coin {
+	if x != nil {
+		return x.ReservePrice
+	}
+	return nil
+}
+
+// Deprecated: Do not use.
+func (x *ReserveAuctionMetadata) GetBids() []*Bid {
+	if x != nil {
+		return x.Bids
+	}
+	return nil
+}
+
+func (x *ReserveAuctionMetadata) GetLastPrice() *v1beta1.Coin {
+	if x != nil {
+		return x.LastPrice
+	}
+	return nil
+}
+
+// Deprecated: Do not use.
+func (x *ReserveAuctionMetadata) GetStrategy() *SettleStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return nil
+}
+
+func (x *ReserveAuctionMetadata) GetRefundOnOutbid() bool {
+	if x != nil {
+		return x.RefundOnOutbid
+	}
+	return false
+}
+
+func (x *ReserveAuctionMetadata) GetHighestBid() *Bid {
+	if x != nil {
+		return x.HighestBid
+	}
+	return nil
+}
+
+func (x *ReserveAuctionMetadata) GetNumBids() uint64 {
+	if x != nil {
+		return x.NumBids
+	}
+	return 0
+}
+
+func (x *ReserveAuctionMetadata) GetStrategyType() string {
+	if x != nil {
+		return x.StrategyType
+	}
+	return ""
+}
+
+func (x *ReserveAuctionMetadata) GetSettlementStrategy() *anypb.Any {
+	if x != nil {
+		return x.SettlementStrategy
+	}
+	return nil
+}
+
+func (x *ReserveAuctionMetadata) GetExtensionWindow() *durationpb.Duration {
+	if x != nil {
+		return x.ExtensionWindow
+	}
+	return nil
 }
 
-var _ protoreflect.Message = (*fastReflection_ReserveAuction)(nil)
+func (x *ReserveAuctionMetadata) GetExtensionDuration() *durationpb.Duration {
+	if x != nil {
+		return x.ExtensionDuration
+	}
+	return nil
+}
 
-type fastReflection_ReserveAuction ReserveAuction
+func (x *ReserveAuctionMetadata) GetMaxExtensions() uint32 {
+	if x != nil {
+		return x.MaxExtensions
+	}
+	return 0
+}
 
-func (x *ReserveAuction) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ReserveAuction)(x)
+func (x *ReserveAuctionMetadata) GetNumExtensions() uint32 {
+	if x != nil {
+		return x.NumExtensions
+	}
+	return 0
 }
 
-func (x *ReserveAuction) slowProtoReflect() protoreflect.Message {
-	mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ReserveAuctionMetadata) GetBuyNowPrice() *v1beta1.Coin {
+	if x != nil {
+		return x.BuyNowPrice
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-var _fastReflection_ReserveAuction_messageType fastReflection_ReserveAuction_messageType
-var _ protoreflect.MessageType = fastReflection_ReserveAuction_messageType{}
+func (x *ReserveAuctionMetadata) GetMinIncrement() *v1beta1.Coin {
+	if x != nil {
+		return x.MinIncrement
+	}
+	return nil
+}
 
-type fastReflection_ReserveAuction_messageType struct{}
+func (x *ReserveAuctionMetadata) GetMinIncrementBps() uint32 {
+	if x != nil {
+		return x.MinIncrementBps
+	}
+	return 0
+}
 
-func (x fastReflection_ReserveAuction_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ReserveAuction)(nil)
+func (x *ReserveAuctionMetadata) GetAutoExecute() bool {
+	if x != nil {
+		return x.AutoExecute
+	}
+	return false
 }
-func (x fastReflection_ReserveAuction_messageType) New() protoreflect.Message {
-	return new(fastReflection_ReserveAuction)
+
+type ReserveAuction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Deprecated: replaced by status. This field is only read when migrating auctions
+	// created before statuses were validated.
+	//
+	// Deprecated: Do not use.
+	LegacyStatus string                  `protobuf:"bytes,2,opt,name=legacy_status,json=legacyStatus,proto3" json:"legacy_status,omitempty"`
+	Owner        string                  `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	AuctionType  string                  `protobuf:"bytes,4,opt,name=auction_type,json=auctionType,proto3" json:"auction_type,omitempty"`
+	Metadata     *ReserveAuctionMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// deposit is the amount escrowed by the owner when the auction was created.
+	Deposit []*v1beta1.Coin `protobuf:"bytes,6,rep,name=deposit,proto3" json:"deposit,omitempty"`
+	// status is the lifecycle stage of the auction, which matches the queue holding it.
+	Status AuctionStatus `protobuf:"varint,7,opt,name=status,proto3,enum=fatal_fruit.auction.v1.AuctionStatus" json:"status,omitempty"`
 }
-func (x fastReflection_ReserveAuction_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ReserveAuction
+
+func (x *ReserveAuction) Reset() {
+	*x = ReserveAuction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_ReserveAuction) Descriptor() protoreflect.MessageDescriptor {
-	return md_ReserveAuction
+func (x *ReserveAuction) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ReserveAuction) Type() protoreflect.MessageType {
-	return _fastReflection_ReserveAuction_messageType
+func (*ReserveAuction) ProtoMessage() {}
+
+// Deprecated: Use ReserveAuction.ProtoReflect.Descriptor instead.
+func (*ReserveAuction) Descriptor() ([]byte, []int) {
+	return file_fatal_fruit_auction_v1_auctiontypes_proto_rawDescGZIP(), []int{1}
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ReserveAuction) New() protoreflect.Message {
-	return new(fastReflection_ReserveAuction)
+func (x *ReserveAuction) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_ReserveAuction) Interface() protoreflect.ProtoMessage {
-	return (*ReserveAuction)(x)
+// Deprecated: Do not use.
+func (x *ReserveAuction) GetLegacyStatus() string {
+	if x != nil {
+		return x.LegacyStatus
+	}
+	return ""
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_ReserveAuction) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_ReserveAuction_id, value) {
-			return
-		}
+func (x *ReserveAuction) GetOwner() string {
+	if x != nil {
+		return x.Owner
 	}
-	if x.Status != "" {
-		value := protoreflect.ValueOfString(x.Status)
-		if !f(fd_ReserveAuction_status, value) {
-			return
-		}
+	return ""
+}
+
+func (x *ReserveAuction) GetAuctionType() string {
+	if x != nil {
+		return x.AuctionType
 	}
-	if x.Owner != "" {
-		value := protoreflect.ValueOfString(x.Owner)
-		if !f(fd_ReserveAuction_owner, value) {
-			return
-		}
+	return ""
+}
+
+func (x *ReserveAuction) GetMetadata() *ReserveAuctionMetadata {
+	if x != nil {
+		return x.Metadata
 	}
-	if x.AuctionType != "" {
-		value := protoreflect.ValueOfString(x.AuctionType)
-		if !f(fd_ReserveAuction_auction_type, value) {
-			return
-		}
+	return nil
+}
+
+func (x *ReserveAuction) GetDeposit() []*v1beta1.Coin {
+	if x != nil {
+		return x.Deposit
 	}
-	if x.Metadata != nil {
-		value := protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
-		if !f(fd_ReserveAuction_metadata, value) {
-			return
-		}
+	return nil
+}
+
+func (x *ReserveAuction) GetStatus() AuctionStatus {
+	if x != nil {
+		return x.Status
 	}
+	return AuctionStatus_AUCTION_STATUS_UNSPECIFIED
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_ReserveAuction) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "fatal_fruit.auction.v1.ReserveAuction.id":
-		return x.Id != uint64(0)
-	case "fatal_fruit.auction.v1.ReserveAuction.status":
-		return x.Status != ""
-	case "fatal_fruit.auction.v1.ReserveAuction.owner":
-		return x.Owner != ""
-	case "fatal_fruit.auction.v1.ReserveAuction.auction_type":
-		return x.AuctionType != ""
-	case "fatal_fruit.auction.v1.ReserveAuction.metadata":
-		return x.Metadata != nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReserveAuction"))
-		}
-		panic(fmt.Errorf("message fatal_fruit.auction.v1.ReserveAuction does not contain field %s", fd.FullName()))
+// SettleStrategy is the default settlement strategy. The winner pays the settlement
+// price selected by strategy_type to the auction owner.
+type SettleStrategy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StrategyType string `protobuf:"bytes,1,opt,name=strategy_type,json=strategyType,proto3" json:"strategy_type,omitempty"`
+	// id of escrow contract for auction
+	EscrowContractId      uint64 `protobuf:"varint,2,opt,name=escrow_contract_id,json=escrowContractId,proto3" json:"escrow_contract_id,omitempty"`
+	EscrowContractAddress string `protobuf:"bytes,3,opt,name=escrow_contract_address,json=escrowContractAddress,proto3" json:"escrow_contract_address,omitempty"`
+}
+
+func (x *SettleStrategy) Reset() {
+	*x = SettleStrategy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ReserveAuction) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "fatal_fruit.auction.v1.ReserveAuction.id":
-		x.Id = uint64(0)
-	case "fatal_fruit.auction.v1.ReserveAuction.status":
-		x.Status = ""
-	case "fatal_fruit.auction.v1.ReserveAuction.owner":
-		x.Owner = ""
-	case "fatal_fruit.auction.v1.ReserveAuction.auction_type":
-		x.AuctionType = ""
-	case "fatal_fruit.auction.v1.ReserveAuction.metadata":
-		x.Metadata = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReserveAuction"))
-		}
-		panic(fmt.Errorf("message fatal_fruit.auction.v1.ReserveAuction does not contain field %s", fd.FullName()))
+func (x *SettleStrategy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettleStrategy) ProtoMessage() {}
+
+// Deprecated: Use SettleStrategy.ProtoReflect.Descriptor instead.
+func (*SettleStrategy) Descriptor() ([]byte, []int) {
+	return file_fatal_fruit_auction_v1_auctiontypes_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SettleStrategy) GetStrategyType() string {
+	if x != nil {
+		return x.StrategyType
 	}
+	return ""
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ReserveAuction) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "fatal_fruit.auction.v1.ReserveAuction.id":
-		value := x.Id
-		return protoreflect.ValueOfUint64(value)
-	case "fatal_fruit.auction.v1.ReserveAuction.status":
-		value := x.Status
-		return protoreflect.ValueOfString(value)
-	case "fatal_fruit.auction.v1.ReserveAuction.owner":
-		value := x.Owner
-		return protoreflect.ValueOfString(value)
-	case "fatal_fruit.auction.v1.ReserveAuction.auction_type":
-		value := x.AuctionType
-		return protoreflect.ValueOfString(value)
-	case "fatal_fruit.auction.v1.ReserveAuction.metadata":
-		value := x.Metadata
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReserveAuction"))
-		}
-		panic(fmt.Errorf("message fatal_fruit.auction.v1.ReserveAuction does not contain field %s", descriptor.FullName()))
+func (x *SettleStrategy) GetEscrowContractId() uint64 {
+	if x != nil {
+		return x.EscrowContractId
 	}
+	return 0
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ReserveAuction) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "fatal_fruit.auction.v1.ReserveAuction.id":
-		x.Id = value.Uint()
-	case "fatal_fruit.auction.v1.ReserveAuction.status":
-		x.Status = value.Interface().(string)
-	case "fatal_fruit.auction.v1.ReserveAuction.owner":
-		x.Owner = value.Interface().(string)
-	case "fatal_fruit.auction.v1.ReserveAuction.auction_type":
-		x.AuctionType = value.Interface().(string)
-	case "fatal_fruit.auction.v1.ReserveAuction.metadata":
-		x.Metadata = value.Message().Interface().(*ReserveAuctionMetadata)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReserveAuction"))
-		}
-		panic(fmt.Errorf("message fatal_fruit.auction.v1.ReserveAuction does not contain field %s", fd.FullName()))
+func (x *SettleStrategy) GetEscrowContractAddress() string {
+	if x != nil {
+		return x.EscrowContractAddress
 	}
+	return ""
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ReserveAuction) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "fatal_fruit.auction.v1.ReserveAuction.metadata":
-		if x.Metadata == nil {
-			x.Metadata = new(ReserveAuctionMetadata)
-		}
-		return protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
-	case "fatal_fruit.auction.v1.ReserveAuction.id":
-		panic(fmt.Errorf("field id of message fatal_fruit.auction.v1.ReserveAuction is not mutable"))
-	case "fatal_fruit.auction.v1.ReserveAuction.status":
-		panic(fmt.Errorf("field status of message fatal_fruit.auction.v1.ReserveAuction is not mutable"))
-	case "fatal_fruit.auction.v1.ReserveAuction.owner":
-		panic(fmt.Errorf("field owner of message fatal_fruit.auction.v1.ReserveAuction is not mutable"))
-	case "fatal_fruit.auction.v1.ReserveAuction.auction_type":
-		panic(fmt.Errorf("field auction_type of message fatal_fruit.auction.v1.ReserveAuction is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReserveAuction"))
-		}
-		panic(fmt.Errorf("message fatal_fruit.auction.v1.ReserveAuction does not contain field %s", fd.FullName()))
-	}
+type DutchAuctionMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// duration specifies the time duration of the auction.
+	Duration *durationpb.Duration `protobuf:"bytes,1,opt,name=duration,proto3" json:"duration,omitempty"`
+	// start_time and end_time are calculated from the contract duration. end_time is
+	// moved to the time of the winning bid when the auction closes early.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// start_price is the asking price when the auction starts.
+	StartPrice *v1beta1.Coin `protobuf:"bytes,4,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
+	// floor_price is the lowest asking price the auction will fall to.
+	FloorPrice *v1beta1.Coin     `protobuf:"bytes,5,opt,name=floor_price,json=floorPrice,proto3" json:"floor_price,omitempty"`
+	Schedule   DecrementSchedule `protobuf:"varint,6,opt,name=schedule,proto3,enum=fatal_fruit.auction.v1.DecrementSchedule" json:"schedule,omitempty"`
+	// decrement is the amount the price falls every step_interval on a stepwise schedule.
+	Decrement *v1beta1.Coin `protobuf:"bytes,7,opt,name=decrement,proto3" json:"decrement,omitempty"`
+	// step_interval is the time between price decrements on a stepwise schedule. Setting it
+	// to the chain's block time lowers the price once per block.
+	StepInterval *durationpb.Duration `protobuf:"bytes,8,opt,name=step_interval,json=stepInterval,proto3" json:"step_interval,omitempty"`
+	// winning_bid is the first bid at or above the current price, which closes the auction.
+	WinningBid *Bid `protobuf:"bytes,9,opt,name=winning_bid,json=winningBid,proto3" json:"winning_bid,omitempty"`
+	// Deprecated: replaced by settlement_strategy. This field is only read when migrating
+	// auctions created before strategies were stored as Anys.
+	//
+	// Deprecated: Do not use.
+	Strategy *SettleStrategy `protobuf:"bytes,10,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// settlement_strategy is the strategy used to settle the auction, selected by its type
+	// URL. When creating an auction it may be left empty to use a SettleStrategy.
+	SettlementStrategy *anypb.Any `protobuf:"bytes,11,opt,name=settlement_strategy,json=settlementStrategy,proto3" json:"settlement_strategy,omitempty"`
+	// auto_execute executes the auction in the EndBlocker once it is pending, instead of
+	// waiting for MsgExecAuction.
+	AutoExecute bool `protobuf:"varint,12,opt,name=auto_execute,json=autoExecute,proto3" json:"auto_execute,omitempty"`
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ReserveAuction) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "fatal_fruit.auction.v1.ReserveAuction.id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "fatal_fruit.auction.v1.ReserveAuction.status":
-		return protoreflect.ValueOfString("")
-	case "fatal_fruit.auction.v1.ReserveAuction.owner":
-		return protoreflect.ValueOfString("")
-	case "fatal_fruit.auction.v1.ReserveAuction.auction_type":
-		return protoreflect.ValueOfString("")
-	case "fatal_fruit.auction.v1.ReserveAuction.metadata":
-		m := new(ReserveAuctionMetadata)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.ReserveAuction"))
-		}
-		panic(fmt.Errorf("message fatal_fruit.auction.v1.ReserveAuction does not contain field %s", fd.FullName()))
+func (x *DutchAuctionMetadata) Reset() {
+	*x = DutchAuctionMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ReserveAuction) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in fatal_fruit.auction.v1.ReserveAuction", d.FullName()))
-	}
-	panic("unreachable")
+func (x *DutchAuctionMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ReserveAuction) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (*DutchAuctionMetadata) ProtoMessage() {}
+
+// Deprecated: Use DutchAuctionMetadata.ProtoReflect.Descriptor instead.
+func (*DutchAuctionMetadata) Descriptor() ([]byte, []int) {
+	return file_fatal_fruit_auction_v1_auctiontypes_proto_rawDescGZIP(), []int{3}
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ReserveAuction) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *DutchAuctionMetadata) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_ReserveAuction) IsValid() bool {
-	return x != nil
+func (x *DutchAuctionMetadata) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_ReserveAuction) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ReserveAuction)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.Id != 0 {
-			n += 1 + runtime.Sov(uint64(x.Id))
-		}
-		l = len(x.Status)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Owner)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.AuctionType)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Metadata != nil {
-			l = options.Size(x.Metadata)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (x *DutchAuctionMetadata) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
 	}
+	return nil
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ReserveAuction)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if x.Metadata != nil {
-			encoded, err := options.Marshal(x.Metadata)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.AuctionType) > 0 {
-			i -= len(x.AuctionType)
-			copy(dAtA[i:], x.AuctionType)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AuctionType)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.Owner) > 0 {
-			i -= len(x.Owner)
-			copy(dAtA[i:], x.Owner)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Status) > 0 {
-			i -= len(x.Status)
-			copy(dAtA[i:], x.Status)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Status)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
-			i--
-			dAtA[i] = 0x8
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
+func (x *DutchAuctionMetadata) GetStartPrice() *v1beta1.Coin {
+	if x != nil {
+		return x.StartPrice
+	}
+	return nil
+}
+
+func (x *DutchAuctionMetadata) GetFloorPrice() *v1beta1.Coin {
+	if x != nil {
+		return x.FloorPrice
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ReserveAuction)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReserveAuction: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReserveAuction: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-				}
-				x.Id = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Status = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Owner = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.AuctionType = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Metadata == nil {
-					x.Metadata = &ReserveAuctionMetadata{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Metadata); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-				}
-				iNdEx += skippy
-			}
-		}
+	return nil
+}
+
+func (x *DutchAuctionMetadata) GetSchedule() DecrementSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return DecrementSchedule_DECREMENT_SCHEDULE_UNSPECIFIED
+}
+
+func (x *DutchAuctionMetadata) GetDecrement() *v1beta1.Coin {
+	if x != nil {
+		return x.Decrement
+	}
+	return nil
+}
+
+func (x *DutchAuctionMetadata) GetStepInterval() *durationpb.Duration {
+	if x != nil {
+		return x.StepInterval
+	}
+	return nil
+}
+
+func (x *DutchAuctionMetadata) GetWinningBid() *Bid {
+	if x != nil {
+		return x.WinningBid
+	}
+	return nil
+}
+
+// Deprecated: Do not use.
+func (x *DutchAuctionMetadata) GetStrategy() *SettleStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return nil
+}
+
+func (x *DutchAuctionMetadata) GetSettlementStrategy() *anypb.Any {
+	if x != nil {
+		return x.SettlementStrategy
+	}
+	return nil
+}
+
+func (x *DutchAuctionMetadata) GetAutoExecute() bool {
+	if x != nil {
+		return x.AutoExecute
+	}
+	return false
+}
+
+type DutchAuction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Deprecated: replaced by status. This field is only read when migrating auctions
+	// created before statuses were validated.
+	//
+	// Deprecated: Do not use.
+	LegacyStatus string                `protobuf:"bytes,2,opt,name=legacy_status,json=legacyStatus,proto3" json:"legacy_status,omitempty"`
+	Owner        string                `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	AuctionType  string                `protobuf:"bytes,4,opt,name=auction_type,json=auctionType,proto3" json:"auction_type,omitempty"`
+	Metadata     *DutchAuctionMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// deposit is the amount escrowed by the owner when the auction was created.
+	Deposit []*v1beta1.Coin `protobuf:"bytes,6,rep,name=deposit,proto3" json:"deposit,omitempty"`
+	// status is the lifecycle stage of the auction, which matches the queue holding it.
+	Status AuctionStatus `protobuf:"varint,7,opt,name=status,proto3,enum=fatal_fruit.auction.v1.AuctionStatus" json:"status,omitempty"`
+}
+
+func (x *DutchAuction) Reset() {
+	*x = DutchAuction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DutchAuction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+func (*DutchAuction) ProtoMessage() {}
+
+// Deprecated: Use DutchAuction.ProtoReflect.Descriptor instead.
+func (*DutchAuction) Descriptor() ([]byte, []int) {
+	return file_fatal_fruit_auction_v1_auctiontypes_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DutchAuction) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return 0
+}
+
+// Deprecated: Do not use.
+func (x *DutchAuction) GetLegacyStatus() string {
+	if x != nil {
+		return x.LegacyStatus
 	}
+	return ""
 }
 
-var (
-	md_SettleStrategy                         protoreflect.MessageDescriptor
-	fd_SettleStrategy_strategy_type           protoreflect.FieldDescriptor
-	fd_SettleStrategy_escrow_contract_id      protoreflect.FieldDescriptor
-	fd_SettleStrategy_escrow_contract_address protoreflect.FieldDescriptor
-)
+func (x *DutchAuction) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
 
-func init() {
-	file_fatal_fruit_auction_v1_auctiontypes_proto_init()
-	md_SettleStrategy = File_fatal_fruit_auction_v1_auctiontypes_proto.Messages().ByName("SettleStrategy")
-	fd_SettleStrategy_strategy_type = md_SettleStrategy.Fields().ByName("strategy_type")
-	fd_SettleStrategy_escrow_contract_id = md_SettleStrategy.Fields().ByName("escrow_contract_id")
-	fd_SettleStrategy_escrow_contract_address = md_SettleStrategy.Fields().ByName("escrow_contract_address")
+func (x *DutchAuction) GetAuctionType() string {
+	if x != nil {
+		return x.AuctionType
+	}
+	return ""
 }
 
-var _ protoreflect.Message = (*fastReflection_SettleStrategy)(nil)
+func (x *DutchAuction) GetMetadata() *DutchAuctionMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
 
-type fastReflection_SettleStrategy SettleStrategy
+func (x *DutchAuction) GetDeposit() []*v1beta1.Coin {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
 
-func (x *SettleStrategy) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SettleStrategy)(x)
+func (x *DutchAuction) GetStatus() AuctionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AuctionStatus_AUCTION_STATUS_UNSPECIFIED
 }
 
-func (x *SettleStrategy) slowProtoReflect() protoreflect.Message {
-	mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+type SealedBidAuctionMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// duration specifies the time duration of the bidding phase.
+	Duration *durationpb.Duration `protobuf:"bytes,1,opt,name=duration,proto3" json:"duration,omitempty"`
+	// reveal_duration specifies the time duration of the reveal phase that follows bidding.
+	RevealDuration *durationpb.Duration `protobuf:"bytes,2,opt,name=reveal_duration,json=revealDuration,proto3" json:"reveal_duration,omitempty"`
+	// start_time and end_time bound the bidding phase, and reveal_end_time ends the reveal
+	// phase. They are calculated from the durations when the auction starts.
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	RevealEndTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=reveal_end_time,json=revealEndTime,proto3" json:"reveal_end_time,omitempty"`
+	// reserve_price is the minimum price a revealed bid must meet.
+	ReservePrice        *v1beta1.Coin       `protobuf:"bytes,6,opt,name=reserve_price,json=reservePrice,proto3" json:"reserve_price,omitempty"`
+	UnrevealedBidPolicy UnrevealedBidPolicy `protobuf:"varint,7,opt,name=unrevealed_bid_policy,json=unrevealedBidPolicy,proto3,enum=fatal_fruit.auction.v1.UnrevealedBidPolicy" json:"unrevealed_bid_policy,omitempty"`
+	// highest_bid is the highest revealed bid.
+	HighestBid *Bid `protobuf:"bytes,8,opt,name=highest_bid,json=highestBid,proto3" json:"highest_bid,omitempty"`
+	// num_bids is the number of sealed bids and the sequence of the next bid.
+	NumBids uint64 `protobuf:"varint,9,opt,name=num_bids,json=numBids,proto3" json:"num_bids,omitempty"`
+	// num_revealed is the number of sealed bids that have been revealed.
+	NumRevealed uint64 `protobuf:"varint,10,opt,name=num_revealed,json=numRevealed,proto3" json:"num_revealed,omitempty"`
+	// Deprecated: replaced by settlement_strategy. This field is only read when migrating
+	// auctions created before strategies were stored as Anys.
+	//
+	// Deprecated: Do not use.
+	Strategy *SettleStrategy `protobuf:"bytes,11,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// strategy_type selects how the auction is settled when no settlement_strategy is
+	// given: SETTLE (the default) charges the winner their own bid, SECOND_PRICE charges the
+	// highest competing bid.
+	StrategyType string `protobuf:"bytes,12,opt,name=strategy_type,json=strategyType,proto3" json:"strategy_type,omitempty"`
+	// settlement_strategy is the strategy used to settle the auction, selected by its type
+	// URL. When creating an auction it may be left empty to use a SettleStrategy.
+	SettlementStrategy *anypb.Any `protobuf:"bytes,13,opt,name=settlement_strategy,json=settlementStrategy,proto3" json:"settlement_strategy,omitempty"`
+	// auto_execute executes the auction in the EndBlocker once it is pending, instead of
+	// waiting for MsgExecAuction.
+	AutoExecute bool `protobuf:"varint,14,opt,name=auto_execute,json=autoExecute,proto3" json:"auto_execute,omitempty"`
+}
+
+func (x *SealedBidAuctionMetadata) Reset() {
+	*x = SealedBidAuctionMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SealedBidAuctionMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SealedBidAuctionMetadata) ProtoMessage() {}
+
+// Deprecated: Use SealedBidAuctionMetadata.ProtoReflect.Descriptor instead.
+func (*SealedBidAuctionMetadata) Descriptor() ([]byte, []int) {
+	return file_fatal_fruit_auction_v1_auctiontypes_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SealedBidAuctionMetadata) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *SealedBidAuctionMetadata) GetRevealDuration() *durationpb.Duration {
+	if x != nil {
+		return x.RevealDuration
+	}
+	return nil
+}
+
+func (x *SealedBidAuctionMetadata) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *SealedBidAuctionMetadata) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *SealedBidAuctionMetadata) GetRevealEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevealEndTime
+	}
+	return nil
+}
+
+func (x *SealedBidAuctionMetadata) GetReservePrice() *v1beta1.Coin {
+	if x != nil {
+		return x.ReservePrice
+	}
+	return nil
+}
+
+func (x *SealedBidAuctionMetadata) GetUnrevealedBidPolicy() UnrevealedBidPolicy {
+	if x != nil {
+		return x.UnrevealedBidPolicy
+	}
+	return UnrevealedBidPolicy_UNREVEALED_BID_POLICY_UNSPECIFIED
+}
+
+func (x *SealedBidAuctionMetadata) GetHighestBid() *Bid {
+	if x != nil {
+		return x.HighestBid
+	}
+	return nil
+}
+
+func (x *SealedBidAuctionMetadata) GetNumBids() uint64 {
+	if x != nil {
+		return x.NumBids
+	}
+	return 0
+}
+
+func (x *SealedBidAuctionMetadata) GetNumRevealed() uint64 {
+	if x != nil {
+		return x.NumRevealed
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (x *SealedBidAuctionMetadata) GetStrategy() *SettleStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return nil
+}
+
+func (x *SealedBidAuctionMetadata) GetStrategyType() string {
+	if x != nil {
+		return x.StrategyType
+	}
+	return ""
+}
+
+func (x *SealedBidAuctionMetadata) GetSettlementStrategy() *anypb.Any {
+	if x != nil {
+		return x.SettlementStrategy
+	}
+	return nil
+}
+
+func (x *SealedBidAuctionMetadata) GetAutoExecute() bool {
+	if x != nil {
+		return x.AutoExecute
+	}
+	return false
+}
+
+type SealedBidAuction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Deprecated: replaced by status. This field is only read when migrating auctions
+	// created before statuses were validated.
+	//
+	// Deprecated: Do not use.
+	LegacyStatus string                    `protobuf:"bytes,2,opt,name=legacy_status,json=legacyStatus,proto3" json:"legacy_status,omitempty"`
+	Owner        string                    `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	AuctionType  string                    `protobuf:"bytes,4,opt,name=auction_type,json=auctionType,proto3" json:"auction_type,omitempty"`
+	Metadata     *SealedBidAuctionMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// deposit is the amount escrowed by the owner when the auction was created.
+	Deposit []*v1beta1.Coin `protobuf:"bytes,6,rep,name=deposit,proto3" json:"deposit,omitempty"`
+	// status is the lifecycle stage of the auction, which matches the queue holding it.
+	Status AuctionStatus `protobuf:"varint,7,opt,name=status,proto3,enum=fatal_fruit.auction.v1.AuctionStatus" json:"status,omitempty"`
+}
+
+func (x *SealedBidAuction) Reset() {
+	*x = SealedBidAuction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SealedBidAuction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SealedBidAuction) ProtoMessage() {}
+
+// Deprecated: Use SealedBidAuction.ProtoReflect.Descriptor instead.
+func (*SealedBidAuction) Descriptor() ([]byte, []int) {
+	return file_fatal_fruit_auction_v1_auctiontypes_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SealedBidAuction) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (x *SealedBidAuction) GetLegacyStatus() string {
+	if x != nil {
+		return x.LegacyStatus
+	}
+	return ""
+}
+
+func (x *SealedBidAuction) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *SealedBidAuction) GetAuctionType() string {
+	if x != nil {
+		return x.AuctionType
+	}
+	return ""
+}
+
+func (x *SealedBidAuction) GetMetadata() *SealedBidAuctionMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *SealedBidAuction) GetDeposit() []*v1beta1.Coin {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
+
+func (x *SealedBidAuction) GetStatus() AuctionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AuctionStatus_AUCTION_STATUS_UNSPECIFIED
+}
+
+// SealedBid is the bid metadata carried by MsgNewBid on a sealed-bid auction. The bid
+// amount of the message is left empty so the price is not disclosed.
+type SealedBid struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// commitment is the sha256 hash of the auction id, bidder, bid amount and a secret salt.
+	Commitment []byte `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// collateral is escrowed with the sealed bid and must cover the revealed amount.
+	Collateral *v1beta1.Coin `protobuf:"bytes,2,opt,name=collateral,proto3" json:"collateral,omitempty"`
+	// revealed is set once the bid has been revealed.
+	Revealed bool `protobuf:"varint,3,opt,name=revealed,proto3" json:"revealed,omitempty"`
+}
+
+func (x *SealedBid) Reset() {
+	*x = SealedBid{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SealedBid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SealedBid) ProtoMessage() {}
+
+// Deprecated: Use SealedBid.ProtoReflect.Descriptor instead.
+func (*SealedBid) Descriptor() ([]byte, []int) {
+	return file_fatal_fruit_auction_v1_auctiontypes_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SealedBid) GetCommitment() []byte {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+func (x *SealedBid) GetCollateral() *v1beta1.Coin {
+	if x != nil {
+		return x.Collateral
+	}
+	return nil
+}
+
+func (x *SealedBid) GetRevealed() bool {
+	if x != nil {
+		return x.Revealed
+	}
+	return false
+}
+
+type BatchAuctionMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// duration specifies the time duration of the auction.
+	Duration *durationpb.Duration `protobuf:"bytes,1,opt,name=duration,proto3" json:"duration,omitempty"`
+	// start_time and end_time are calculated from the contract duration
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// reserve_price is the minimum price per unit a bid must offer.
+	ReservePrice *v1beta1.Coin `protobuf:"bytes,4,opt,name=reserve_price,json=reservePrice,proto3" json:"reserve_price,omitempty"`
+	// highest_bid is the bid with the highest price per unit.
+	HighestBid *Bid `protobuf:"bytes,5,opt,name=highest_bid,json=highestBid,proto3" json:"highest_bid,omitempty"`
+	// num_bids is the number of accepted bids and the sequence of the next bid.
+	NumBids uint64 `protobuf:"varint,6,opt,name=num_bids,json=numBids,proto3" json:"num_bids,omitempty"`
+	// settlement_strategy is the strategy used to settle the auction, selected by its type
+	// URL. When creating an auction it may be left empty to use a UniformPriceStrategy.
+	SettlementStrategy *anypb.Any `protobuf:"bytes,7,opt,name=settlement_strategy,json=settlementStrategy,proto3" json:"settlement_strategy,omitempty"`
+	// auto_execute executes the auction in the EndBlocker once it is pending, instead of
+	// waiting for MsgExecAuction.
+	AutoExecute bool `protobuf:"varint,8,opt,name=auto_execute,json=autoExecute,proto3" json:"auto_execute,omitempty"`
+}
+
+func (x *BatchAuctionMetadata) Reset() {
+	*x = BatchAuctionMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return mi.MessageOf(x)
 }
 
-var _fastReflection_SettleStrategy_messageType fastReflection_SettleStrategy_messageType
-var _ protoreflect.MessageType = fastReflection_SettleStrategy_messageType{}
+func (x *BatchAuctionMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-type fastReflection_SettleStrategy_messageType struct{}
+func (*BatchAuctionMetadata) ProtoMessage() {}
 
-func (x fastReflection_SettleStrategy_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SettleStrategy)(nil)
+// Deprecated: Use BatchAuctionMetadata.ProtoReflect.Descriptor instead.
+func (*BatchAuctionMetadata) Descriptor() ([]byte, []int) {
+	return file_fatal_fruit_auction_v1_auctiontypes_proto_rawDescGZIP(), []int{8}
 }
-func (x fastReflection_SettleStrategy_messageType) New() protoreflect.Message {
-	return new(fastReflection_SettleStrategy)
+
+func (x *BatchAuctionMetadata) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
 }
-func (x fastReflection_SettleStrategy_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SettleStrategy
+
+func (x *BatchAuctionMetadata) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_SettleStrategy) Descriptor() protoreflect.MessageDescriptor {
-	return md_SettleStrategy
+func (x *BatchAuctionMetadata) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SettleStrategy) Type() protoreflect.MessageType {
-	return _fastReflection_SettleStrategy_messageType
+func (x *BatchAuctionMetadata) GetReservePrice() *v1beta1.Coin {
+	if x != nil {
+		return x.ReservePrice
+	}
+	return nil
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SettleStrategy) New() protoreflect.Message {
-	return new(fastReflection_SettleStrategy)
+func (x *BatchAuctionMetadata) GetHighestBid() *Bid {
+	if x != nil {
+		return x.HighestBid
+	}
+	return nil
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_SettleStrategy) Interface() protoreflect.ProtoMessage {
-	return (*SettleStrategy)(x)
+func (x *BatchAuctionMetadata) GetNumBids() uint64 {
+	if x != nil {
+		return x.NumBids
+	}
+	return 0
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_SettleStrategy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.StrategyType != "" {
-		value := protoreflect.ValueOfString(x.StrategyType)
-		if !f(fd_SettleStrategy_strategy_type, value) {
-			return
-		}
+func (x *BatchAuctionMetadata) GetSettlementStrategy() *anypb.Any {
+	if x != nil {
+		return x.SettlementStrategy
 	}
-	if x.EscrowContractId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.EscrowContractId)
-		if !f(fd_SettleStrategy_escrow_contract_id, value) {
-			return
-		}
+	return nil
+}
+
+func (x *BatchAuctionMetadata) GetAutoExecute() bool {
+	if x != nil {
+		return x.AutoExecute
 	}
-	if x.EscrowContractAddress != "" {
-		value := protoreflect.ValueOfString(x.EscrowContractAddress)
-		if !f(fd_SettleStrategy_escrow_contract_address, value) {
-			return
-		}
+	return false
+}
+
+// BatchAuction sells the units of its deposit to many bidders at a single clearing price.
+type BatchAuction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Deprecated: replaced by status. This field is only read when migrating auctions
+	// created before statuses were validated.
+	//
+	// Deprecated: Do not use.
+	LegacyStatus string                `protobuf:"bytes,2,opt,name=legacy_status,json=legacyStatus,proto3" json:"legacy_status,omitempty"`
+	Owner        string                `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	AuctionType  string                `protobuf:"bytes,4,opt,name=auction_type,json=auctionType,proto3" json:"auction_type,omitempty"`
+	Metadata     *BatchAuctionMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// deposit holds the units being auctioned. It must be a single coin.
+	Deposit []*v1beta1.Coin `protobuf:"bytes,6,rep,name=deposit,proto3" json:"deposit,omitempty"`
+	// status is the lifecycle stage of the auction, which matches the queue holding it.
+	Status AuctionStatus `protobuf:"varint,7,opt,name=status,proto3,enum=fatal_fruit.auction.v1.AuctionStatus" json:"status,omitempty"`
+}
+
+func (x *BatchAuction) Reset() {
+	*x = BatchAuction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_SettleStrategy) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "fatal_fruit.auction.v1.SettleStrategy.strategy_type":
-		return x.StrategyType != ""
-	case "fatal_fruit.auction.v1.SettleStrategy.escrow_contract_id":
-		return x.EscrowContractId != uint64(0)
-	case "fatal_fruit.auction.v1.SettleStrategy.escrow_contract_address":
-		return x.EscrowContractAddress != ""
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SettleStrategy"))
-		}
-		panic(fmt.Errorf("message fatal_fruit.auction.v1.SettleStrategy does not contain field %s", fd.FullName()))
+func (x *BatchAuction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchAuction) ProtoMessage() {}
+
+// Deprecated: Use BatchAuction.ProtoReflect.Descriptor instead.
+func (*BatchAuction) Descriptor() ([]byte, []int) {
+	return file_fatal_fruit_auction_v1_auctiontypes_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BatchAuction) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
+	return 0
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SettleStrategy) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "fatal_fruit.auction.v1.SettleStrategy.strategy_type":
-		x.StrategyType = ""
-	case "fatal_fruit.auction.v1.SettleStrategy.escrow_contract_id":
-		x.EscrowContractId = uint64(0)
-	case "fatal_fruit.auction.v1.SettleStrategy.escrow_contract_address":
-		x.EscrowContractAddress = ""
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SettleStrategy"))
-		}
-		panic(fmt.Errorf("message fatal_fruit.auction.v1.SettleStrategy does not contain field %s", fd.FullName()))
+// Deprecated: Do not use.
+func (x *BatchAuction) GetLegacyStatus() string {
+	if x != nil {
+		return x.LegacyStatus
 	}
+	return ""
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SettleStrategy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "fatal_fruit.auction.v1.SettleStrategy.strategy_type":
-		value := x.StrategyType
-		return protoreflect.ValueOfString(value)
-	case "fatal_fruit.auction.v1.SettleStrategy.escrow_contract_id":
-		value := x.EscrowContractId
-		return protoreflect.ValueOfUint64(value)
-	case "fatal_fruit.auction.v1.SettleStrategy.escrow_contract_address":
-		value := x.EscrowContractAddress
-		return protoreflect.ValueOfString(value)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SettleStrategy"))
-		}
-		panic(fmt.Errorf("message fatal_fruit.auction.v1.SettleStrategy does not contain field %s", descriptor.FullName()))
+func (x *BatchAuction) GetOwner() string {
+	if x != nil {
+		return x.Owner
 	}
+	return ""
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SettleStrategy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "fatal_fruit.auction.v1.SettleStrategy.strategy_type":
-		x.StrategyType = value.Interface().(string)
-	case "fatal_fruit.auction.v1.SettleStrategy.escrow_contract_id":
-		x.EscrowContractId = value.Uint()
-	case "fatal_fruit.auction.v1.SettleStrategy.escrow_contract_address":
-		x.EscrowContractAddress = value.Interface().(string)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SettleStrategy"))
-		}
-		panic(fmt.Errorf("message fatal_fruit.auction.v1.SettleStrategy does not contain field %s", fd.FullName()))
+func (x *BatchAuction) GetAuctionType() string {
+	if x != nil {
+		return x.AuctionType
 	}
+	return ""
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SettleStrategy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "fatal_fruit.auction.v1.SettleStrategy.strategy_type":
-		panic(fmt.Errorf("field strategy_type of message fatal_fruit.auction.v1.SettleStrategy is not mutable"))
-	case "fatal_fruit.auction.v1.SettleStrategy.escrow_contract_id":
-		panic(fmt.Errorf("field escrow_contract_id of message fatal_fruit.auction.v1.SettleStrategy is not mutable"))
-	case "fatal_fruit.auction.v1.SettleStrategy.escrow_contract_address":
-		panic(fmt.Errorf("field escrow_contract_address of message fatal_fruit.auction.v1.SettleStrategy is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SettleStrategy"))
-		}
-		panic(fmt.Errorf("message fatal_fruit.auction.v1.SettleStrategy does not contain field %s", fd.FullName()))
+func (x *BatchAuction) GetMetadata() *BatchAuctionMetadata {
+	if x != nil {
+		return x.Metadata
 	}
+	return nil
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SettleStrategy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "fatal_fruit.auction.v1.SettleStrategy.strategy_type":
-		return protoreflect.ValueOfString("")
-	case "fatal_fruit.auction.v1.SettleStrategy.escrow_contract_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "fatal_fruit.auction.v1.SettleStrategy.escrow_contract_address":
-		return protoreflect.ValueOfString("")
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.SettleStrategy"))
-		}
-		panic(fmt.Errorf("message fatal_fruit.auction.v1.SettleStrategy does not contain field %s", fd.FullName()))
+func (x *BatchAuction) GetDeposit() []*v1beta1.Coin {
+	if x != nil {
+		return x.Deposit
 	}
+	return nil
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SettleStrategy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in fatal_fruit.auction.v1.SettleStrategy", d.FullName()))
+func (x *BatchAuction) GetStatus() AuctionStatus {
+	if x != nil {
+		return x.Status
 	}
-	panic("unreachable")
+	return AuctionStatus_AUCTION_STATUS_UNSPECIFIED
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SettleStrategy) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+// BatchBid is the bid metadata carried by MsgNewBid on a batch auction. The bid amount of
+// the message is the maximum price the bidder pays per unit.
+type BatchBid struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// quantity is the number of units the bidder wants to buy.
+	Quantity string `protobuf:"bytes,1,opt,name=quantity,proto3" json:"quantity,omitempty"`
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SettleStrategy) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *BatchBid) Reset() {
+	*x = BatchBid{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_SettleStrategy) IsValid() bool {
-	return x != nil
+func (x *BatchBid) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_SettleStrategy) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SettleStrategy)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		l = len(x.StrategyType)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.EscrowContractId != 0 {
-			n += 1 + runtime.Sov(uint64(x.EscrowContractId))
-		}
-		l = len(x.EscrowContractAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
-	}
+func (*BatchBid) ProtoMessage() {}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SettleStrategy)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if len(x.EscrowContractAddress) > 0 {
-			i -= len(x.EscrowContractAddress)
-			copy(dAtA[i:], x.EscrowContractAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EscrowContractAddress)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if x.EscrowContractId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.EscrowContractId))
-			i--
-			dAtA[i] = 0x10
-		}
-		if len(x.StrategyType) > 0 {
-			i -= len(x.StrategyType)
-			copy(dAtA[i:], x.StrategyType)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StrategyType)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SettleStrategy)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SettleStrategy: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SettleStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StrategyType", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.StrategyType = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EscrowContractId", wireType)
-				}
-				x.EscrowContractId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.EscrowContractId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EscrowContractAddress", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.EscrowContractAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-				}
-				iNdEx += skippy
-			}
-		}
+// Deprecated: Use BatchBid.ProtoReflect.Descriptor instead.
+func (*BatchBid) Descriptor() ([]byte, []int) {
+	return file_fatal_fruit_auction_v1_auctiontypes_proto_rawDescGZIP(), []int{10}
+}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+func (x *BatchBid) GetQuantity() string {
+	if x != nil {
+		return x.Quantity
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return ""
+}
+
+// UniformPriceStrategy settles a batch auction. Every winning bid is filled at the same
+// clearing price and receives its share of the deposit units.
+type UniformPriceStrategy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of escrow contract for auction
+	EscrowContractId      uint64 `protobuf:"varint,1,opt,name=escrow_contract_id,json=escrowContractId,proto3" json:"escrow_contract_id,omitempty"`
+	EscrowContractAddress string `protobuf:"bytes,2,opt,name=escrow_contract_address,json=escrowContractAddress,proto3" json:"escrow_contract_address,omitempty"`
+}
+
+func (x *UniformPriceStrategy) Reset() {
+	*x = UniformPriceStrategy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: fatal_fruit/auction/v1/auctiontypes.proto
+func (x *UniformPriceStrategy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (*UniformPriceStrategy) ProtoMessage() {}
 
-type ReserveAuctionMetadata struct {
+// Deprecated: Use UniformPriceStrategy.ProtoReflect.Descriptor instead.
+func (*UniformPriceStrategy) Descriptor() ([]byte, []int) {
+	return file_fatal_fruit_auction_v1_auctiontypes_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UniformPriceStrategy) GetEscrowContractId() uint64 {
+	if x != nil {
+		return x.EscrowContractId
+	}
+	return 0
+}
+
+func (x *UniformPriceStrategy) GetEscrowContractAddress() string {
+	if x != nil {
+		return x.EscrowContractAddress
+	}
+	return ""
+}
+
+type ReverseAuctionMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// duration specifies the time duration of the auction.
-	Duration *durationpb.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	Duration *durationpb.Duration `protobuf:"bytes,1,opt,name=duration,proto3" json:"duration,omitempty"`
 	// start_time and end_time are calculated from the contract duration
-	StartTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	// reserve_price is the minimum price for the auction.
-	ReservePrice *v1beta1.Coin   `protobuf:"bytes,3,opt,name=reserve_price,json=reservePrice,proto3" json:"reserve_price,omitempty"`
-	Bids         []*Bid          `protobuf:"bytes,9,rep,name=bids,proto3" json:"bids,omitempty"`
-	LastPrice    *v1beta1.Coin   `protobuf:"bytes,10,opt,name=last_price,json=lastPrice,proto3" json:"last_price,omitempty"`
-	Strategy     *SettleStrategy `protobuf:"bytes,11,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// ceiling_price bounds the bids. Every bid must be strictly below it.
+	CeilingPrice *v1beta1.Coin `protobuf:"bytes,4,opt,name=ceiling_price,json=ceilingPrice,proto3" json:"ceiling_price,omitempty"`
+	// lowest_bid is the currently leading bid.
+	LowestBid *Bid `protobuf:"bytes,5,opt,name=lowest_bid,json=lowestBid,proto3" json:"lowest_bid,omitempty"`
+	// num_bids is the number of accepted bids and the sequence of the next bid.
+	NumBids uint64 `protobuf:"varint,6,opt,name=num_bids,json=numBids,proto3" json:"num_bids,omitempty"`
+	// settlement_strategy is the strategy used to settle the auction, selected by its type
+	// URL. When creating an auction it may be left empty to use a ProcurementStrategy.
+	SettlementStrategy *anypb.Any `protobuf:"bytes,7,opt,name=settlement_strategy,json=settlementStrategy,proto3" json:"settlement_strategy,omitempty"`
+	// auto_execute executes the auction in the EndBlocker once it is pending, instead of
+	// waiting for MsgExecAuction.
+	AutoExecute bool `protobuf:"varint,8,opt,name=auto_execute,json=autoExecute,proto3" json:"auto_execute,omitempty"`
 }
 
-func (x *ReserveAuctionMetadata) Reset() {
-	*x = ReserveAuctionMetadata{}
+func (x *ReverseAuctionMetadata) Reset() {
+	*x = ReverseAuctionMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[0]
+		mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReserveAuctionMetadata) String() string {
+func (x *ReverseAuctionMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReserveAuctionMetadata) ProtoMessage() {}
+func (*ReverseAuctionMetadata) ProtoMessage() {}
 
-// Deprecated: Use ReserveAuctionMetadata.ProtoReflect.Descriptor instead.
-func (*ReserveAuctionMetadata) Descriptor() ([]byte, []int) {
-	return file_fatal_fruit_auction_v1_auctiontypes_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ReverseAuctionMetadata.ProtoReflect.Descriptor instead.
+func (*ReverseAuctionMetadata) Descriptor() ([]byte, []int) {
+	return file_fatal_fruit_auction_v1_auctiontypes_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ReserveAuctionMetadata) GetDuration() *durationpb.Duration {
+func (x *ReverseAuctionMetadata) GetDuration() *durationpb.Duration {
 	if x != nil {
 		return x.Duration
 	}
 	return nil
 }
 
-func (x *ReserveAuctionMetadata) GetStartTime() *timestamppb.Timestamp {
+func (x *ReverseAuctionMetadata) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
 		return x.StartTime
 	}
 	return nil
 }
 
-func (x *ReserveAuctionMetadata) GetEndTime() *timestamppb.Timestamp {
+func (x *ReverseAuctionMetadata) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
 		return x.EndTime
 	}
 	return nil
 }
 
-func (x *ReserveAuctionMetadata) GetReservePrice() *v1beta1.Coin {
+func (x *ReverseAuctionMetadata) GetCeilingPrice() *v1beta1.Coin {
 	if x != nil {
-		return x.ReservePrice
+		return x.CeilingPrice
 	}
 	return nil
 }
 
-func (x *ReserveAuctionMetadata) GetBids() []*Bid {
+func (x *ReverseAuctionMetadata) GetLowestBid() *Bid {
 	if x != nil {
-		return x.Bids
+		return x.LowestBid
 	}
 	return nil
 }
 
-func (x *ReserveAuctionMetadata) GetLastPrice() *v1beta1.Coin {
+func (x *ReverseAuctionMetadata) GetNumBids() uint64 {
 	if x != nil {
-		return x.LastPrice
+		return x.NumBids
 	}
-	return nil
+	return 0
 }
 
-func (x *ReserveAuctionMetadata) GetStrategy() *SettleStrategy {
+func (x *ReverseAuctionMetadata) GetSettlementStrategy() *anypb.Any {
 	if x != nil {
-		return x.Strategy
+		return x.SettlementStrategy
 	}
 	return nil
 }
 
-type ReserveAuction struct {
+func (x *ReverseAuctionMetadata) GetAutoExecute() bool {
+	if x != nil {
+		return x.AutoExecute
+	}
+	return false
+}
+
+// ReverseAuction procures a service for the budget held in its deposit. Providers bid
+// downward and the lowest bid wins.
+type ReverseAuction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          uint64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status      string                  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Owner       string                  `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
-	AuctionType string                  `protobuf:"bytes,4,opt,name=auction_type,json=auctionType,proto3" json:"auction_type,omitempty"`
-	Metadata    *ReserveAuctionMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Deprecated: replaced by status. This field is only read when migrating auctions
+	// created before statuses were validated.
+	//
+	// Deprecated: Do not use.
+	LegacyStatus string                  `protobuf:"bytes,2,opt,name=legacy_status,json=legacyStatus,proto3" json:"legacy_status,omitempty"`
+	Owner        string                  `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	AuctionType  string                  `protobuf:"bytes,4,opt,name=auction_type,json=auctionType,proto3" json:"auction_type,omitempty"`
+	Metadata     *ReverseAuctionMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// deposit is the budget escrowed by the owner. It must be a single coin in the ceiling
+	// price denom that covers the ceiling price.
+	Deposit []*v1beta1.Coin `protobuf:"bytes,6,rep,name=deposit,proto3" json:"deposit,omitempty"`
+	// status is the lifecycle stage of the auction, which matches the queue holding it.
+	Status AuctionStatus `protobuf:"varint,7,opt,name=status,proto3,enum=fatal_fruit.auction.v1.AuctionStatus" json:"status,omitempty"`
 }
 
-func (x *ReserveAuction) Reset() {
-	*x = ReserveAuction{}
+func (x *ReverseAuction) Reset() {
+	*x = ReverseAuction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[1]
+		mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReserveAuction) String() string {
+func (x *ReverseAuction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReserveAuction) ProtoMessage() {}
+func (*ReverseAuction) ProtoMessage() {}
 
-// Deprecated: Use ReserveAuction.ProtoReflect.Descriptor instead.
-func (*ReserveAuction) Descriptor() ([]byte, []int) {
-	return file_fatal_fruit_auction_v1_auctiontypes_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ReverseAuction.ProtoReflect.Descriptor instead.
+func (*ReverseAuction) Descriptor() ([]byte, []int) {
+	return file_fatal_fruit_auction_v1_auctiontypes_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ReserveAuction) GetId() uint64 {
+func (x *ReverseAuction) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *ReserveAuction) GetStatus() string {
+// Deprecated: Do not use.
+func (x *ReverseAuction) GetLegacyStatus() string {
 	if x != nil {
-		return x.Status
+		return x.LegacyStatus
 	}
 	return ""
 }
 
-func (x *ReserveAuction) GetOwner() string {
+func (x *ReverseAuction) GetOwner() string {
 	if x != nil {
 		return x.Owner
 	}
 	return ""
 }
 
-func (x *ReserveAuction) GetAuctionType() string {
+func (x *ReverseAuction) GetAuctionType() string {
 	if x != nil {
 		return x.AuctionType
 	}
 	return ""
 }
 
-func (x *ReserveAuction) GetMetadata() *ReserveAuctionMetadata {
+func (x *ReverseAuction) GetMetadata() *ReverseAuctionMetadata {
 	if x != nil {
 		return x.Metadata
 	}
 	return nil
 }
 
-type SettleStrategy struct {
+func (x *ReverseAuction) GetDeposit() []*v1beta1.Coin {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
+
+func (x *ReverseAuction) GetStatus() AuctionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AuctionStatus_AUCTION_STATUS_UNSPECIFIED
+}
+
+// ProcurementStrategy settles a reverse auction. The winning provider is paid their bid
+// from the auction's budget and the remainder is returned to the owner.
+type ProcurementStrategy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StrategyType string `protobuf:"bytes,1,opt,name=strategy_type,json=strategyType,proto3" json:"strategy_type,omitempty"`
-	// id of escrow contract for auction
-	EscrowContractId      uint64 `protobuf:"varint,2,opt,name=escrow_contract_id,json=escrowContractId,proto3" json:"escrow_contract_id,omitempty"`
-	EscrowContractAddress string `protobuf:"bytes,3,opt,name=escrow_contract_address,json=escrowContractAddress,proto3" json:"escrow_contract_address,omitempty"`
+	// id of escrow contract for auction. The budget is held by the module account, so no
+	// escrow contract is created and both escrow fields are left unset.
+	EscrowContractId      uint64 `protobuf:"varint,1,opt,name=escrow_contract_id,json=escrowContractId,proto3" json:"escrow_contract_id,omitempty"`
+	EscrowContractAddress string `protobuf:"bytes,2,opt,name=escrow_contract_address,json=escrowContractAddress,proto3" json:"escrow_contract_address,omitempty"`
 }
 
-func (x *SettleStrategy) Reset() {
-	*x = SettleStrategy{}
+func (x *ProcurementStrategy) Reset() {
+	*x = ProcurementStrategy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[2]
+		mi := &file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SettleStrategy) String() string {
+func (x *ProcurementStrategy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SettleStrategy) ProtoMessage() {}
-
-// Deprecated: Use SettleStrategy.ProtoReflect.Descriptor instead.
-func (*SettleStrategy) Descriptor() ([]byte, []int) {
-	return file_fatal_fruit_auction_v1_auctiontypes_proto_rawDescGZIP(), []int{2}
-}
+func (*ProcurementStrategy) ProtoMessage() {}
 
-func (x *SettleStrategy) GetStrategyType() string {
-	if x != nil {
-		return x.StrategyType
-	}
-	return ""
+// Deprecated: Use ProcurementStrategy.ProtoReflect.Descriptor instead.
+func (*ProcurementStrategy) Descriptor() ([]byte, []int) {
+	return file_fatal_fruit_auction_v1_auctiontypes_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *SettleStrategy) GetEscrowContractId() uint64 {
+func (x *ProcurementStrategy) GetEscrowContractId() uint64 {
 	if x != nil {
 		return x.EscrowContractId
 	}
 	return 0
 }
 
-func (x *SettleStrategy) GetEscrowContractAddress() string {
+func (x *ProcurementStrategy) GetEscrowContractAddress() string {
 	if x != nil {
 		return x.EscrowContractAddress
 	}
@@ -2433,7 +14595,7 @@ var file_fatal_fruit_auction_v1_auctiontypes_proto_rawDesc = []byte{
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x22, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74,
 	0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xba, 0x05, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x65,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa8, 0x0c, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x65,
 	0x72, 0x76, 0x65, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
 	0x74, 0x61, 0x12, 0x44, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
@@ -2457,71 +14619,553 @@ var file_fatal_fruit_auction_v1_auctiontypes_proto_rawDesc = []byte{
 	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0,
 	0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7,
 	0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x12, 0x2f, 0x0a, 0x04, 0x62, 0x69, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x65, 0x12, 0x33, 0x0a, 0x04, 0x62, 0x69, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x1b, 0x2e, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x64, 0x52, 0x04, 0x62, 0x69,
-	0x64, 0x73, 0x12, 0x80, 0x01, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
-	0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
-	0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f,
-	0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
-	0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f,
-	0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52,
-	0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x3a, 0x49, 0xca, 0xb4, 0x2d, 0x26, 0x66,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x64, 0x42, 0x02, 0x18, 0x01,
+	0x52, 0x04, 0x62, 0x69, 0x64, 0x73, 0x12, 0x80, 0x01, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09,
+	0x6c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x08, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66, 0x61,
+	0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x6f, 0x6e, 0x5f, 0x6f,
+	0x75, 0x74, 0x62, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x4f, 0x6e, 0x4f, 0x75, 0x74, 0x62, 0x69, 0x64, 0x12, 0x3c, 0x0a, 0x0b, 0x68,
+	0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x64, 0x52, 0x0a, 0x68,
+	0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x42, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x75, 0x6d,
+	0x5f, 0x62, 0x69, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6e, 0x75, 0x6d,
+	0x42, 0x69, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x6a, 0x0a, 0x13, 0x73, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x23, 0xca, 0xb4,
+	0x2d, 0x1f, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x52, 0x12, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x53, 0x0a, 0x10, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00,
+	0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x57, 0x0a, 0x12, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x11, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x61, 0x78,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x75,
+	0x6d, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x80, 0x01, 0x0a, 0x0d, 0x62, 0x75, 0x79, 0x5f, 0x6e, 0x6f, 0x77, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x41, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0b, 0x62, 0x75, 0x79, 0x4e, 0x6f, 0x77, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x63,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x41, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x49,
+	0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x5f,
+	0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x17, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x42, 0x70, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x6f,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x3a, 0x49, 0xca, 0xb4, 0x2d, 0x26, 0x66, 0x61, 0x74,
+	0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x8a, 0xe7, 0xb0, 0x2a, 0x1a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0xe6, 0x03, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x41, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0d, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01,
+	0x52, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e,
+	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x21,
+	0x0a, 0x0c, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x4a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69,
+	0x74, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x7b, 0x0a,
+	0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa,
+	0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c,
+	0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x66, 0x61, 0x74,
+	0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x40, 0xca, 0xb4, 0x2d, 0x1e, 0x66,
+	0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x8a, 0xe7, 0xb0,
+	0x2a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xda, 0x01, 0x0a, 0x0e,
+	0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x23,
+	0x0a, 0x0d, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49,
+	0x64, 0x12, 0x50, 0x0a, 0x17, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x15, 0x65, 0x73,
+	0x63, 0x72, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x3a, 0x23, 0xca, 0xb4, 0x2d, 0x1f, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66,
+	0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x22, 0xf2, 0x08, 0x0a, 0x14, 0x44, 0x75, 0x74,
+	0x63, 0x68, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x44, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d,
+	0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f,
+	0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x44, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf,
+	0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x82, 0x01, 0x0a,
+	0x0b, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8,
+	0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a,
+	0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x45, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69,
+	0x74, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x7f, 0x0a, 0x09, 0x64, 0x65, 0x63, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09,
+	0x64, 0x65, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x0d, 0x73, 0x74, 0x65,
+	0x70, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde, 0x1f,
+	0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x73, 0x74, 0x65, 0x70,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x3c, 0x0a, 0x0b, 0x77, 0x69, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x62, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x64, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x42, 0x69, 0x64, 0x12, 0x46, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66, 0x61, 0x74, 0x61, 0x6c,
+	0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x6a,
+	0x0a, 0x13, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
+	0x79, 0x42, 0x23, 0xca, 0xb4, 0x2d, 0x1f, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75,
+	0x69, 0x74, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x12, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75,
+	0x74, 0x6f, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x3a, 0x4e, 0xca,
+	0xb4, 0x2d, 0x26, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x8a, 0xe7, 0xb0, 0x2a, 0x1f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x44, 0x75, 0x74, 0x63, 0x68, 0x41, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xe0, 0x03,
+	0x0a, 0x0c, 0x44, 0x75, 0x74, 0x63, 0x68, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27,
+	0x0a, 0x0d, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x48, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x66,
 	0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x8a, 0xe7, 0xb0, 0x2a, 0x1a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0x99, 0x02, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
-	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12,
-	0x21, 0x0a, 0x0c, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x4a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75,
-	0x69, 0x74, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x40,
-	0xca, 0xb4, 0x2d, 0x1e, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x74, 0x63, 0x68, 0x41, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x7b, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f,
+	0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x25, 0x2e, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e,
 	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x8a, 0xe7, 0xb0, 0x2a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
-	0x6b, 0x2f, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0xb5, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74,
-	0x65, 0x67, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x61,
-	0x74, 0x65, 0x67, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x73, 0x63, 0x72,
-	0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x50, 0x0a, 0x17, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77,
-	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x15, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0xea, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d,
-	0x2e, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x11, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x43, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x2d, 0x66,
-	0x72, 0x75, 0x69, 0x74, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2f, 0x61, 0x75, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x76,
-	0x31, 0xa2, 0x02, 0x03, 0x46, 0x41, 0x58, 0xaa, 0x02, 0x15, 0x46, 0x61, 0x74, 0x61, 0x6c, 0x46,
-	0x72, 0x75, 0x69, 0x74, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x31, 0xca,
-	0x02, 0x15, 0x46, 0x61, 0x74, 0x61, 0x6c, 0x46, 0x72, 0x75, 0x69, 0x74, 0x5c, 0x41, 0x75, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x46, 0x61, 0x74, 0x61, 0x6c, 0x46,
-	0x72, 0x75, 0x69, 0x74, 0x5c, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x46, 0x61,
-	0x74, 0x61, 0x6c, 0x46, 0x72, 0x75, 0x69, 0x74, 0x3a, 0x3a, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x3a, 0x3e, 0xca, 0xb4, 0x2d, 0x1e, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69,
+	0x74, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x8a, 0xe7, 0xb0, 0x2a, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x44, 0x75, 0x74, 0x63, 0x68, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xcc, 0x08, 0x0a, 0x18, 0x53, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x42, 0x69, 0x64, 0x41, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x44, 0x0a,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00,
+	0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x0f, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x5f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f,
+	0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x44, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d,
+	0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x51, 0x0a, 0x0f, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c,
+	0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f,
+	0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x76, 0x65,
+	0x61, 0x6c, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x0d, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde,
+	0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7,
+	0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x5f, 0x0a, 0x15, 0x75, 0x6e, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64,
+	0x5f, 0x62, 0x69, 0x64, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2b, 0x2e, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x76,
+	0x65, 0x61, 0x6c, 0x65, 0x64, 0x42, 0x69, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x13,
+	0x75, 0x6e, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x42, 0x69, 0x64, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x3c, 0x0a, 0x0b, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x5f, 0x62,
+	0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x61, 0x74, 0x61, 0x6c,
+	0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x69, 0x64, 0x52, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x42, 0x69,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x5f, 0x62, 0x69, 0x64, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x6e, 0x75, 0x6d, 0x42, 0x69, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x12,
+	0x46, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x6a, 0x0a, 0x13,
+	0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42,
+	0x23, 0xca, 0xb4, 0x2d, 0x1f, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74,
+	0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x52, 0x12, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x6f,
+	0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x61, 0x75, 0x74, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x3a, 0x52, 0xca, 0xb4, 0x2d,
+	0x26, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x8a, 0xe7, 0xb0, 0x2a, 0x23, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x53, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x42, 0x69, 0x64,
+	0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0xec, 0x03, 0x0a, 0x10, 0x53, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x42, 0x69, 0x64, 0x41, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0d, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52,
+	0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x4c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74,
+	0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x6c,
+	0x65, 0x64, 0x42, 0x69, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x7b,
+	0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00,
+	0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a,
+	0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x3d, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x66, 0x61,
+	0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x42, 0xca, 0xb4, 0x2d, 0x1e,
+	0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x8a, 0xe7,
+	0xb0, 0x2a, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x53, 0x65,
+	0x61, 0x6c, 0x65, 0x64, 0x42, 0x69, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf3,
+	0x01, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x42, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x81, 0x01, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde,
+	0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7,
+	0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x3a, 0x26, 0xca, 0xb4,
+	0x2d, 0x22, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x64, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0xad, 0x05, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x44, 0x0a,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00,
+	0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f,
+	0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x0b,
+	0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x64, 0x52, 0x0a,
+	0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x42, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x75,
+	0x6d, 0x5f, 0x62, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6e, 0x75,
+	0x6d, 0x42, 0x69, 0x64, 0x73, 0x12, 0x6a, 0x0a, 0x13, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x23, 0xca, 0xb4, 0x2d, 0x1f, 0x66, 0x61,
+	0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x12, 0x73,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x3a, 0x4e, 0xca, 0xb4, 0x2d, 0x26, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f,
+	0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x1f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0xe0, 0x03, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0d, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01,
+	0x52, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e,
+	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x21,
+	0x0a, 0x0c, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x48, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69,
+	0x74, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x7b, 0x0a, 0x07, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x66, 0x61, 0x74, 0x61, 0x6c,
+	0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x3e, 0xca, 0xb4, 0x2d, 0x1e, 0x66, 0x61, 0x74,
+	0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x8a, 0xe7, 0xb0, 0x2a, 0x17,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x80, 0x01, 0x0a, 0x08, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x42, 0x69, 0x64, 0x12, 0x4c, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x3a, 0x26, 0xca, 0xb4, 0x2d, 0x22, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72,
+	0x75, 0x69, 0x74, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x69, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xbb, 0x01, 0x0a, 0x14, 0x55,
+	0x6e, 0x69, 0x66, 0x6f, 0x72, 0x6d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49,
+	0x64, 0x12, 0x50, 0x0a, 0x17, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x15, 0x65, 0x73,
+	0x63, 0x72, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x3a, 0x23, 0xca, 0xb4, 0x2d, 0x1f, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66,
+	0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x22, 0xaf, 0x05, 0x0a, 0x16, 0x52, 0x65, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x44, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90,
+	0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x0d, 0x63, 0x65,
+	0x69, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde,
+	0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7,
+	0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x63, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x6c, 0x6f, 0x77, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66,
+	0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x69, 0x64, 0x52, 0x09, 0x6c, 0x6f, 0x77, 0x65, 0x73, 0x74, 0x42, 0x69, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x5f, 0x62, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x6e, 0x75, 0x6d, 0x42, 0x69, 0x64, 0x73, 0x12, 0x6a, 0x0a, 0x13, 0x73, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x23, 0xca, 0xb4,
+	0x2d, 0x1f, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x52, 0x12, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x75, 0x74,
+	0x6f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x3a, 0x50, 0xca, 0xb4, 0x2d, 0x26, 0x66, 0x61,
+	0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x8a, 0xe7, 0xb0, 0x2a, 0x21, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x41, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xe6, 0x03, 0x0a, 0x0e, 0x52,
+	0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a,
+	0x0d, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4a, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x66, 0x61,
+	0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x41, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x7b, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63,
+	0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x25, 0x2e, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74,
+	0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x3a, 0x40, 0xca, 0xb4, 0x2d, 0x1e, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75,
+	0x69, 0x74, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x8a, 0xe7, 0xb0, 0x2a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x41, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0xba, 0x01, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x63, 0x75, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x65,
+	0x73, 0x63, 0x72, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x50, 0x0a, 0x17, 0x65, 0x73, 0x63,
+	0x72, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x15, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x3a, 0x23, 0xca, 0xb4, 0x2d,
+	0x1f, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x2a, 0x7d, 0x0a, 0x11, 0x44, 0x65, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x44, 0x45, 0x43, 0x52, 0x45, 0x4d, 0x45,
+	0x4e, 0x54, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x45, 0x43,
+	0x52, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f,
+	0x4c, 0x49, 0x4e, 0x45, 0x41, 0x52, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x45, 0x43, 0x52,
+	0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x53,
+	0x54, 0x45, 0x50, 0x57, 0x49, 0x53, 0x45, 0x10, 0x02, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a,
+	0x87, 0x01, 0x0a, 0x13, 0x55, 0x6e, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x42, 0x69,
+	0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x25, 0x0a, 0x21, 0x55, 0x4e, 0x52, 0x45, 0x56,
+	0x45, 0x41, 0x4c, 0x45, 0x44, 0x5f, 0x42, 0x49, 0x44, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20,
+	0x0a, 0x1c, 0x55, 0x4e, 0x52, 0x45, 0x56, 0x45, 0x41, 0x4c, 0x45, 0x44, 0x5f, 0x42, 0x49, 0x44,
+	0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x10, 0x01,
+	0x12, 0x21, 0x0a, 0x1d, 0x55, 0x4e, 0x52, 0x45, 0x56, 0x45, 0x41, 0x4c, 0x45, 0x44, 0x5f, 0x42,
+	0x49, 0x44, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x46, 0x45, 0x49,
+	0x54, 0x10, 0x02, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xea, 0x01, 0x0a, 0x1a, 0x63, 0x6f,
+	0x6d, 0x2e, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x11, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x43, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x2d,
+	0x66, 0x72, 0x75, 0x69, 0x74, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2f, 0x61, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x46, 0x41, 0x58, 0xaa, 0x02, 0x15, 0x46, 0x61, 0x74, 0x61, 0x6c,
+	0x46, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x15, 0x46, 0x61, 0x74, 0x61, 0x6c, 0x46, 0x72, 0x75, 0x69, 0x74, 0x5c, 0x41, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x46, 0x61, 0x74, 0x61, 0x6c,
+	0x46, 0x72, 0x75, 0x69, 0x74, 0x5c, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x46,
+	0x61, 0x74, 0x61, 0x6c, 0x46, 0x72, 0x75, 0x69, 0x74, 0x3a, 0x3a, 0x41, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2536,30 +15180,101 @@ func file_fatal_fruit_auction_v1_auctiontypes_proto_rawDescGZIP() []byte {
 	return file_fatal_fruit_auction_v1_auctiontypes_proto_rawDescData
 }
 
-var file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_fatal_fruit_auction_v1_auctiontypes_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_fatal_fruit_auction_v1_auctiontypes_proto_goTypes = []interface{}{
-	(*ReserveAuctionMetadata)(nil), // 0: fatal_fruit.auction.v1.ReserveAuctionMetadata
-	(*ReserveAuction)(nil),         // 1: fatal_fruit.auction.v1.ReserveAuction
-	(*SettleStrategy)(nil),         // 2: fatal_fruit.auction.v1.SettleStrategy
-	(*durationpb.Duration)(nil),    // 3: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil),  // 4: google.protobuf.Timestamp
-	(*v1beta1.Coin)(nil),           // 5: cosmos.base.v1beta1.Coin
-	(*Bid)(nil),                    // 6: fatal_fruit.auction.v1.Bid
+	(DecrementSchedule)(0),           // 0: fatal_fruit.auction.v1.DecrementSchedule
+	(UnrevealedBidPolicy)(0),         // 1: fatal_fruit.auction.v1.UnrevealedBidPolicy
+	(*ReserveAuctionMetadata)(nil),   // 2: fatal_fruit.auction.v1.ReserveAuctionMetadata
+	(*ReserveAuction)(nil),           // 3: fatal_fruit.auction.v1.ReserveAuction
+	(*SettleStrategy)(nil),           // 4: fatal_fruit.auction.v1.SettleStrategy
+	(*DutchAuctionMetadata)(nil),     // 5: fatal_fruit.auction.v1.DutchAuctionMetadata
+	(*DutchAuction)(nil),             // 6: fatal_fruit.auction.v1.DutchAuction
+	(*SealedBidAuctionMetadata)(nil), // 7: fatal_fruit.auction.v1.SealedBidAuctionMetadata
+	(*SealedBidAuction)(nil),         // 8: fatal_fruit.auction.v1.SealedBidAuction
+	(*SealedBid)(nil),                // 9: fatal_fruit.auction.v1.SealedBid
+	(*BatchAuctionMetadata)(nil),     // 10: fatal_fruit.auction.v1.BatchAuctionMetadata
+	(*BatchAuction)(nil),             // 11: fatal_fruit.auction.v1.BatchAuction
+	(*BatchBid)(nil),                 // 12: fatal_fruit.auction.v1.BatchBid
+	(*UniformPriceStrategy)(nil),     // 13: fatal_fruit.auction.v1.UniformPriceStrategy
+	(*ReverseAuctionMetadata)(nil),   // 14: fatal_fruit.auction.v1.ReverseAuctionMetadata
+	(*ReverseAuction)(nil),           // 15: fatal_fruit.auction.v1.ReverseAuction
+	(*ProcurementStrategy)(nil),      // 16: fatal_fruit.auction.v1.ProcurementStrategy
+	(*durationpb.Duration)(nil),      // 17: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),    // 18: google.protobuf.Timestamp
+	(*v1beta1.Coin)(nil),             // 19: cosmos.base.v1beta1.Coin
+	(*Bid)(nil),                      // 20: fatal_fruit.auction.v1.Bid
+	(*anypb.Any)(nil),                // 21: google.protobuf.Any
+	(AuctionStatus)(0),               // 22: fatal_fruit.auction.v1.AuctionStatus
 }
 var file_fatal_fruit_auction_v1_auctiontypes_proto_depIdxs = []int32{
-	3, // 0: fatal_fruit.auction.v1.ReserveAuctionMetadata.duration:type_name -> google.protobuf.Duration
-	4, // 1: fatal_fruit.auction.v1.ReserveAuctionMetadata.start_time:type_name -> google.protobuf.Timestamp
-	4, // 2: fatal_fruit.auction.v1.ReserveAuctionMetadata.end_time:type_name -> google.protobuf.Timestamp
-	5, // 3: fatal_fruit.auction.v1.ReserveAuctionMetadata.reserve_price:type_name -> cosmos.base.v1beta1.Coin
-	6, // 4: fatal_fruit.auction.v1.ReserveAuctionMetadata.bids:type_name -> fatal_fruit.auction.v1.Bid
-	5, // 5: fatal_fruit.auction.v1.ReserveAuctionMetadata.last_price:type_name -> cosmos.base.v1beta1.Coin
-	2, // 6: fatal_fruit.auction.v1.ReserveAuctionMetadata.strategy:type_name -> fatal_fruit.auction.v1.SettleStrategy
-	0, // 7: fatal_fruit.auction.v1.ReserveAuction.metadata:type_name -> fatal_fruit.auction.v1.ReserveAuctionMetadata
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	17, // 0: fatal_fruit.auction.v1.ReserveAuctionMetadata.duration:type_name -> google.protobuf.Duration
+	18, // 1: fatal_fruit.auction.v1.ReserveAuctionMetadata.start_time:type_name -> google.protobuf.Timestamp
+	18, // 2: fatal_fruit.auction.v1.ReserveAuctionMetadata.end_time:type_name -> google.protobuf.Timestamp
+	19, // 3: fatal_fruit.auction.v1.ReserveAuctionMetadata.reserve_price:type_name -> cosmos.base.v1beta1.Coin
+	20, // 4: fatal_fruit.auction.v1.ReserveAuctionMetadata.bids:type_name -> fatal_fruit.auction.v1.Bid
+	19, // 5: fatal_fruit.auction.v1.ReserveAuctionMetadata.last_price:type_name -> cosmos.base.v1beta1.Coin
+	4,  // 6: fatal_fruit.auction.v1.ReserveAuctionMetadata.strategy:type_name -> fatal_fruit.auction.v1.SettleStrategy
+	20, // 7: fatal_fruit.auction.v1.ReserveAuctionMetadata.highest_bid:type_name -> fatal_fruit.auction.v1.Bid
+	21, // 8: fatal_fruit.auction.v1.ReserveAuctionMetadata.settlement_strategy:type_name -> google.protobuf.Any
+	17, // 9: fatal_fruit.auction.v1.ReserveAuctionMetadata.extension_window:type_name -> google.protobuf.Duration
+	17, // 10: fatal_fruit.auction.v1.ReserveAuctionMetadata.extension_duration:type_name -> google.protobuf.Duration
+	19, // 11: fatal_fruit.auction.v1.ReserveAuctionMetadata.buy_now_price:type_name -> cosmos.base.v1beta1.Coin
+	19, // 12: fatal_fruit.auction.v1.ReserveAuctionMetadata.min_increment:type_name -> cosmos.base.v1beta1.Coin
+	2,  // 13: fatal_fruit.auction.v1.ReserveAuction.metadata:type_name -> fatal_fruit.auction.v1.ReserveAuctionMetadata
+	19, // 14: fatal_fruit.auction.v1.ReserveAuction.deposit:type_name -> cosmos.base.v1beta1.Coin
+	22, // 15: fatal_fruit.auction.v1.ReserveAuction.status:type_name -> fatal_fruit.auction.v1.AuctionStatus
+	17, // 16: fatal_fruit.auction.v1.DutchAuctionMetadata.duration:type_name -> google.protobuf.Duration
+	18, // 17: fatal_fruit.auction.v1.DutchAuctionMetadata.start_time:type_name -> google.protobuf.Timestamp
+	18, // 18: fatal_fruit.auction.v1.DutchAuctionMetadata.end_time:type_name -> google.protobuf.Timestamp
+	19, // 19: fatal_fruit.auction.v1.DutchAuctionMetadata.start_price:type_name -> cosmos.base.v1beta1.Coin
+	19, // 20: fatal_fruit.auction.v1.DutchAuctionMetadata.floor_price:type_name -> cosmos.base.v1beta1.Coin
+	0,  // 21: fatal_fruit.auction.v1.DutchAuctionMetadata.schedule:type_name -> fatal_fruit.auction.v1.DecrementSchedule
+	19, // 22: fatal_fruit.auction.v1.DutchAuctionMetadata.decrement:type_name -> cosmos.base.v1beta1.Coin
+	17, // 23: fatal_fruit.auction.v1.DutchAuctionMetadata.step_interval:type_name -> google.protobuf.Duration
+	20, // 24: fatal_fruit.auction.v1.DutchAuctionMetadata.winning_bid:type_name -> fatal_fruit.auction.v1.Bid
+	4,  // 25: fatal_fruit.auction.v1.DutchAuctionMetadata.strategy:type_name -> fatal_fruit.auction.v1.SettleStrategy
+	21, // 26: fatal_fruit.auction.v1.DutchAuctionMetadata.settlement_strategy:type_name -> google.protobuf.Any
+	5,  // 27: fatal_fruit.auction.v1.DutchAuction.metadata:type_name -> fatal_fruit.auction.v1.DutchAuctionMetadata
+	19, // 28: fatal_fruit.auction.v1.DutchAuction.deposit:type_name -> cosmos.base.v1beta1.Coin
+	22, // 29: fatal_fruit.auction.v1.DutchAuction.status:type_name -> fatal_fruit.auction.v1.AuctionStatus
+	17, // 30: fatal_fruit.auction.v1.SealedBidAuctionMetadata.duration:type_name -> google.protobuf.Duration
+	17, // 31: fatal_fruit.auction.v1.SealedBidAuctionMetadata.reveal_duration:type_name -> google.protobuf.Duration
+	18, // 32: fatal_fruit.auction.v1.SealedBidAuctionMetadata.start_time:type_name -> google.protobuf.Timestamp
+	18, // 33: fatal_fruit.auction.v1.SealedBidAuctionMetadata.end_time:type_name -> google.protobuf.Timestamp
+	18, // 34: fatal_fruit.auction.v1.SealedBidAuctionMetadata.reveal_end_time:type_name -> google.protobuf.Timestamp
+	19, // 35: fatal_fruit.auction.v1.SealedBidAuctionMetadata.reserve_price:type_name -> cosmos.base.v1beta1.Coin
+	1,  // 36: fatal_fruit.auction.v1.SealedBidAuctionMetadata.unrevealed_bid_policy:type_name -> fatal_fruit.auction.v1.UnrevealedBidPolicy
+	20, // 37: fatal_fruit.auction.v1.SealedBidAuctionMetadata.highest_bid:type_name -> fatal_fruit.auction.v1.Bid
+	4,  // 38: fatal_fruit.auction.v1.SealedBidAuctionMetadata.strategy:type_name -> fatal_fruit.auction.v1.SettleStrategy
+	21, // 39: fatal_fruit.auction.v1.SealedBidAuctionMetadata.settlement_strategy:type_name -> google.protobuf.Any
+	7,  // 40: fatal_fruit.auction.v1.SealedBidAuction.metadata:type_name -> fatal_fruit.auction.v1.SealedBidAuctionMetadata
+	19, // 41: fatal_fruit.auction.v1.SealedBidAuction.deposit:type_name -> cosmos.base.v1beta1.Coin
+	22, // 42: fatal_fruit.auction.v1.SealedBidAuction.status:type_name -> fatal_fruit.auction.v1.AuctionStatus
+	19, // 43: fatal_fruit.auction.v1.SealedBid.collateral:type_name -> cosmos.base.v1beta1.Coin
+	17, // 44: fatal_fruit.auction.v1.BatchAuctionMetadata.duration:type_name -> google.protobuf.Duration
+	18, // 45: fatal_fruit.auction.v1.BatchAuctionMetadata.start_time:type_name -> google.protobuf.Timestamp
+	18, // 46: fatal_fruit.auction.v1.BatchAuctionMetadata.end_time:type_name -> google.protobuf.Timestamp
+	19, // 47: fatal_fruit.auction.v1.BatchAuctionMetadata.reserve_price:type_name -> cosmos.base.v1beta1.Coin
+	20, // 48: fatal_fruit.auction.v1.BatchAuctionMetadata.highest_bid:type_name -> fatal_fruit.auction.v1.Bid
+	21, // 49: fatal_fruit.auction.v1.BatchAuctionMetadata.settlement_strategy:type_name -> google.protobuf.Any
+	10, // 50: fatal_fruit.auction.v1.BatchAuction.metadata:type_name -> fatal_fruit.auction.v1.BatchAuctionMetadata
+	19, // 51: fatal_fruit.auction.v1.BatchAuction.deposit:type_name -> cosmos.base.v1beta1.Coin
+	22, // 52: fatal_fruit.auction.v1.BatchAuction.status:type_name -> fatal_fruit.auction.v1.AuctionStatus
+	17, // 53: fatal_fruit.auction.v1.ReverseAuctionMetadata.duration:type_name -> google.protobuf.Duration
+	18, // 54: fatal_fruit.auction.v1.ReverseAuctionMetadata.start_time:type_name -> google.protobuf.Timestamp
+	18, // 55: fatal_fruit.auction.v1.ReverseAuctionMetadata.end_time:type_name -> google.protobuf.Timestamp
+	19, // 56: fatal_fruit.auction.v1.ReverseAuctionMetadata.ceiling_price:type_name -> cosmos.base.v1beta1.Coin
+	20, // 57: fatal_fruit.auction.v1.ReverseAuctionMetadata.lowest_bid:type_name -> fatal_fruit.auction.v1.Bid
+	21, // 58: fatal_fruit.auction.v1.ReverseAuctionMetadata.settlement_strategy:type_name -> google.protobuf.Any
+	14, // 59: fatal_fruit.auction.v1.ReverseAuction.metadata:type_name -> fatal_fruit.auction.v1.ReverseAuctionMetadata
+	19, // 60: fatal_fruit.auction.v1.ReverseAuction.deposit:type_name -> cosmos.base.v1beta1.Coin
+	22, // 61: fatal_fruit.auction.v1.ReverseAuction.status:type_name -> fatal_fruit.auction.v1.AuctionStatus
+	62, // [62:62] is the sub-list for method output_type
+	62, // [62:62] is the sub-list for method input_type
+	62, // [62:62] is the sub-list for extension type_name
+	62, // [62:62] is the sub-list for extension extendee
+	0,  // [0:62] is the sub-list for field type_name
 }
 
 func init() { file_fatal_fruit_auction_v1_auctiontypes_proto_init() }
@@ -2605,19 +15320,164 @@ func file_fatal_fruit_auction_v1_auctiontypes_proto_init() {
 				return nil
 			}
 		}
+		file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DutchAuctionMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DutchAuction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SealedBidAuctionMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SealedBidAuction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SealedBid); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchAuctionMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchAuction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchBid); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UniformPriceStrategy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReverseAuctionMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReverseAuction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcurementStrategy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_fatal_fruit_auction_v1_auctiontypes_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      2,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_fatal_fruit_auction_v1_auctiontypes_proto_goTypes,
 		DependencyIndexes: file_fatal_fruit_auction_v1_auctiontypes_proto_depIdxs,
+		EnumInfos:         file_fatal_fruit_auction_v1_auctiontypes_proto_enumTypes,
 		MessageInfos:      file_fatal_fruit_auction_v1_auctiontypes_proto_msgTypes,
 	}.Build()
 	File_fatal_fruit_auction_v1_auctiontypes_proto = out.File
@@ -19,9 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Auction_FullMethodName       = "/fatal_fruit.auction.v1.Query/Auction"
-	Query_OwnerAuctions_FullMethodName = "/fatal_fruit.auction.v1.Query/OwnerAuctions"
-	Query_AllAuctions_FullMethodName   = "/fatal_fruit.auction.v1.Query/AllAuctions"
+	Query_Auction_FullMethodName           = "/fatal_fruit.auction.v1.Query/Auction"
+	Query_OwnerAuctions_FullMethodName     = "/fatal_fruit.auction.v1.Query/OwnerAuctions"
+	Query_AuctionBids_FullMethodName       = "/fatal_fruit.auction.v1.Query/AuctionBids"
+	Query_NextMinimumBid_FullMethodName    = "/fatal_fruit.auction.v1.Query/NextMinimumBid"
+	Query_BidderAuctions_FullMethodName    = "/fatal_fruit.auction.v1.Query/BidderAuctions"
+	Query_AllAuctions_FullMethodName       = "/fatal_fruit.auction.v1.Query/AllAuctions"
+	Query_ActiveAuctions_FullMethodName    = "/fatal_fruit.auction.v1.Query/ActiveAuctions"
+	Query_RevealAuctions_FullMethodName    = "/fatal_fruit.auction.v1.Query/RevealAuctions"
+	Query_ExpiredAuctions_FullMethodName   = "/fatal_fruit.auction.v1.Query/ExpiredAuctions"
+	Query_PendingAuctions_FullMethodName   = "/fatal_fruit.auction.v1.Query/PendingAuctions"
+	Query_CancelledAuctions_FullMethodName = "/fatal_fruit.auction.v1.Query/CancelledAuctions"
+	Query_Params_FullMethodName            = "/fatal_fruit.auction.v1.Query/Params"
 )
 
 // QueryClient is the client API for Query service.
@@ -32,8 +41,26 @@ type QueryClient interface {
 	Auction(ctx context.Context, in *QueryAuctionRequest, opts ...grpc.CallOption) (*QueryAuctionResponse, error)
 	// OwnerAuctions retrieves all auctions owned by an address.
 	OwnerAuctions(ctx context.Context, in *QueryOwnerAuctionsRequest, opts ...grpc.CallOption) (*QueryOwnerAuctionsResponse, error)
+	// AuctionBids retrieves a paginated list of the bids placed on an auction in the order they were accepted.
+	AuctionBids(ctx context.Context, in *QueryAuctionBidsRequest, opts ...grpc.CallOption) (*QueryAuctionBidsResponse, error)
+	// NextMinimumBid retrieves the lowest bid an auction will currently accept.
+	NextMinimumBid(ctx context.Context, in *QueryNextMinimumBidRequest, opts ...grpc.CallOption) (*QueryNextMinimumBidResponse, error)
+	// BidderAuctions retrieves a paginated list of auctions an address has bid on, along with its bids.
+	BidderAuctions(ctx context.Context, in *QueryBidderAuctionsRequest, opts ...grpc.CallOption) (*QueryBidderAuctionsResponse, error)
 	// AllAuctions retrieves a paginated list of all auctions.
 	AllAuctions(ctx context.Context, in *QueryAllAuctionsRequest, opts ...grpc.CallOption) (*QueryAllAuctionsResponse, error)
+	// ActiveAuctions retrieves a paginated list of auctions in the active queue.
+	ActiveAuctions(ctx context.Context, in *QueryActiveAuctionsRequest, opts ...grpc.CallOption) (*QueryActiveAuctionsResponse, error)
+	// RevealAuctions retrieves a paginated list of sealed-bid auctions in their reveal phase.
+	RevealAuctions(ctx context.Context, in *QueryRevealAuctionsRequest, opts ...grpc.CallOption) (*QueryRevealAuctionsResponse, error)
+	// ExpiredAuctions retrieves a paginated list of auctions in the expired queue awaiting processing.
+	ExpiredAuctions(ctx context.Context, in *QueryExpiredAuctionsRequest, opts ...grpc.CallOption) (*QueryExpiredAuctionsResponse, error)
+	// PendingAuctions retrieves a paginated list of auctions in the pending queue waiting to be executed.
+	PendingAuctions(ctx context.Context, in *QueryPendingAuctionsRequest, opts ...grpc.CallOption) (*QueryPendingAuctionsResponse, error)
+	// CancelledAuctions retrieves a paginated list of auctions in the cancelled queue.
+	CancelledAuctions(ctx context.Context, in *QueryCancelledAuctionsRequest, opts ...grpc.CallOption) (*QueryCancelledAuctionsResponse, error)
+	// Params retrieves the module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -62,6 +89,33 @@ func (c *queryClient) OwnerAuctions(ctx context.Context, in *QueryOwnerAuctionsR
 	return out, nil
 }
 
+func (c *queryClient) AuctionBids(ctx context.Context, in *QueryAuctionBidsRequest, opts ...grpc.CallOption) (*QueryAuctionBidsResponse, error) {
+	out := new(QueryAuctionBidsResponse)
+	err := c.cc.Invoke(ctx, Query_AuctionBids_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NextMinimumBid(ctx context.Context, in *QueryNextMinimumBidRequest, opts ...grpc.CallOption) (*QueryNextMinimumBidResponse, error) {
+	out := new(QueryNextMinimumBidResponse)
+	err := c.cc.Invoke(ctx, Query_NextMinimumBid_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BidderAuctions(ctx context.Context, in *QueryBidderAuctionsRequest, opts ...grpc.CallOption) (*QueryBidderAuctionsResponse, error) {
+	out := new(QueryBidderAuctionsResponse)
+	err := c.cc.Invoke(ctx, Query_BidderAuctions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) AllAuctions(ctx context.Context, in *QueryAllAuctionsRequest, opts ...grpc.CallOption) (*QueryAllAuctionsResponse, error) {
 	out := new(QueryAllAuctionsResponse)
 	err := c.cc.Invoke(ctx, Query_AllAuctions_FullMethodName, in, out, opts...)
@@ -71,6 +125,60 @@ func (c *queryClient) AllAuctions(ctx context.Context, in *QueryAllAuctionsReque
 	return out, nil
 }
 
+func (c *queryClient) ActiveAuctions(ctx context.Context, in *QueryActiveAuctionsRequest, opts ...grpc.CallOption) (*QueryActiveAuctionsResponse, error) {
+	out := new(QueryActiveAuctionsResponse)
+	err := c.cc.Invoke(ctx, Query_ActiveAuctions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RevealAuctions(ctx context.Context, in *QueryRevealAuctionsRequest, opts ...grpc.CallOption) (*QueryRevealAuctionsResponse, error) {
+	out := new(QueryRevealAuctionsResponse)
+	err := c.cc.Invoke(ctx, Query_RevealAuctions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExpiredAuctions(ctx context.Context, in *QueryExpiredAuctionsRequest, opts ...grpc.CallOption) (*QueryExpiredAuctionsResponse, error) {
+	out := new(QueryExpiredAuctionsResponse)
+	err := c.cc.Invoke(ctx, Query_ExpiredAuctions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingAuctions(ctx context.Context, in *QueryPendingAuctionsRequest, opts ...grpc.CallOption) (*QueryPendingAuctionsResponse, error) {
+	out := new(QueryPendingAuctionsResponse)
+	err := c.cc.Invoke(ctx, Query_PendingAuctions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CancelledAuctions(ctx context.Context, in *QueryCancelledAuctionsRequest, opts ...grpc.CallOption) (*QueryCancelledAuctionsResponse, error) {
+	out := new(QueryCancelledAuctionsResponse)
+	err := c.cc.Invoke(ctx, Query_CancelledAuctions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -79,8 +187,26 @@ type QueryServer interface {
 	Auction(context.Context, *QueryAuctionRequest) (*QueryAuctionResponse, error)
 	// OwnerAuctions retrieves all auctions owned by an address.
 	OwnerAuctions(context.Context, *QueryOwnerAuctionsRequest) (*QueryOwnerAuctionsResponse, error)
+	// AuctionBids retrieves a paginated list of the bids placed on an auction in the order they were accepted.
+	AuctionBids(context.Context, *QueryAuctionBidsRequest) (*QueryAuctionBidsResponse, error)
+	// NextMinimumBid retrieves the lowest bid an auction will currently accept.
+	NextMinimumBid(context.Context, *QueryNextMinimumBidRequest) (*QueryNextMinimumBidResponse, error)
+	// BidderAuctions retrieves a paginated list of auctions an address has bid on, along with its bids.
+	BidderAuctions(context.Context, *QueryBidderAuctionsRequest) (*QueryBidderAuctionsResponse, error)
 	// AllAuctions retrieves a paginated list of all auctions.
 	AllAuctions(context.Context, *QueryAllAuctionsRequest) (*QueryAllAuctionsResponse, error)
+	// ActiveAuctions retrieves a paginated list of auctions in the active queue.
+	ActiveAuctions(context.Context, *QueryActiveAuctionsRequest) (*QueryActiveAuctionsResponse, error)
+	// RevealAuctions retrieves a paginated list of sealed-bid auctions in their reveal phase.
+	RevealAuctions(context.Context, *QueryRevealAuctionsRequest) (*QueryRevealAuctionsResponse, error)
+	// ExpiredAuctions retrieves a paginated list of auctions in the expired queue awaiting processing.
+	ExpiredAuctions(context.Context, *QueryExpiredAuctionsRequest) (*QueryExpiredAuctionsResponse, error)
+	// PendingAuctions retrieves a paginated list of auctions in the pending queue waiting to be executed.
+	PendingAuctions(context.Context, *QueryPendingAuctionsRequest) (*QueryPendingAuctionsResponse, error)
+	// CancelledAuctions retrieves a paginated list of auctions in the cancelled queue.
+	CancelledAuctions(context.Context, *QueryCancelledAuctionsRequest) (*QueryCancelledAuctionsResponse, error)
+	// Params retrieves the module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -94,9 +220,36 @@ func (UnimplementedQueryServer) Auction(context.Context, *QueryAuctionRequest) (
 func (UnimplementedQueryServer) OwnerAuctions(context.Context, *QueryOwnerAuctionsRequest) (*QueryOwnerAuctionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OwnerAuctions not implemented")
 }
+func (UnimplementedQueryServer) AuctionBids(context.Context, *QueryAuctionBidsRequest) (*QueryAuctionBidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuctionBids not implemented")
+}
+func (UnimplementedQueryServer) NextMinimumBid(context.Context, *QueryNextMinimumBidRequest) (*QueryNextMinimumBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextMinimumBid not implemented")
+}
+func (UnimplementedQueryServer) BidderAuctions(context.Context, *QueryBidderAuctionsRequest) (*QueryBidderAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BidderAuctions not implemented")
+}
 func (UnimplementedQueryServer) AllAuctions(context.Context, *QueryAllAuctionsRequest) (*QueryAllAuctionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllAuctions not implemented")
 }
+func (UnimplementedQueryServer) ActiveAuctions(context.Context, *QueryActiveAuctionsRequest) (*QueryActiveAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveAuctions not implemented")
+}
+func (UnimplementedQueryServer) RevealAuctions(context.Context, *QueryRevealAuctionsRequest) (*QueryRevealAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealAuctions not implemented")
+}
+func (UnimplementedQueryServer) ExpiredAuctions(context.Context, *QueryExpiredAuctionsRequest) (*QueryExpiredAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpiredAuctions not implemented")
+}
+func (UnimplementedQueryServer) PendingAuctions(context.Context, *QueryPendingAuctionsRequest) (*QueryPendingAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingAuctions not implemented")
+}
+func (UnimplementedQueryServer) CancelledAuctions(context.Context, *QueryCancelledAuctionsRequest) (*QueryCancelledAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelledAuctions not implemented")
+}
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -146,6 +299,60 @@ func _Query_OwnerAuctions_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AuctionBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionBidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuctionBids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AuctionBids_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuctionBids(ctx, req.(*QueryAuctionBidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextMinimumBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextMinimumBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextMinimumBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NextMinimumBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextMinimumBid(ctx, req.(*QueryNextMinimumBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BidderAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBidderAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BidderAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BidderAuctions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BidderAuctions(ctx, req.(*QueryBidderAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_AllAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAllAuctionsRequest)
 	if err := dec(in); err != nil {
@@ -164,6 +371,114 @@ func _Query_AllAuctions_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ActiveAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActiveAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ActiveAuctions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActiveAuctions(ctx, req.(*QueryActiveAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RevealAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRevealAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RevealAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RevealAuctions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RevealAuctions(ctx, req.(*QueryRevealAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExpiredAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExpiredAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExpiredAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ExpiredAuctions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExpiredAuctions(ctx, req.(*QueryExpiredAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingAuctions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingAuctions(ctx, req.(*QueryPendingAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CancelledAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCancelledAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CancelledAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CancelledAuctions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CancelledAuctions(ctx, req.(*QueryCancelledAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -179,10 +494,46 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "OwnerAuctions",
 			Handler:    _Query_OwnerAuctions_Handler,
 		},
+		{
+			MethodName: "AuctionBids",
+			Handler:    _Query_AuctionBids_Handler,
+		},
+		{
+			MethodName: "NextMinimumBid",
+			Handler:    _Query_NextMinimumBid_Handler,
+		},
+		{
+			MethodName: "BidderAuctions",
+			Handler:    _Query_BidderAuctions_Handler,
+		},
 		{
 			MethodName: "AllAuctions",
 			Handler:    _Query_AllAuctions_Handler,
 		},
+		{
+			MethodName: "ActiveAuctions",
+			Handler:    _Query_ActiveAuctions_Handler,
+		},
+		{
+			MethodName: "RevealAuctions",
+			Handler:    _Query_RevealAuctions_Handler,
+		},
+		{
+			MethodName: "ExpiredAuctions",
+			Handler:    _Query_ExpiredAuctions_Handler,
+		},
+		{
+			MethodName: "PendingAuctions",
+			Handler:    _Query_PendingAuctions_Handler,
+		},
+		{
+			MethodName: "CancelledAuctions",
+			Handler:    _Query_CancelledAuctions_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "fatal_fruit/auction/v1/query.proto",
@@ -19,10 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_NewAuction_FullMethodName   = "/fatal_fruit.auction.v1.Msg/NewAuction"
-	Msg_StartAuction_FullMethodName = "/fatal_fruit.auction.v1.Msg/StartAuction"
-	Msg_NewBid_FullMethodName       = "/fatal_fruit.auction.v1.Msg/NewBid"
-	Msg_Exec_FullMethodName         = "/fatal_fruit.auction.v1.Msg/Exec"
+	Msg_NewAuction_FullMethodName    = "/fatal_fruit.auction.v1.Msg/NewAuction"
+	Msg_StartAuction_FullMethodName  = "/fatal_fruit.auction.v1.Msg/StartAuction"
+	Msg_CancelAuction_FullMethodName = "/fatal_fruit.auction.v1.Msg/CancelAuction"
+	Msg_NewBid_FullMethodName        = "/fatal_fruit.auction.v1.Msg/NewBid"
+	Msg_RevealBid_FullMethodName     = "/fatal_fruit.auction.v1.Msg/RevealBid"
+	Msg_Exec_FullMethodName          = "/fatal_fruit.auction.v1.Msg/Exec"
+	Msg_UpdateParams_FullMethodName  = "/fatal_fruit.auction.v1.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -33,10 +36,16 @@ type MsgClient interface {
 	NewAuction(ctx context.Context, in *MsgNewAuction, opts ...grpc.CallOption) (*MsgNewAuctionResponse, error)
 	// StartAuction initializes the auction
 	StartAuction(ctx context.Context, in *MsgStartAuction, opts ...grpc.CallOption) (*MsgStartAuctionResponse, error)
+	// CancelAuction cancels an active auction that has not received any bids.
+	CancelAuction(ctx context.Context, in *MsgCancelAuction, opts ...grpc.CallOption) (*MsgCancelAuctionResponse, error)
 	// NewBid places a new bid on an auction.
 	NewBid(ctx context.Context, in *MsgNewBid, opts ...grpc.CallOption) (*MsgNewBidResponse, error)
+	// RevealBid reveals a sealed bid during the reveal phase of a sealed-bid auction.
+	RevealBid(ctx context.Context, in *MsgRevealBid, opts ...grpc.CallOption) (*MsgRevealBidResponse, error)
 	// Exec executes an auction, distributing funds and finalizing the auction.
 	Exec(ctx context.Context, in *MsgExecAuction, opts ...grpc.CallOption) (*MsgExecAuctionResponse, error)
+	// UpdateParams updates the module parameters. It can only be called by the module authority.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -65,6 +74,15 @@ func (c *msgClient) StartAuction(ctx context.Context, in *MsgStartAuction, opts
 	return out, nil
 }
 
+func (c *msgClient) CancelAuction(ctx context.Context, in *MsgCancelAuction, opts ...grpc.CallOption) (*MsgCancelAuctionResponse, error) {
+	out := new(MsgCancelAuctionResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelAuction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) NewBid(ctx context.Context, in *MsgNewBid, opts ...grpc.CallOption) (*MsgNewBidResponse, error) {
 	out := new(MsgNewBidResponse)
 	err := c.cc.Invoke(ctx, Msg_NewBid_FullMethodName, in, out, opts...)
@@ -74,6 +92,15 @@ func (c *msgClient) NewBid(ctx context.Context, in *MsgNewBid, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) RevealBid(ctx context.Context, in *MsgRevealBid, opts ...grpc.CallOption) (*MsgRevealBidResponse, error) {
+	out := new(MsgRevealBidResponse)
+	err := c.cc.Invoke(ctx, Msg_RevealBid_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Exec(ctx context.Context, in *MsgExecAuction, opts ...grpc.CallOption) (*MsgExecAuctionResponse, error) {
 	out := new(MsgExecAuctionResponse)
 	err := c.cc.Invoke(ctx, Msg_Exec_FullMethodName, in, out, opts...)
@@ -83,6 +110,15 @@ func (c *msgClient) Exec(ctx context.Context, in *MsgExecAuction, opts ...grpc.C
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -91,10 +127,16 @@ type MsgServer interface {
 	NewAuction(context.Context, *MsgNewAuction) (*MsgNewAuctionResponse, error)
 	// StartAuction initializes the auction
 	StartAuction(context.Context, *MsgStartAuction) (*MsgStartAuctionResponse, error)
+	// CancelAuction cancels an active auction that has not received any bids.
+	CancelAuction(context.Context, *MsgCancelAuction) (*MsgCancelAuctionResponse, error)
 	// NewBid places a new bid on an auction.
 	NewBid(context.Context, *MsgNewBid) (*MsgNewBidResponse, error)
+	// RevealBid reveals a sealed bid during the reveal phase of a sealed-bid auction.
+	RevealBid(context.Context, *MsgRevealBid) (*MsgRevealBidResponse, error)
 	// Exec executes an auction, distributing funds and finalizing the auction.
 	Exec(context.Context, *MsgExecAuction) (*MsgExecAuctionResponse, error)
+	// UpdateParams updates the module parameters. It can only be called by the module authority.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -108,12 +150,21 @@ func (UnimplementedMsgServer) NewAuction(context.Context, *MsgNewAuction) (*MsgN
 func (UnimplementedMsgServer) StartAuction(context.Context, *MsgStartAuction) (*MsgStartAuctionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartAuction not implemented")
 }
+func (UnimplementedMsgServer) CancelAuction(context.Context, *MsgCancelAuction) (*MsgCancelAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAuction not implemented")
+}
 func (UnimplementedMsgServer) NewBid(context.Context, *MsgNewBid) (*MsgNewBidResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NewBid not implemented")
 }
+func (UnimplementedMsgServer) RevealBid(context.Context, *MsgRevealBid) (*MsgRevealBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealBid not implemented")
+}
 func (UnimplementedMsgServer) Exec(context.Context, *MsgExecAuction) (*MsgExecAuctionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Exec not implemented")
 }
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -163,6 +214,24 @@ func _Msg_StartAuction_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelAuction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAuction(ctx, req.(*MsgCancelAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_NewBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgNewBid)
 	if err := dec(in); err != nil {
@@ -181,6 +250,24 @@ func _Msg_NewBid_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RevealBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevealBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealBid(ctx, req.(*MsgRevealBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgExecAuction)
 	if err := dec(in); err != nil {
@@ -199,6 +286,24 @@ func _Msg_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -214,14 +319,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StartAuction",
 			Handler:    _Msg_StartAuction_Handler,
 		},
+		{
+			MethodName: "CancelAuction",
+			Handler:    _Msg_CancelAuction_Handler,
+		},
 		{
 			MethodName: "NewBid",
 			Handler:    _Msg_NewBid_Handler,
 		},
+		{
+			MethodName: "RevealBid",
+			Handler:    _Msg_RevealBid_Handler,
+		},
 		{
 			MethodName: "Exec",
 			Handler:    _Msg_Exec_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "fatal_fruit/auction/v1/tx.proto",
@@ -11,7 +11,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	_ "google.golang.org/protobuf/types/known/anypb"
+	anypb "google.golang.org/protobuf/types/known/anypb"
 	_ "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
@@ -1097,6 +1097,7 @@ var (
 	fd_Bid_bidder     protoreflect.FieldDescriptor
 	fd_Bid_bid_price  protoreflect.FieldDescriptor
 	fd_Bid_timestamp  protoreflect.FieldDescriptor
+	fd_Bid_data       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1106,6 +1107,7 @@ func init() {
 	fd_Bid_bidder = md_Bid.Fields().ByName("bidder")
 	fd_Bid_bid_price = md_Bid.Fields().ByName("bid_price")
 	fd_Bid_timestamp = md_Bid.Fields().ByName("timestamp")
+	fd_Bid_data = md_Bid.Fields().ByName("data")
 }
 
 var _ protoreflect.Message = (*fastReflection_Bid)(nil)
@@ -1197,6 +1199,12 @@ func (x *fastReflection_Bid) Range(f func(protoreflect.FieldDescriptor, protoref
 			return
 		}
 	}
+	if x.Data != nil {
+		value := protoreflect.ValueOfMessage(x.Data.ProtoReflect())
+		if !f(fd_Bid_data, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1220,6 +1228,8 @@ func (x *fastReflection_Bid) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.BidPrice != nil
 	case "fatal_fruit.auction.v1.Bid.timestamp":
 		return x.Timestamp != nil
+	case "fatal_fruit.auction.v1.Bid.data":
+		return x.Data != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.Bid"))
@@ -1244,6 +1254,8 @@ func (x *fastReflection_Bid) Clear(fd protoreflect.FieldDescriptor) {
 		x.BidPrice = nil
 	case "fatal_fruit.auction.v1.Bid.timestamp":
 		x.Timestamp = nil
+	case "fatal_fruit.auction.v1.Bid.data":
+		x.Data = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.Bid"))
@@ -1272,6 +1284,9 @@ func (x *fastReflection_Bid) Get(descriptor protoreflect.FieldDescriptor) protor
 	case "fatal_fruit.auction.v1.Bid.timestamp":
 		value := x.Timestamp
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "fatal_fruit.auction.v1.Bid.data":
+		value := x.Data
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.Bid"))
@@ -1300,6 +1315,8 @@ func (x *fastReflection_Bid) Set(fd protoreflect.FieldDescriptor, value protoref
 		x.BidPrice = value.Message().Interface().(*v1beta1.Coin)
 	case "fatal_fruit.auction.v1.Bid.timestamp":
 		x.Timestamp = value.Message().Interface().(*timestamppb.Timestamp)
+	case "fatal_fruit.auction.v1.Bid.data":
+		x.Data = value.Message().Interface().(*anypb.Any)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.Bid"))
@@ -1330,6 +1347,11 @@ func (x *fastReflection_Bid) Mutable(fd protoreflect.FieldDescriptor) protorefle
 			x.Timestamp = new(timestamppb.Timestamp)
 		}
 		return protoreflect.ValueOfMessage(x.Timestamp.ProtoReflect())
+	case "fatal_fruit.auction.v1.Bid.data":
+		if x.Data == nil {
+			x.Data = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.Data.ProtoReflect())
 	case "fatal_fruit.auction.v1.Bid.auction_id":
 		panic(fmt.Errorf("field auction_id of message fatal_fruit.auction.v1.Bid is not mutable"))
 	case "fatal_fruit.auction.v1.Bid.bidder":
@@ -1357,6 +1379,9 @@ func (x *fastReflection_Bid) NewField(fd protoreflect.FieldDescriptor) protorefl
 	case "fatal_fruit.auction.v1.Bid.timestamp":
 		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "fatal_fruit.auction.v1.Bid.data":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: fatal_fruit.auction.v1.Bid"))
@@ -1441,6 +1466,10 @@ func (x *fastReflection_Bid) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Timestamp)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Data != nil {
+			l = options.Size(x.Data)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1470,6 +1499,20 @@ func (x *fastReflection_Bid) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Data != nil {
+			encoded, err := options.Marshal(x.Data)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
 		if x.Timestamp != nil {
 			encoded, err := options.Marshal(x.Timestamp)
 			if err != nil {
@@ -1682,6 +1725,42 @@ func (x *fastReflection_Bid) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Data == nil {
+					x.Data = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Data); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1730,6 +1809,81 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// AuctionStatus is the lifecycle stage of an auction. Auctions move between statuses only
+// along the transitions allowed by the module's status transition table.
+type AuctionStatus int32
+
+const (
+	// AUCTION_STATUS_UNSPECIFIED is an invalid status.
+	AuctionStatus_AUCTION_STATUS_UNSPECIFIED AuctionStatus = 0
+	// AUCTION_STATUS_CREATED is an auction that has not been started. It is not held in any
+	// queue.
+	AuctionStatus_AUCTION_STATUS_CREATED AuctionStatus = 1
+	// AUCTION_STATUS_ACTIVE is an auction accepting bids or, for sealed-bid auctions, reveals.
+	// It is held in the active or reveal queue.
+	AuctionStatus_AUCTION_STATUS_ACTIVE AuctionStatus = 2
+	// AUCTION_STATUS_EXPIRED is an auction whose bidding has ended. It is held in the expired
+	// queue until it is found to be executable or not.
+	AuctionStatus_AUCTION_STATUS_EXPIRED AuctionStatus = 3
+	// AUCTION_STATUS_PENDING is an auction with a winning bid waiting to be executed. It is
+	// held in the pending queue.
+	AuctionStatus_AUCTION_STATUS_PENDING AuctionStatus = 4
+	// AUCTION_STATUS_CANCELLED is an auction that was cancelled or ended without being
+	// executed. It is held in the cancelled queue.
+	AuctionStatus_AUCTION_STATUS_CANCELLED AuctionStatus = 5
+	// AUCTION_STATUS_SETTLED is an auction that was executed. It is not held in any queue.
+	AuctionStatus_AUCTION_STATUS_SETTLED AuctionStatus = 6
+)
+
+// Enum value maps for AuctionStatus.
+var (
+	AuctionStatus_name = map[int32]string{
+		0: "AUCTION_STATUS_UNSPECIFIED",
+		1: "AUCTION_STATUS_CREATED",
+		2: "AUCTION_STATUS_ACTIVE",
+		3: "AUCTION_STATUS_EXPIRED",
+		4: "AUCTION_STATUS_PENDING",
+		5: "AUCTION_STATUS_CANCELLED",
+		6: "AUCTION_STATUS_SETTLED",
+	}
+	AuctionStatus_value = map[string]int32{
+		"AUCTION_STATUS_UNSPECIFIED": 0,
+		"AUCTION_STATUS_CREATED":     1,
+		"AUCTION_STATUS_ACTIVE":      2,
+		"AUCTION_STATUS_EXPIRED":     3,
+		"AUCTION_STATUS_PENDING":     4,
+		"AUCTION_STATUS_CANCELLED":   5,
+		"AUCTION_STATUS_SETTLED":     6,
+	}
+)
+
+func (x AuctionStatus) Enum() *AuctionStatus {
+	p := new(AuctionStatus)
+	*p = x
+	return p
+}
+
+func (x AuctionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuctionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_fatal_fruit_auction_v1_types_proto_enumTypes[0].Descriptor()
+}
+
+func (AuctionStatus) Type() protoreflect.EnumType {
+	return &file_fatal_fruit_auction_v1_types_proto_enumTypes[0]
+}
+
+func (x AuctionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuctionStatus.Descriptor instead.
+func (AuctionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_fatal_fruit_auction_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
 type OwnerAuctions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1809,6 +1963,8 @@ type Bid struct {
 	Bidder    string                 `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
 	BidPrice  *v1beta1.Coin          `protobuf:"bytes,3,opt,name=bid_price,json=bidPrice,proto3" json:"bid_price,omitempty"`
 	Timestamp *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// data is the auction type specific bid metadata submitted with the bid.
+	Data *anypb.Any `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
 }
 
 func (x *Bid) Reset() {
@@ -1859,6 +2015,13 @@ func (x *Bid) GetTimestamp() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Bid) GetData() *anypb.Any {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 var File_fatal_fruit_auction_v1_types_proto protoreflect.FileDescriptor
 
 var file_fatal_fruit_auction_v1_types_proto_rawDesc = []byte{
@@ -1882,7 +2045,7 @@ var file_fatal_fruit_auction_v1_types_proto_rawDesc = []byte{
 	0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22,
 	0x1e, 0x0a, 0x0a, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x10, 0x0a,
 	0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22,
-	0x9f, 0x02, 0x0a, 0x03, 0x42, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x63, 0x74, 0x69,
+	0xf1, 0x02, 0x0a, 0x03, 0x42, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x63, 0x74, 0x69,
 	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x75, 0x63,
 	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
@@ -1900,21 +2063,41 @@ var file_fatal_fruit_auction_v1_types_proto_rawDesc = []byte{
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
 	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f,
 	0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x42, 0xe3, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f,
-	0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x43,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x61, 0x74, 0x61, 0x6c,
-	0x2d, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2f, 0x61,
-	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x46, 0x41, 0x58, 0xaa, 0x02, 0x15, 0x46, 0x61, 0x74, 0x61,
-	0x6c, 0x46, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x15, 0x46, 0x61, 0x74, 0x61, 0x6c, 0x46, 0x72, 0x75, 0x69, 0x74, 0x5c, 0x41,
-	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x46, 0x61, 0x74, 0x61,
-	0x6c, 0x46, 0x72, 0x75, 0x69, 0x74, 0x5c, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17,
-	0x46, 0x61, 0x74, 0x61, 0x6c, 0x46, 0x72, 0x75, 0x69, 0x74, 0x3a, 0x3a, 0x41, 0x75, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x12, 0x50, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x26, 0xca, 0xb4, 0x2d, 0x22, 0x66, 0x61, 0x74, 0x61, 0x6c,
+	0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x69, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x2a, 0xde, 0x01, 0x0a, 0x0d, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16,
+	0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45,
+	0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x55, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49,
+	0x4e, 0x47, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44,
+	0x10, 0x05, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x1a, 0x04,
+	0x88, 0xa3, 0x1e, 0x00, 0x42, 0xe3, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x61, 0x74,
+	0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x61,
+	0x74, 0x61, 0x6c, 0x2d, 0x66, 0x72, 0x75, 0x69, 0x74, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x75, 0x69,
+	0x74, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x46, 0x41, 0x58, 0xaa, 0x02, 0x15, 0x46,
+	0x61, 0x74, 0x61, 0x6c, 0x46, 0x72, 0x75, 0x69, 0x74, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x15, 0x46, 0x61, 0x74, 0x61, 0x6c, 0x46, 0x72, 0x75, 0x69,
+	0x74, 0x5c, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x46,
+	0x61, 0x74, 0x61, 0x6c, 0x46, 0x72, 0x75, 0x69, 0x74, 0x5c, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x17, 0x46, 0x61, 0x74, 0x61, 0x6c, 0x46, 0x72, 0x75, 0x69, 0x74, 0x3a, 0x3a, 0x41,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -1929,22 +2112,26 @@ func file_fatal_fruit_auction_v1_types_proto_rawDescGZIP() []byte {
 	return file_fatal_fruit_auction_v1_types_proto_rawDescData
 }
 
+var file_fatal_fruit_auction_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_fatal_fruit_auction_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_fatal_fruit_auction_v1_types_proto_goTypes = []interface{}{
-	(*OwnerAuctions)(nil),         // 0: fatal_fruit.auction.v1.OwnerAuctions
-	(*AuctionIds)(nil),            // 1: fatal_fruit.auction.v1.AuctionIds
-	(*Bid)(nil),                   // 2: fatal_fruit.auction.v1.Bid
-	(*v1beta1.Coin)(nil),          // 3: cosmos.base.v1beta1.Coin
-	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(AuctionStatus)(0),            // 0: fatal_fruit.auction.v1.AuctionStatus
+	(*OwnerAuctions)(nil),         // 1: fatal_fruit.auction.v1.OwnerAuctions
+	(*AuctionIds)(nil),            // 2: fatal_fruit.auction.v1.AuctionIds
+	(*Bid)(nil),                   // 3: fatal_fruit.auction.v1.Bid
+	(*v1beta1.Coin)(nil),          // 4: cosmos.base.v1beta1.Coin
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(*anypb.Any)(nil),             // 6: google.protobuf.Any
 }
 var file_fatal_fruit_auction_v1_types_proto_depIdxs = []int32{
-	3, // 0: fatal_fruit.auction.v1.Bid.bid_price:type_name -> cosmos.base.v1beta1.Coin
-	4, // 1: fatal_fruit.auction.v1.Bid.timestamp:type_name -> google.protobuf.Timestamp
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	4, // 0: fatal_fruit.auction.v1.Bid.bid_price:type_name -> cosmos.base.v1beta1.Coin
+	5, // 1: fatal_fruit.auction.v1.Bid.timestamp:type_name -> google.protobuf.Timestamp
+	6, // 2: fatal_fruit.auction.v1.Bid.data:type_name -> google.protobuf.Any
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_fatal_fruit_auction_v1_types_proto_init() }
@@ -1995,13 +2182,14 @@ func file_fatal_fruit_auction_v1_types_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_fatal_fruit_auction_v1_types_proto_rawDesc,
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_fatal_fruit_auction_v1_types_proto_goTypes,
 		DependencyIndexes: file_fatal_fruit_auction_v1_types_proto_depIdxs,
+		EnumInfos:         file_fatal_fruit_auction_v1_types_proto_enumTypes,
 		MessageInfos:      file_fatal_fruit_auction_v1_types_proto_msgTypes,
 	}.Build()
 	File_fatal_fruit_auction_v1_types_proto = out.File
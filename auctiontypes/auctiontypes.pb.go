@@ -4,9 +4,11 @@
 package auctiontypes
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	_ "github.com/cosmos/cosmos-sdk/codec/types"
+	types2 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -33,6 +35,73 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DecrementSchedule defines how the price of a Dutch auction falls over its duration.
+type DecrementSchedule int32
+
+const (
+	// DECREMENT_SCHEDULE_UNSPECIFIED is an invalid schedule.
+	DECREMENT_SCHEDULE_UNSPECIFIED DecrementSchedule = 0
+	// DECREMENT_SCHEDULE_LINEAR lowers the price continuously from the start price to the
+	// floor price over the auction duration.
+	DECREMENT_SCHEDULE_LINEAR DecrementSchedule = 1
+	// DECREMENT_SCHEDULE_STEPWISE lowers the price by a fixed decrement every step interval
+	// until it reaches the floor price.
+	DECREMENT_SCHEDULE_STEPWISE DecrementSchedule = 2
+)
+
+var DecrementSchedule_name = map[int32]string{
+	0: "DECREMENT_SCHEDULE_UNSPECIFIED",
+	1: "DECREMENT_SCHEDULE_LINEAR",
+	2: "DECREMENT_SCHEDULE_STEPWISE",
+}
+
+var DecrementSchedule_value = map[string]int32{
+	"DECREMENT_SCHEDULE_UNSPECIFIED": 0,
+	"DECREMENT_SCHEDULE_LINEAR":      1,
+	"DECREMENT_SCHEDULE_STEPWISE":    2,
+}
+
+func (x DecrementSchedule) String() string {
+	return proto.EnumName(DecrementSchedule_name, int32(x))
+}
+
+func (DecrementSchedule) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9ee1315214a58372, []int{0}
+}
+
+// UnrevealedBidPolicy defines what happens to the collateral of sealed bids that are not
+// revealed before the reveal phase ends.
+type UnrevealedBidPolicy int32
+
+const (
+	// UNREVEALED_BID_POLICY_UNSPECIFIED is an invalid policy.
+	UNREVEALED_BID_POLICY_UNSPECIFIED UnrevealedBidPolicy = 0
+	// UNREVEALED_BID_POLICY_REFUND returns the collateral to the bidder.
+	UNREVEALED_BID_POLICY_REFUND UnrevealedBidPolicy = 1
+	// UNREVEALED_BID_POLICY_FORFEIT pays the collateral to the auction owner.
+	UNREVEALED_BID_POLICY_FORFEIT UnrevealedBidPolicy = 2
+)
+
+var UnrevealedBidPolicy_name = map[int32]string{
+	0: "UNREVEALED_BID_POLICY_UNSPECIFIED",
+	1: "UNREVEALED_BID_POLICY_REFUND",
+	2: "UNREVEALED_BID_POLICY_FORFEIT",
+}
+
+var UnrevealedBidPolicy_value = map[string]int32{
+	"UNREVEALED_BID_POLICY_UNSPECIFIED": 0,
+	"UNREVEALED_BID_POLICY_REFUND":      1,
+	"UNREVEALED_BID_POLICY_FORFEIT":     2,
+}
+
+func (x UnrevealedBidPolicy) String() string {
+	return proto.EnumName(UnrevealedBidPolicy_name, int32(x))
+}
+
+func (UnrevealedBidPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9ee1315214a58372, []int{1}
+}
+
 type ReserveAuctionMetadata struct {
 	// duration specifies the time duration of the auction.
 	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration"`
@@ -40,10 +109,52 @@ type ReserveAuctionMetadata struct {
 	StartTime time.Time `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
 	EndTime   time.Time `protobuf:"bytes,8,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
 	// reserve_price is the minimum price for the auction.
-	ReservePrice types.Coin      `protobuf:"bytes,3,opt,name=reserve_price,json=reservePrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reserve_price"`
-	Bids         []*types1.Bid   `protobuf:"bytes,9,rep,name=bids,proto3" json:"bids,omitempty"`
-	LastPrice    types.Coin      `protobuf:"bytes,10,opt,name=last_price,json=lastPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"last_price"`
-	Strategy     *SettleStrategy `protobuf:"bytes,11,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	ReservePrice types.Coin `protobuf:"bytes,3,opt,name=reserve_price,json=reservePrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reserve_price"`
+	// Deprecated: bids are stored in the module's bids collection. This field is
+	// only read when migrating auctions created before bids were moved out.
+	Bids      []*types1.Bid `protobuf:"bytes,9,rep,name=bids,proto3" json:"bids,omitempty"` // Deprecated: Do not use.
+	LastPrice types.Coin    `protobuf:"bytes,10,opt,name=last_price,json=lastPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"last_price"`
+	// Deprecated: replaced by settlement_strategy. This field is only read when migrating
+	// auctions created before strategies were stored as Anys.
+	Strategy *SettleStrategy `protobuf:"bytes,11,opt,name=strategy,proto3" json:"strategy,omitempty"` // Deprecated: Do not use.
+	// refund_on_outbid returns a bidder's escrowed funds as soon as a higher bid is
+	// accepted. When false, all losing bids are refunded when the auction is settled.
+	RefundOnOutbid bool `protobuf:"varint,12,opt,name=refund_on_outbid,json=refundOnOutbid,proto3" json:"refund_on_outbid,omitempty"`
+	// highest_bid is the currently leading bid.
+	HighestBid *types1.Bid `protobuf:"bytes,13,opt,name=highest_bid,json=highestBid,proto3" json:"highest_bid,omitempty"`
+	// num_bids is the number of accepted bids and the sequence of the next bid.
+	NumBids uint64 `protobuf:"varint,14,opt,name=num_bids,json=numBids,proto3" json:"num_bids,omitempty"`
+	// strategy_type selects how the auction is settled when no settlement_strategy is
+	// given: SETTLE (the default) charges the winner their own bid, SECOND_PRICE charges the
+	// highest competing bid.
+	StrategyType string `protobuf:"bytes,15,opt,name=strategy_type,json=strategyType,proto3" json:"strategy_type,omitempty"`
+	// settlement_strategy is the strategy used to settle the auction, selected by its type
+	// URL. When creating an auction it may be left empty to use a SettleStrategy.
+	SettlementStrategy *types2.Any `protobuf:"bytes,16,opt,name=settlement_strategy,json=settlementStrategy,proto3" json:"settlement_strategy,omitempty"`
+	// extension_window is the period before end_time in which an accepted bid extends the
+	// auction. A zero window disables extensions.
+	ExtensionWindow time.Duration `protobuf:"bytes,17,opt,name=extension_window,json=extensionWindow,proto3,stdduration" json:"extension_window"`
+	// extension_duration is the amount of time end_time is pushed forward by a bid placed
+	// within the extension window.
+	ExtensionDuration time.Duration `protobuf:"bytes,18,opt,name=extension_duration,json=extensionDuration,proto3,stdduration" json:"extension_duration"`
+	// max_extensions caps the number of times the auction can be extended. Zero allows
+	// unlimited extensions.
+	MaxExtensions uint32 `protobuf:"varint,19,opt,name=max_extensions,json=maxExtensions,proto3" json:"max_extensions,omitempty"`
+	// num_extensions is the number of times the auction has been extended.
+	NumExtensions uint32 `protobuf:"varint,20,opt,name=num_extensions,json=numExtensions,proto3" json:"num_extensions,omitempty"`
+	// buy_now_price is an optional price at which a bid wins the auction immediately. The
+	// auction closes on the first bid at or above it and skips the expired queue. It is
+	// disabled when left empty.
+	BuyNowPrice types.Coin `protobuf:"bytes,21,opt,name=buy_now_price,json=buyNowPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"buy_now_price"`
+	// min_increment is the minimum amount a bid must exceed the last price by. It is
+	// disabled when left empty.
+	MinIncrement types.Coin `protobuf:"bytes,22,opt,name=min_increment,json=minIncrement,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_increment"`
+	// min_increment_bps is the minimum amount a bid must exceed the last price by, in basis
+	// points of the last price. It cannot be combined with min_increment.
+	MinIncrementBps uint32 `protobuf:"varint,23,opt,name=min_increment_bps,json=minIncrementBps,proto3" json:"min_increment_bps,omitempty"`
+	// auto_execute executes the auction in the EndBlocker once it is pending, instead of
+	// waiting for MsgExecAuction.
+	AutoExecute bool `protobuf:"varint,24,opt,name=auto_execute,json=autoExecute,proto3" json:"auto_execute,omitempty"`
 }
 
 func (m *ReserveAuctionMetadata) Reset()         { *m = ReserveAuctionMetadata{} }
@@ -107,6 +218,7 @@ func (m *ReserveAuctionMetadata) GetReservePrice() types.Coin {
 	return types.Coin{}
 }
 
+// Deprecated: Do not use.
 func (m *ReserveAuctionMetadata) GetBids() []*types1.Bid {
 	if m != nil {
 		return m.Bids
@@ -121,6 +233,7 @@ func (m *ReserveAuctionMetadata) GetLastPrice() types.Coin {
 	return types.Coin{}
 }
 
+// Deprecated: Do not use.
 func (m *ReserveAuctionMetadata) GetStrategy() *SettleStrategy {
 	if m != nil {
 		return m.Strategy
@@ -128,12 +241,109 @@ func (m *ReserveAuctionMetadata) GetStrategy() *SettleStrategy {
 	return nil
 }
 
+func (m *ReserveAuctionMetadata) GetRefundOnOutbid() bool {
+	if m != nil {
+		return m.RefundOnOutbid
+	}
+	return false
+}
+
+func (m *ReserveAuctionMetadata) GetHighestBid() *types1.Bid {
+	if m != nil {
+		return m.HighestBid
+	}
+	return nil
+}
+
+func (m *ReserveAuctionMetadata) GetNumBids() uint64 {
+	if m != nil {
+		return m.NumBids
+	}
+	return 0
+}
+
+func (m *ReserveAuctionMetadata) GetStrategyType() string {
+	if m != nil {
+		return m.StrategyType
+	}
+	return ""
+}
+
+func (m *ReserveAuctionMetadata) GetSettlementStrategy() *types2.Any {
+	if m != nil {
+		return m.SettlementStrategy
+	}
+	return nil
+}
+
+func (m *ReserveAuctionMetadata) GetExtensionWindow() time.Duration {
+	if m != nil {
+		return m.ExtensionWindow
+	}
+	return 0
+}
+
+func (m *ReserveAuctionMetadata) GetExtensionDuration() time.Duration {
+	if m != nil {
+		return m.ExtensionDuration
+	}
+	return 0
+}
+
+func (m *ReserveAuctionMetadata) GetMaxExtensions() uint32 {
+	if m != nil {
+		return m.MaxExtensions
+	}
+	return 0
+}
+
+func (m *ReserveAuctionMetadata) GetNumExtensions() uint32 {
+	if m != nil {
+		return m.NumExtensions
+	}
+	return 0
+}
+
+func (m *ReserveAuctionMetadata) GetBuyNowPrice() types.Coin {
+	if m != nil {
+		return m.BuyNowPrice
+	}
+	return types.Coin{}
+}
+
+func (m *ReserveAuctionMetadata) GetMinIncrement() types.Coin {
+	if m != nil {
+		return m.MinIncrement
+	}
+	return types.Coin{}
+}
+
+func (m *ReserveAuctionMetadata) GetMinIncrementBps() uint32 {
+	if m != nil {
+		return m.MinIncrementBps
+	}
+	return 0
+}
+
+func (m *ReserveAuctionMetadata) GetAutoExecute() bool {
+	if m != nil {
+		return m.AutoExecute
+	}
+	return false
+}
+
 type ReserveAuction struct {
-	Id          uint64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status      string                  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Owner       string                  `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
-	AuctionType string                  `protobuf:"bytes,4,opt,name=auction_type,json=auctionType,proto3" json:"auction_type,omitempty"`
-	Metadata    *ReserveAuctionMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Deprecated: replaced by status. This field is only read when migrating auctions
+	// created before statuses were validated.
+	LegacyStatus string                  `protobuf:"bytes,2,opt,name=legacy_status,json=legacyStatus,proto3" json:"legacy_status,omitempty"` // Deprecated: Do not use.
+	Owner        string                  `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	AuctionType  string                  `protobuf:"bytes,4,opt,name=auction_type,json=auctionType,proto3" json:"auction_type,omitempty"`
+	Metadata     *ReserveAuctionMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// deposit is the amount escrowed by the owner when the auction was created.
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// status is the lifecycle stage of the auction, which matches the queue holding it.
+	Status types1.AuctionStatus `protobuf:"varint,7,opt,name=status,proto3,enum=fatal_fruit.auction.v1.AuctionStatus" json:"status,omitempty"`
 }
 
 func (m *ReserveAuction) Reset()         { *m = ReserveAuction{} }
@@ -176,9 +386,10 @@ func (m *ReserveAuction) GetId() uint64 {
 	return 0
 }
 
-func (m *ReserveAuction) GetStatus() string {
+// Deprecated: Do not use.
+func (m *ReserveAuction) GetLegacyStatus() string {
 	if m != nil {
-		return m.Status
+		return m.LegacyStatus
 	}
 	return ""
 }
@@ -204,6 +415,22 @@ func (m *ReserveAuction) GetMetadata() *ReserveAuctionMetadata {
 	return nil
 }
 
+func (m *ReserveAuction) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *ReserveAuction) GetStatus() types1.AuctionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return types1.AUCTION_STATUS_UNSPECIFIED
+}
+
+// SettleStrategy is the default settlement strategy. The winner pays the settlement
+// price selected by strategy_type to the auction owner.
 type SettleStrategy struct {
 	StrategyType string `protobuf:"bytes,1,opt,name=strategy_type,json=strategyType,proto3" json:"strategy_type,omitempty"`
 	// id of escrow contract for auction
@@ -265,355 +492,6268 @@ func (m *SettleStrategy) GetEscrowContractAddress() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*ReserveAuctionMetadata)(nil), "fatal_fruit.auction.v1.ReserveAuctionMetadata")
-	proto.RegisterType((*ReserveAuction)(nil), "fatal_fruit.auction.v1.ReserveAuction")
-	proto.RegisterType((*SettleStrategy)(nil), "fatal_fruit.auction.v1.SettleStrategy")
+type DutchAuctionMetadata struct {
+	// duration specifies the time duration of the auction.
+	Duration time.Duration `protobuf:"bytes,1,opt,name=duration,proto3,stdduration" json:"duration"`
+	// start_time and end_time are calculated from the contract duration. end_time is
+	// moved to the time of the winning bid when the auction closes early.
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime   time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// start_price is the asking price when the auction starts.
+	StartPrice types.Coin `protobuf:"bytes,4,opt,name=start_price,json=startPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"start_price"`
+	// floor_price is the lowest asking price the auction will fall to.
+	FloorPrice types.Coin        `protobuf:"bytes,5,opt,name=floor_price,json=floorPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"floor_price"`
+	Schedule   DecrementSchedule `protobuf:"varint,6,opt,name=schedule,proto3,enum=fatal_fruit.auction.v1.DecrementSchedule" json:"schedule,omitempty"`
+	// decrement is the amount the price falls every step_interval on a stepwise schedule.
+	Decrement types.Coin `protobuf:"bytes,7,opt,name=decrement,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"decrement"`
+	// step_interval is the time between price decrements on a stepwise schedule. Setting it
+	// to the chain's block time lowers the price once per block.
+	StepInterval time.Duration `protobuf:"bytes,8,opt,name=step_interval,json=stepInterval,proto3,stdduration" json:"step_interval"`
+	// winning_bid is the first bid at or above the current price, which closes the auction.
+	WinningBid *types1.Bid `protobuf:"bytes,9,opt,name=winning_bid,json=winningBid,proto3" json:"winning_bid,omitempty"`
+	// Deprecated: replaced by settlement_strategy. This field is only read when migrating
+	// auctions created before strategies were stored as Anys.
+	Strategy *SettleStrategy `protobuf:"bytes,10,opt,name=strategy,proto3" json:"strategy,omitempty"` // Deprecated: Do not use.
+	// settlement_strategy is the strategy used to settle the auction, selected by its type
+	// URL. When creating an auction it may be left empty to use a SettleStrategy.
+	SettlementStrategy *types2.Any `protobuf:"bytes,11,opt,name=settlement_strategy,json=settlementStrategy,proto3" json:"settlement_strategy,omitempty"`
+	// auto_execute executes the auction in the EndBlocker once it is pending, instead of
+	// waiting for MsgExecAuction.
+	AutoExecute bool `protobuf:"varint,12,opt,name=auto_execute,json=autoExecute,proto3" json:"auto_execute,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("fatal_fruit/auction/v1/auctiontypes.proto", fileDescriptor_9ee1315214a58372)
+func (m *DutchAuctionMetadata) Reset()         { *m = DutchAuctionMetadata{} }
+func (m *DutchAuctionMetadata) String() string { return proto.CompactTextString(m) }
+func (*DutchAuctionMetadata) ProtoMessage()    {}
+func (*DutchAuctionMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ee1315214a58372, []int{3}
+}
+func (m *DutchAuctionMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DutchAuctionMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DutchAuctionMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DutchAuctionMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DutchAuctionMetadata.Merge(m, src)
+}
+func (m *DutchAuctionMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *DutchAuctionMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_DutchAuctionMetadata.DiscardUnknown(m)
 }
 
-var fileDescriptor_9ee1315214a58372 = []byte{
-	// 697 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x41, 0x4f, 0x13, 0x41,
-	0x14, 0xee, 0x96, 0x02, 0xed, 0xb4, 0x10, 0xdd, 0x20, 0x6e, 0x6b, 0xb2, 0xc5, 0x9a, 0x90, 0x8a,
-	0x76, 0x37, 0xc5, 0x1b, 0x27, 0x29, 0x68, 0xc4, 0xc4, 0x84, 0x2c, 0x9c, 0xbc, 0x6c, 0xa6, 0x3b,
-	0xc3, 0x3a, 0xb1, 0xdd, 0x69, 0x66, 0xa6, 0x25, 0xbd, 0x79, 0xf2, 0xe0, 0x89, 0xa3, 0xfa, 0x0b,
-	0x8c, 0x27, 0x0e, 0x78, 0xf1, 0x17, 0x10, 0x4e, 0xc4, 0x93, 0x27, 0x31, 0x70, 0xe8, 0xdf, 0x30,
-	0x3b, 0x33, 0xdb, 0xb4, 0x95, 0x6a, 0xbc, 0x78, 0x81, 0x9d, 0x79, 0xdf, 0xfb, 0xde, 0xf7, 0xbe,
-	0xf7, 0x3a, 0xe0, 0xfe, 0x01, 0x14, 0xb0, 0xe5, 0x1f, 0xb0, 0x2e, 0x11, 0x2e, 0xec, 0x06, 0x82,
-	0xd0, 0xc8, 0xed, 0xd5, 0x93, 0x4f, 0xd1, 0xef, 0x60, 0xee, 0x74, 0x18, 0x15, 0xd4, 0x5c, 0x1e,
-	0x81, 0x3a, 0x3a, 0xee, 0xf4, 0xea, 0xa5, 0x62, 0x40, 0x79, 0x9b, 0x72, 0x5f, 0xa2, 0x5c, 0x75,
-	0x50, 0x29, 0xa5, 0xa5, 0x90, 0x86, 0x54, 0xdd, 0xc7, 0x5f, 0xfa, 0xd6, 0x56, 0x18, 0xb7, 0x09,
-	0x39, 0x76, 0x7b, 0xf5, 0x26, 0x16, 0xb0, 0xee, 0x06, 0x94, 0x44, 0x3a, 0x7e, 0x13, 0xb6, 0x49,
-	0x44, 0x5d, 0xf9, 0x57, 0x5f, 0x95, 0x43, 0x4a, 0xc3, 0x16, 0x76, 0xe5, 0xa9, 0xd9, 0x3d, 0x70,
-	0x05, 0x69, 0x63, 0x2e, 0x60, 0xbb, 0x93, 0x70, 0x4e, 0x02, 0x50, 0x97, 0x41, 0xa9, 0x50, 0xc5,
-	0x8b, 0x93, 0x71, 0x18, 0xf5, 0x75, 0xa8, 0x32, 0xc5, 0x82, 0x91, 0xde, 0x2b, 0x5f, 0x67, 0xc1,
-	0xb2, 0x87, 0x39, 0x66, 0x3d, 0xbc, 0xa9, 0x10, 0x2f, 0xb0, 0x80, 0x08, 0x0a, 0x68, 0x6e, 0x83,
-	0x6c, 0x52, 0xcb, 0x4a, 0xaf, 0x18, 0xd5, 0xfc, 0x7a, 0xd1, 0x51, 0xc5, 0x9c, 0xa4, 0x98, 0xb3,
-	0xad, 0x01, 0x8d, 0x85, 0xd3, 0x1f, 0xe5, 0xd4, 0xfb, 0x8b, 0xb2, 0xf1, 0x69, 0x70, 0xbc, 0x66,
-	0x78, 0xc3, 0x4c, 0xf3, 0x19, 0x00, 0x5c, 0x40, 0x26, 0xfc, 0xb8, 0x31, 0x6b, 0x5e, 0xf2, 0x94,
-	0x7e, 0xe3, 0xd9, 0x4f, 0xba, 0x56, 0x44, 0x47, 0x43, 0xa2, 0x9c, 0x4c, 0x8e, 0xc3, 0xb1, 0x1e,
-	0x1c, 0x21, 0xc5, 0x93, 0xfd, 0x57, 0x9e, 0x79, 0x1c, 0x21, 0xc9, 0xf2, 0xd6, 0x00, 0x0b, 0x4c,
-	0x35, 0xec, 0x77, 0x18, 0x09, 0xb0, 0x35, 0xa3, 0x7b, 0xd3, 0x03, 0x8e, 0x87, 0xe7, 0xe8, 0xe1,
-	0x39, 0x5b, 0x94, 0x44, 0x8d, 0xa7, 0x31, 0xd5, 0xe7, 0x8b, 0x72, 0x35, 0x24, 0xe2, 0x55, 0xb7,
-	0xe9, 0x04, 0xb4, 0xad, 0xb7, 0x41, 0xff, 0xab, 0x71, 0xf4, 0x5a, 0xbb, 0x1a, 0x27, 0xf0, 0x8f,
-	0x83, 0xe3, 0xb5, 0x42, 0x0b, 0x87, 0x30, 0xe8, 0xfb, 0xf1, 0xf8, 0xb9, 0xd2, 0x50, 0xd0, 0x75,
-	0x77, 0xe3, 0xb2, 0xa6, 0x0b, 0x32, 0x4d, 0x82, 0xb8, 0x95, 0x5b, 0x99, 0xa9, 0xe6, 0xd7, 0xef,
-	0x38, 0xd7, 0x2f, 0xa1, 0xd3, 0x20, 0xc8, 0x93, 0x40, 0xf3, 0x8d, 0x01, 0x40, 0x0b, 0x72, 0xa1,
-	0x65, 0x83, 0xff, 0x25, 0x3b, 0x17, 0x17, 0x55, 0x9a, 0x1b, 0x20, 0xcb, 0x05, 0x83, 0x02, 0x87,
-	0x7d, 0x2b, 0x2f, 0xeb, 0xaf, 0x4e, 0xd3, 0xbd, 0x87, 0x85, 0x68, 0xe1, 0x3d, 0x8d, 0xf6, 0x86,
-	0x79, 0x1b, 0x3b, 0x67, 0x27, 0xb5, 0xd5, 0x29, 0x49, 0x13, 0x2b, 0xf8, 0x6e, 0x70, 0xbc, 0x56,
-	0x1a, 0xd1, 0x3a, 0x11, 0xae, 0x7c, 0x48, 0x83, 0xc5, 0xf1, 0xe5, 0x35, 0x17, 0x41, 0x9a, 0x20,
-	0xcb, 0x58, 0x31, 0xaa, 0x19, 0x2f, 0x4d, 0x90, 0xb9, 0x0c, 0xe6, 0xb8, 0x80, 0xa2, 0xcb, 0xe5,
-	0x0a, 0xe7, 0x3c, 0x7d, 0x32, 0x1d, 0x30, 0x4b, 0x0f, 0x23, 0xcc, 0xe4, 0xf4, 0x73, 0x0d, 0xeb,
-	0xdb, 0x49, 0x6d, 0x49, 0x3b, 0xb9, 0x89, 0x10, 0xc3, 0x9c, 0xef, 0x09, 0x46, 0xa2, 0xd0, 0x53,
-	0x30, 0xf3, 0x2e, 0x28, 0x68, 0x9d, 0x7e, 0xec, 0x98, 0x95, 0x91, 0x6c, 0x79, 0x7d, 0xb7, 0xdf,
-	0xef, 0x60, 0xf3, 0x39, 0xc8, 0xb6, 0xb5, 0x32, 0x6b, 0x56, 0x9a, 0xe3, 0x4c, 0x33, 0xe7, 0xfa,
-	0x5f, 0x9c, 0x37, 0xcc, 0xdf, 0x78, 0x7c, 0x76, 0x52, 0xb3, 0xff, 0x6c, 0x52, 0x6c, 0x4e, 0x71,
-	0xc4, 0x9c, 0x71, 0xce, 0xca, 0x17, 0x03, 0x2c, 0x8e, 0xcf, 0xc0, 0xbc, 0x07, 0x16, 0x92, 0x29,
-	0xa8, 0x26, 0x0c, 0xd9, 0x44, 0x21, 0xb9, 0x94, 0x5d, 0x3c, 0x04, 0x26, 0xe6, 0x01, 0xa3, 0x87,
-	0x7e, 0x40, 0x23, 0xc1, 0x60, 0x20, 0x7c, 0x82, 0xa4, 0x79, 0x19, 0xef, 0x86, 0x8a, 0x6c, 0xe9,
-	0xc0, 0x0e, 0x32, 0x77, 0xc1, 0xed, 0x49, 0x34, 0x54, 0xf6, 0xfd, 0xd5, 0xd8, 0x5b, 0xe3, 0x64,
-	0x3a, 0xd8, 0x78, 0x72, 0x7a, 0x69, 0x1b, 0xe7, 0x97, 0xb6, 0xf1, 0xf3, 0xd2, 0x36, 0x8e, 0xae,
-	0xec, 0xd4, 0xf9, 0x95, 0x9d, 0xfa, 0x7e, 0x65, 0xa7, 0x5e, 0x3e, 0x18, 0xd9, 0x63, 0x69, 0x4d,
-	0x6d, 0xfc, 0x65, 0x1b, 0x7d, 0xd9, 0x9b, 0x73, 0xf2, 0x49, 0x78, 0xf4, 0x2b, 0x00, 0x00, 0xff,
-	0xff, 0x63, 0x2d, 0xdc, 0x0b, 0x07, 0x06, 0x00, 0x00,
+var xxx_messageInfo_DutchAuctionMetadata proto.InternalMessageInfo
+
+func (m *DutchAuctionMetadata) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
 }
 
-func (m *ReserveAuctionMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DutchAuctionMetadata) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *ReserveAuctionMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DutchAuctionMetadata) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
 }
 
-func (m *ReserveAuctionMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Strategy != nil {
-		{
-			size, err := m.Strategy.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
+func (m *DutchAuctionMetadata) GetStartPrice() types.Coin {
+	if m != nil {
+		return m.StartPrice
 	}
-	{
-		size, err := m.LastPrice.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+	return types.Coin{}
+}
+
+func (m *DutchAuctionMetadata) GetFloorPrice() types.Coin {
+	if m != nil {
+		return m.FloorPrice
 	}
-	i--
-	dAtA[i] = 0x52
-	if len(m.Bids) > 0 {
-		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
+	return types.Coin{}
+}
+
+func (m *DutchAuctionMetadata) GetSchedule() DecrementSchedule {
+	if m != nil {
+		return m.Schedule
 	}
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
-	if err3 != nil {
-		return 0, err3
+	return DECREMENT_SCHEDULE_UNSPECIFIED
+}
+
+func (m *DutchAuctionMetadata) GetDecrement() types.Coin {
+	if m != nil {
+		return m.Decrement
 	}
-	i -= n3
-	i = encodeVarintAuctiontypes(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x42
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err4 != nil {
-		return 0, err4
+	return types.Coin{}
+}
+
+func (m *DutchAuctionMetadata) GetStepInterval() time.Duration {
+	if m != nil {
+		return m.StepInterval
 	}
-	i -= n4
-	i = encodeVarintAuctiontypes(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x3a
-	{
-		size, err := m.ReservePrice.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *DutchAuctionMetadata) GetWinningBid() *types1.Bid {
+	if m != nil {
+		return m.WinningBid
 	}
-	i--
-	dAtA[i] = 0x1a
-	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
-	if err6 != nil {
-		return 0, err6
+	return nil
+}
+
+// Deprecated: Do not use.
+func (m *DutchAuctionMetadata) GetStrategy() *SettleStrategy {
+	if m != nil {
+		return m.Strategy
 	}
-	i -= n6
-	i = encodeVarintAuctiontypes(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0x12
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ReserveAuction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DutchAuctionMetadata) GetSettlementStrategy() *types2.Any {
+	if m != nil {
+		return m.SettlementStrategy
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ReserveAuction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DutchAuctionMetadata) GetAutoExecute() bool {
+	if m != nil {
+		return m.AutoExecute
+	}
+	return false
 }
 
-func (m *ReserveAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+type DutchAuction struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Deprecated: replaced by status. This field is only read when migrating auctions
+	// created before statuses were validated.
+	LegacyStatus string                `protobuf:"bytes,2,opt,name=legacy_status,json=legacyStatus,proto3" json:"legacy_status,omitempty"` // Deprecated: Do not use.
+	Owner        string                `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	AuctionType  string                `protobuf:"bytes,4,opt,name=auction_type,json=auctionType,proto3" json:"auction_type,omitempty"`
+	Metadata     *DutchAuctionMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// deposit is the amount escrowed by the owner when the auction was created.
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// status is the lifecycle stage of the auction, which matches the queue holding it.
+	Status types1.AuctionStatus `protobuf:"varint,7,opt,name=status,proto3,enum=fatal_fruit.auction.v1.AuctionStatus" json:"status,omitempty"`
+}
+
+func (m *DutchAuction) Reset()         { *m = DutchAuction{} }
+func (m *DutchAuction) String() string { return proto.CompactTextString(m) }
+func (*DutchAuction) ProtoMessage()    {}
+func (*DutchAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ee1315214a58372, []int{4}
+}
+func (m *DutchAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DutchAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DutchAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	if len(m.AuctionType) > 0 {
-		i -= len(m.AuctionType)
-		copy(dAtA[i:], m.AuctionType)
-		i = encodeVarintAuctiontypes(dAtA, i, uint64(len(m.AuctionType)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *DutchAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DutchAuction.Merge(m, src)
+}
+func (m *DutchAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *DutchAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_DutchAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DutchAuction proto.InternalMessageInfo
+
+func (m *DutchAuction) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintAuctiontypes(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+// Deprecated: Do not use.
+func (m *DutchAuction) GetLegacyStatus() string {
+	if m != nil {
+		return m.LegacyStatus
 	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintAuctiontypes(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *DutchAuction) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	if m.Id != 0 {
-		i = encodeVarintAuctiontypes(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *DutchAuction) GetAuctionType() string {
+	if m != nil {
+		return m.AuctionType
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *SettleStrategy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DutchAuction) GetMetadata() *DutchAuctionMetadata {
+	if m != nil {
+		return m.Metadata
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SettleStrategy) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DutchAuction) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
 }
 
-func (m *SettleStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EscrowContractAddress) > 0 {
-		i -= len(m.EscrowContractAddress)
-		copy(dAtA[i:], m.EscrowContractAddress)
-		i = encodeVarintAuctiontypes(dAtA, i, uint64(len(m.EscrowContractAddress)))
-		i--
-		dAtA[i] = 0x1a
+func (m *DutchAuction) GetStatus() types1.AuctionStatus {
+	if m != nil {
+		return m.Status
 	}
-	if m.EscrowContractId != 0 {
-		i = encodeVarintAuctiontypes(dAtA, i, uint64(m.EscrowContractId))
-		i--
-		dAtA[i] = 0x10
+	return types1.AUCTION_STATUS_UNSPECIFIED
+}
+
+type SealedBidAuctionMetadata struct {
+	// duration specifies the time duration of the bidding phase.
+	Duration time.Duration `protobuf:"bytes,1,opt,name=duration,proto3,stdduration" json:"duration"`
+	// reveal_duration specifies the time duration of the reveal phase that follows bidding.
+	RevealDuration time.Duration `protobuf:"bytes,2,opt,name=reveal_duration,json=revealDuration,proto3,stdduration" json:"reveal_duration"`
+	// start_time and end_time bound the bidding phase, and reveal_end_time ends the reveal
+	// phase. They are calculated from the durations when the auction starts.
+	StartTime     time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime       time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	RevealEndTime time.Time `protobuf:"bytes,5,opt,name=reveal_end_time,json=revealEndTime,proto3,stdtime" json:"reveal_end_time"`
+	// reserve_price is the minimum price a revealed bid must meet.
+	ReservePrice        types.Coin          `protobuf:"bytes,6,opt,name=reserve_price,json=reservePrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reserve_price"`
+	UnrevealedBidPolicy UnrevealedBidPolicy `protobuf:"varint,7,opt,name=unrevealed_bid_policy,json=unrevealedBidPolicy,proto3,enum=fatal_fruit.auction.v1.UnrevealedBidPolicy" json:"unrevealed_bid_policy,omitempty"`
+	// highest_bid is the highest revealed bid.
+	HighestBid *types1.Bid `protobuf:"bytes,8,opt,name=highest_bid,json=highestBid,proto3" json:"highest_bid,omitempty"`
+	// num_bids is the number of sealed bids and the sequence of the next bid.
+	NumBids uint64 `protobuf:"varint,9,opt,name=num_bids,json=numBids,proto3" json:"num_bids,omitempty"`
+	// num_revealed is the number of sealed bids that have been revealed.
+	NumRevealed uint64 `protobuf:"varint,10,opt,name=num_revealed,json=numRevealed,proto3" json:"num_revealed,omitempty"`
+	// Deprecated: replaced by settlement_strategy. This field is only read when migrating
+	// auctions created before strategies were stored as Anys.
+	Strategy *SettleStrategy `protobuf:"bytes,11,opt,name=strategy,proto3" json:"strategy,omitempty"` // Deprecated: Do not use.
+	// strategy_type selects how the auction is settled when no settlement_strategy is
+	// given: SETTLE (the default) charges the winner their own bid, SECOND_PRICE charges the
+	// highest competing bid.
+	StrategyType string `protobuf:"bytes,12,opt,name=strategy_type,json=strategyType,proto3" json:"strategy_type,omitempty"`
+	// settlement_strategy is the strategy used to settle the auction, selected by its type
+	// URL. When creating an auction it may be left empty to use a SettleStrategy.
+	SettlementStrategy *types2.Any `protobuf:"bytes,13,opt,name=settlement_strategy,json=settlementStrategy,proto3" json:"settlement_strategy,omitempty"`
+	// auto_execute executes the auction in the EndBlocker once it is pending, instead of
+	// waiting for MsgExecAuction.
+	AutoExecute bool `protobuf:"varint,14,opt,name=auto_execute,json=autoExecute,proto3" json:"auto_execute,omitempty"`
+}
+
+func (m *SealedBidAuctionMetadata) Reset()         { *m = SealedBidAuctionMetadata{} }
+func (m *SealedBidAuctionMetadata) String() string { return proto.CompactTextString(m) }
+func (*SealedBidAuctionMetadata) ProtoMessage()    {}
+func (*SealedBidAuctionMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ee1315214a58372, []int{5}
+}
+func (m *SealedBidAuctionMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SealedBidAuctionMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SealedBidAuctionMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.StrategyType) > 0 {
-		i -= len(m.StrategyType)
-		copy(dAtA[i:], m.StrategyType)
-		i = encodeVarintAuctiontypes(dAtA, i, uint64(len(m.StrategyType)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *SealedBidAuctionMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SealedBidAuctionMetadata.Merge(m, src)
+}
+func (m *SealedBidAuctionMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *SealedBidAuctionMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_SealedBidAuctionMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SealedBidAuctionMetadata proto.InternalMessageInfo
+
+func (m *SealedBidAuctionMetadata) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintAuctiontypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAuctiontypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SealedBidAuctionMetadata) GetRevealDuration() time.Duration {
+	if m != nil {
+		return m.RevealDuration
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *ReserveAuctionMetadata) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *SealedBidAuctionMetadata) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	var l int
-	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
-	n += 1 + l + sovAuctiontypes(uint64(l))
-	l = m.ReservePrice.Size()
-	n += 1 + l + sovAuctiontypes(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovAuctiontypes(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovAuctiontypes(uint64(l))
-	if len(m.Bids) > 0 {
-		for _, e := range m.Bids {
-			l = e.Size()
-			n += 1 + l + sovAuctiontypes(uint64(l))
-		}
+	return time.Time{}
+}
+
+func (m *SealedBidAuctionMetadata) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	l = m.LastPrice.Size()
-	n += 1 + l + sovAuctiontypes(uint64(l))
-	if m.Strategy != nil {
-		l = m.Strategy.Size()
-		n += 1 + l + sovAuctiontypes(uint64(l))
+	return time.Time{}
+}
+
+func (m *SealedBidAuctionMetadata) GetRevealEndTime() time.Time {
+	if m != nil {
+		return m.RevealEndTime
 	}
-	return n
+	return time.Time{}
 }
 
-func (m *ReserveAuction) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SealedBidAuctionMetadata) GetReservePrice() types.Coin {
+	if m != nil {
+		return m.ReservePrice
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovAuctiontypes(uint64(m.Id))
+	return types.Coin{}
+}
+
+func (m *SealedBidAuctionMetadata) GetUnrevealedBidPolicy() UnrevealedBidPolicy {
+	if m != nil {
+		return m.UnrevealedBidPolicy
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovAuctiontypes(uint64(l))
+	return UNREVEALED_BID_POLICY_UNSPECIFIED
+}
+
+func (m *SealedBidAuctionMetadata) GetHighestBid() *types1.Bid {
+	if m != nil {
+		return m.HighestBid
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovAuctiontypes(uint64(l))
+	return nil
+}
+
+func (m *SealedBidAuctionMetadata) GetNumBids() uint64 {
+	if m != nil {
+		return m.NumBids
 	}
-	l = len(m.AuctionType)
-	if l > 0 {
-		n += 1 + l + sovAuctiontypes(uint64(l))
+	return 0
+}
+
+func (m *SealedBidAuctionMetadata) GetNumRevealed() uint64 {
+	if m != nil {
+		return m.NumRevealed
 	}
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovAuctiontypes(uint64(l))
+	return 0
+}
+
+// Deprecated: Do not use.
+func (m *SealedBidAuctionMetadata) GetStrategy() *SettleStrategy {
+	if m != nil {
+		return m.Strategy
 	}
-	return n
+	return nil
 }
 
-func (m *SettleStrategy) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SealedBidAuctionMetadata) GetStrategyType() string {
+	if m != nil {
+		return m.StrategyType
 	}
-	var l int
-	_ = l
-	l = len(m.StrategyType)
-	if l > 0 {
-		n += 1 + l + sovAuctiontypes(uint64(l))
+	return ""
+}
+
+func (m *SealedBidAuctionMetadata) GetSettlementStrategy() *types2.Any {
+	if m != nil {
+		return m.SettlementStrategy
 	}
-	if m.EscrowContractId != 0 {
-		n += 1 + sovAuctiontypes(uint64(m.EscrowContractId))
+	return nil
+}
+
+func (m *SealedBidAuctionMetadata) GetAutoExecute() bool {
+	if m != nil {
+		return m.AutoExecute
 	}
-	l = len(m.EscrowContractAddress)
-	if l > 0 {
-		n += 1 + l + sovAuctiontypes(uint64(l))
+	return false
+}
+
+type SealedBidAuction struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Deprecated: replaced by status. This field is only read when migrating auctions
+	// created before statuses were validated.
+	LegacyStatus string                    `protobuf:"bytes,2,opt,name=legacy_status,json=legacyStatus,proto3" json:"legacy_status,omitempty"` // Deprecated: Do not use.
+	Owner        string                    `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	AuctionType  string                    `protobuf:"bytes,4,opt,name=auction_type,json=auctionType,proto3" json:"auction_type,omitempty"`
+	Metadata     *SealedBidAuctionMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// deposit is the amount escrowed by the owner when the auction was created.
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// status is the lifecycle stage of the auction, which matches the queue holding it.
+	Status types1.AuctionStatus `protobuf:"varint,7,opt,name=status,proto3,enum=fatal_fruit.auction.v1.AuctionStatus" json:"status,omitempty"`
+}
+
+func (m *SealedBidAuction) Reset()         { *m = SealedBidAuction{} }
+func (m *SealedBidAuction) String() string { return proto.CompactTextString(m) }
+func (*SealedBidAuction) ProtoMessage()    {}
+func (*SealedBidAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ee1315214a58372, []int{6}
+}
+func (m *SealedBidAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SealedBidAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SealedBidAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SealedBidAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SealedBidAuction.Merge(m, src)
+}
+func (m *SealedBidAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *SealedBidAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_SealedBidAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SealedBidAuction proto.InternalMessageInfo
+
+func (m *SealedBidAuction) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (m *SealedBidAuction) GetLegacyStatus() string {
+	if m != nil {
+		return m.LegacyStatus
+	}
+	return ""
+}
+
+func (m *SealedBidAuction) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *SealedBidAuction) GetAuctionType() string {
+	if m != nil {
+		return m.AuctionType
+	}
+	return ""
+}
+
+func (m *SealedBidAuction) GetMetadata() *SealedBidAuctionMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *SealedBidAuction) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *SealedBidAuction) GetStatus() types1.AuctionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return types1.AUCTION_STATUS_UNSPECIFIED
+}
+
+// SealedBid is the bid metadata carried by MsgNewBid on a sealed-bid auction. The bid
+// amount of the message is left empty so the price is not disclosed.
+type SealedBid struct {
+	// commitment is the sha256 hash of the auction id, bidder, bid amount and a secret salt.
+	Commitment []byte `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// collateral is escrowed with the sealed bid and must cover the revealed amount.
+	Collateral types.Coin `protobuf:"bytes,2,opt,name=collateral,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"collateral"`
+	// revealed is set once the bid has been revealed.
+	Revealed bool `protobuf:"varint,3,opt,name=revealed,proto3" json:"revealed,omitempty"`
+}
+
+func (m *SealedBid) Reset()         { *m = SealedBid{} }
+func (m *SealedBid) String() string { return proto.CompactTextString(m) }
+func (*SealedBid) ProtoMessage()    {}
+func (*SealedBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ee1315214a58372, []int{7}
+}
+func (m *SealedBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SealedBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SealedBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SealedBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SealedBid.Merge(m, src)
+}
+func (m *SealedBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *SealedBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_SealedBid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SealedBid proto.InternalMessageInfo
+
+func (m *SealedBid) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+func (m *SealedBid) GetCollateral() types.Coin {
+	if m != nil {
+		return m.Collateral
+	}
+	return types.Coin{}
+}
+
+func (m *SealedBid) GetRevealed() bool {
+	if m != nil {
+		return m.Revealed
+	}
+	return false
+}
+
+type BatchAuctionMetadata struct {
+	// duration specifies the time duration of the auction.
+	Duration time.Duration `protobuf:"bytes,1,opt,name=duration,proto3,stdduration" json:"duration"`
+	// start_time and end_time are calculated from the contract duration
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime   time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// reserve_price is the minimum price per unit a bid must offer.
+	ReservePrice types.Coin `protobuf:"bytes,4,opt,name=reserve_price,json=reservePrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reserve_price"`
+	// highest_bid is the bid with the highest price per unit.
+	HighestBid *types1.Bid `protobuf:"bytes,5,opt,name=highest_bid,json=highestBid,proto3" json:"highest_bid,omitempty"`
+	// num_bids is the number of accepted bids and the sequence of the next bid.
+	NumBids uint64 `protobuf:"varint,6,opt,name=num_bids,json=numBids,proto3" json:"num_bids,omitempty"`
+	// settlement_strategy is the strategy used to settle the auction, selected by its type
+	// URL. When creating an auction it may be left empty to use a UniformPriceStrategy.
+	SettlementStrategy *types2.Any `protobuf:"bytes,7,opt,name=settlement_strategy,json=settlementStrategy,proto3" json:"settlement_strategy,omitempty"`
+	// auto_execute executes the auction in the EndBlocker once it is pending, instead of
+	// waiting for MsgExecAuction.
+	AutoExecute bool `protobuf:"varint,8,opt,name=auto_execute,json=autoExecute,proto3" json:"auto_execute,omitempty"`
+}
+
+func (m *BatchAuctionMetadata) Reset()         { *m = BatchAuctionMetadata{} }
+func (m *BatchAuctionMetadata) String() string { return proto.CompactTextString(m) }
+func (*BatchAuctionMetadata) ProtoMessage()    {}
+func (*BatchAuctionMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ee1315214a58372, []int{8}
+}
+func (m *BatchAuctionMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchAuctionMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchAuctionMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchAuctionMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchAuctionMetadata.Merge(m, src)
+}
+func (m *BatchAuctionMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchAuctionMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchAuctionMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchAuctionMetadata proto.InternalMessageInfo
+
+func (m *BatchAuctionMetadata) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *BatchAuctionMetadata) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *BatchAuctionMetadata) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *BatchAuctionMetadata) GetReservePrice() types.Coin {
+	if m != nil {
+		return m.ReservePrice
+	}
+	return types.Coin{}
+}
+
+func (m *BatchAuctionMetadata) GetHighestBid() *types1.Bid {
+	if m != nil {
+		return m.HighestBid
+	}
+	return nil
+}
+
+func (m *BatchAuctionMetadata) GetNumBids() uint64 {
+	if m != nil {
+		return m.NumBids
+	}
+	return 0
+}
+
+func (m *BatchAuctionMetadata) GetSettlementStrategy() *types2.Any {
+	if m != nil {
+		return m.SettlementStrategy
+	}
+	return nil
+}
+
+func (m *BatchAuctionMetadata) GetAutoExecute() bool {
+	if m != nil {
+		return m.AutoExecute
+	}
+	return false
+}
+
+// BatchAuction sells the units of its deposit to many bidders at a single clearing price.
+type BatchAuction struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Deprecated: replaced by status. This field is only read when migrating auctions
+	// created before statuses were validated.
+	LegacyStatus string                `protobuf:"bytes,2,opt,name=legacy_status,json=legacyStatus,proto3" json:"legacy_status,omitempty"` // Deprecated: Do not use.
+	Owner        string                `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	AuctionType  string                `protobuf:"bytes,4,opt,name=auction_type,json=auctionType,proto3" json:"auction_type,omitempty"`
+	Metadata     *BatchAuctionMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// deposit holds the units being auctioned. It must be a single coin.
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// status is the lifecycle stage of the auction, which matches the queue holding it.
+	Status types1.AuctionStatus `protobuf:"varint,7,opt,name=status,proto3,enum=fatal_fruit.auction.v1.AuctionStatus" json:"status,omitempty"`
+}
+
+func (m *BatchAuction) Reset()         { *m = BatchAuction{} }
+func (m *BatchAuction) String() string { return proto.CompactTextString(m) }
+func (*BatchAuction) ProtoMessage()    {}
+func (*BatchAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ee1315214a58372, []int{9}
+}
+func (m *BatchAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchAuction.Merge(m, src)
+}
+func (m *BatchAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchAuction proto.InternalMessageInfo
+
+func (m *BatchAuction) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (m *BatchAuction) GetLegacyStatus() string {
+	if m != nil {
+		return m.LegacyStatus
+	}
+	return ""
+}
+
+func (m *BatchAuction) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *BatchAuction) GetAuctionType() string {
+	if m != nil {
+		return m.AuctionType
+	}
+	return ""
+}
+
+func (m *BatchAuction) GetMetadata() *BatchAuctionMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *BatchAuction) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *BatchAuction) GetStatus() types1.AuctionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return types1.AUCTION_STATUS_UNSPECIFIED
+}
+
+// BatchBid is the bid metadata carried by MsgNewBid on a batch auction. The bid amount of
+// the message is the maximum price the bidder pays per unit.
+type BatchBid struct {
+	// quantity is the number of units the bidder wants to buy.
+	Quantity cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=quantity,proto3,customtype=cosmossdk.io/math.Int" json:"quantity"`
+}
+
+func (m *BatchBid) Reset()         { *m = BatchBid{} }
+func (m *BatchBid) String() string { return proto.CompactTextString(m) }
+func (*BatchBid) ProtoMessage()    {}
+func (*BatchBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ee1315214a58372, []int{10}
+}
+func (m *BatchBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchBid.Merge(m, src)
+}
+func (m *BatchBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchBid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchBid proto.InternalMessageInfo
+
+// UniformPriceStrategy settles a batch auction. Every winning bid is filled at the same
+// clearing price and receives its share of the deposit units.
+type UniformPriceStrategy struct {
+	// id of escrow contract for auction
+	EscrowContractId      uint64 `protobuf:"varint,1,opt,name=escrow_contract_id,json=escrowContractId,proto3" json:"escrow_contract_id,omitempty"`
+	EscrowContractAddress string `protobuf:"bytes,2,opt,name=escrow_contract_address,json=escrowContractAddress,proto3" json:"escrow_contract_address,omitempty"`
+}
+
+func (m *UniformPriceStrategy) Reset()         { *m = UniformPriceStrategy{} }
+func (m *UniformPriceStrategy) String() string { return proto.CompactTextString(m) }
+func (*UniformPriceStrategy) ProtoMessage()    {}
+func (*UniformPriceStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ee1315214a58372, []int{11}
+}
+func (m *UniformPriceStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UniformPriceStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UniformPriceStrategy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UniformPriceStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UniformPriceStrategy.Merge(m, src)
+}
+func (m *UniformPriceStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *UniformPriceStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_UniformPriceStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UniformPriceStrategy proto.InternalMessageInfo
+
+func (m *UniformPriceStrategy) GetEscrowContractId() uint64 {
+	if m != nil {
+		return m.EscrowContractId
+	}
+	return 0
+}
+
+func (m *UniformPriceStrategy) GetEscrowContractAddress() string {
+	if m != nil {
+		return m.EscrowContractAddress
+	}
+	return ""
+}
+
+type ReverseAuctionMetadata struct {
+	// duration specifies the time duration of the auction.
+	Duration time.Duration `protobuf:"bytes,1,opt,name=duration,proto3,stdduration" json:"duration"`
+	// start_time and end_time are calculated from the contract duration
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime   time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// ceiling_price bounds the bids. Every bid must be strictly below it.
+	CeilingPrice types.Coin `protobuf:"bytes,4,opt,name=ceiling_price,json=ceilingPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"ceiling_price"`
+	// lowest_bid is the currently leading bid.
+	LowestBid *types1.Bid `protobuf:"bytes,5,opt,name=lowest_bid,json=lowestBid,proto3" json:"lowest_bid,omitempty"`
+	// num_bids is the number of accepted bids and the sequence of the next bid.
+	NumBids uint64 `protobuf:"varint,6,opt,name=num_bids,json=numBids,proto3" json:"num_bids,omitempty"`
+	// settlement_strategy is the strategy used to settle the auction, selected by its type
+	// URL. When creating an auction it may be left empty to use a ProcurementStrategy.
+	SettlementStrategy *types2.Any `protobuf:"bytes,7,opt,name=settlement_strategy,json=settlementStrategy,proto3" json:"settlement_strategy,omitempty"`
+	// auto_execute executes the auction in the EndBlocker once it is pending, instead of
+	// waiting for MsgExecAuction.
+	AutoExecute bool `protobuf:"varint,8,opt,name=auto_execute,json=autoExecute,proto3" json:"auto_execute,omitempty"`
+}
+
+func (m *ReverseAuctionMetadata) Reset()         { *m = ReverseAuctionMetadata{} }
+func (m *ReverseAuctionMetadata) String() string { return proto.CompactTextString(m) }
+func (*ReverseAuctionMetadata) ProtoMessage()    {}
+func (*ReverseAuctionMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ee1315214a58372, []int{12}
+}
+func (m *ReverseAuctionMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReverseAuctionMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReverseAuctionMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReverseAuctionMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReverseAuctionMetadata.Merge(m, src)
+}
+func (m *ReverseAuctionMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReverseAuctionMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReverseAuctionMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReverseAuctionMetadata proto.InternalMessageInfo
+
+func (m *ReverseAuctionMetadata) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *ReverseAuctionMetadata) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *ReverseAuctionMetadata) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *ReverseAuctionMetadata) GetCeilingPrice() types.Coin {
+	if m != nil {
+		return m.CeilingPrice
+	}
+	return types.Coin{}
+}
+
+func (m *ReverseAuctionMetadata) GetLowestBid() *types1.Bid {
+	if m != nil {
+		return m.LowestBid
+	}
+	return nil
+}
+
+func (m *ReverseAuctionMetadata) GetNumBids() uint64 {
+	if m != nil {
+		return m.NumBids
+	}
+	return 0
+}
+
+func (m *ReverseAuctionMetadata) GetSettlementStrategy() *types2.Any {
+	if m != nil {
+		return m.SettlementStrategy
+	}
+	return nil
+}
+
+func (m *ReverseAuctionMetadata) GetAutoExecute() bool {
+	if m != nil {
+		return m.AutoExecute
+	}
+	return false
+}
+
+// ReverseAuction procures a service for the budget held in its deposit. Providers bid
+// downward and the lowest bid wins.
+type ReverseAuction struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Deprecated: replaced by status. This field is only read when migrating auctions
+	// created before statuses were validated.
+	LegacyStatus string                  `protobuf:"bytes,2,opt,name=legacy_status,json=legacyStatus,proto3" json:"legacy_status,omitempty"` // Deprecated: Do not use.
+	Owner        string                  `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	AuctionType  string                  `protobuf:"bytes,4,opt,name=auction_type,json=auctionType,proto3" json:"auction_type,omitempty"`
+	Metadata     *ReverseAuctionMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// deposit is the budget escrowed by the owner. It must be a single coin in the ceiling
+	// price denom that covers the ceiling price.
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// status is the lifecycle stage of the auction, which matches the queue holding it.
+	Status types1.AuctionStatus `protobuf:"varint,7,opt,name=status,proto3,enum=fatal_fruit.auction.v1.AuctionStatus" json:"status,omitempty"`
+}
+
+func (m *ReverseAuction) Reset()         { *m = ReverseAuction{} }
+func (m *ReverseAuction) String() string { return proto.CompactTextString(m) }
+func (*ReverseAuction) ProtoMessage()    {}
+func (*ReverseAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ee1315214a58372, []int{13}
+}
+func (m *ReverseAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReverseAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReverseAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReverseAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReverseAuction.Merge(m, src)
+}
+func (m *ReverseAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReverseAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReverseAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReverseAuction proto.InternalMessageInfo
+
+func (m *ReverseAuction) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (m *ReverseAuction) GetLegacyStatus() string {
+	if m != nil {
+		return m.LegacyStatus
+	}
+	return ""
+}
+
+func (m *ReverseAuction) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ReverseAuction) GetAuctionType() string {
+	if m != nil {
+		return m.AuctionType
+	}
+	return ""
+}
+
+func (m *ReverseAuction) GetMetadata() *ReverseAuctionMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ReverseAuction) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *ReverseAuction) GetStatus() types1.AuctionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return types1.AUCTION_STATUS_UNSPECIFIED
+}
+
+// ProcurementStrategy settles a reverse auction. The winning provider is paid their bid
+// from the auction's budget and the remainder is returned to the owner.
+type ProcurementStrategy struct {
+	// id of escrow contract for auction. The budget is held by the module account, so no
+	// escrow contract is created and both escrow fields are left unset.
+	EscrowContractId      uint64 `protobuf:"varint,1,opt,name=escrow_contract_id,json=escrowContractId,proto3" json:"escrow_contract_id,omitempty"`
+	EscrowContractAddress string `protobuf:"bytes,2,opt,name=escrow_contract_address,json=escrowContractAddress,proto3" json:"escrow_contract_address,omitempty"`
+}
+
+func (m *ProcurementStrategy) Reset()         { *m = ProcurementStrategy{} }
+func (m *ProcurementStrategy) String() string { return proto.CompactTextString(m) }
+func (*ProcurementStrategy) ProtoMessage()    {}
+func (*ProcurementStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ee1315214a58372, []int{14}
+}
+func (m *ProcurementStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProcurementStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProcurementStrategy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProcurementStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcurementStrategy.Merge(m, src)
+}
+func (m *ProcurementStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProcurementStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcurementStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProcurementStrategy proto.InternalMessageInfo
+
+func (m *ProcurementStrategy) GetEscrowContractId() uint64 {
+	if m != nil {
+		return m.EscrowContractId
+	}
+	return 0
+}
+
+func (m *ProcurementStrategy) GetEscrowContractAddress() string {
+	if m != nil {
+		return m.EscrowContractAddress
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("fatal_fruit.auction.v1.DecrementSchedule", DecrementSchedule_name, DecrementSchedule_value)
+	proto.RegisterEnum("fatal_fruit.auction.v1.UnrevealedBidPolicy", UnrevealedBidPolicy_name, UnrevealedBidPolicy_value)
+	proto.RegisterType((*ReserveAuctionMetadata)(nil), "fatal_fruit.auction.v1.ReserveAuctionMetadata")
+	proto.RegisterType((*ReserveAuction)(nil), "fatal_fruit.auction.v1.ReserveAuction")
+	proto.RegisterType((*SettleStrategy)(nil), "fatal_fruit.auction.v1.SettleStrategy")
+	proto.RegisterType((*DutchAuctionMetadata)(nil), "fatal_fruit.auction.v1.DutchAuctionMetadata")
+	proto.RegisterType((*DutchAuction)(nil), "fatal_fruit.auction.v1.DutchAuction")
+	proto.RegisterType((*SealedBidAuctionMetadata)(nil), "fatal_fruit.auction.v1.SealedBidAuctionMetadata")
+	proto.RegisterType((*SealedBidAuction)(nil), "fatal_fruit.auction.v1.SealedBidAuction")
+	proto.RegisterType((*SealedBid)(nil), "fatal_fruit.auction.v1.SealedBid")
+	proto.RegisterType((*BatchAuctionMetadata)(nil), "fatal_fruit.auction.v1.BatchAuctionMetadata")
+	proto.RegisterType((*BatchAuction)(nil), "fatal_fruit.auction.v1.BatchAuction")
+	proto.RegisterType((*BatchBid)(nil), "fatal_fruit.auction.v1.BatchBid")
+	proto.RegisterType((*UniformPriceStrategy)(nil), "fatal_fruit.auction.v1.UniformPriceStrategy")
+	proto.RegisterType((*ReverseAuctionMetadata)(nil), "fatal_fruit.auction.v1.ReverseAuctionMetadata")
+	proto.RegisterType((*ReverseAuction)(nil), "fatal_fruit.auction.v1.ReverseAuction")
+	proto.RegisterType((*ProcurementStrategy)(nil), "fatal_fruit.auction.v1.ProcurementStrategy")
+}
+
+func init() {
+	proto.RegisterFile("fatal_fruit/auction/v1/auctiontypes.proto", fileDescriptor_9ee1315214a58372)
+}
+
+var fileDescriptor_9ee1315214a58372 = []byte{
+	// 1855 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x4a, 0x94, 0x44, 0x0e, 0xff, 0x98, 0x1a, 0xc9, 0xf6, 0x4a, 0x6e, 0x28, 0x89, 0x86,
+	0x5d, 0x45, 0x89, 0xc8, 0xd8, 0xb9, 0x09, 0x6d, 0x51, 0x51, 0x5c, 0xc1, 0x2c, 0x64, 0x59, 0x5d,
+	0x4a, 0x35, 0xda, 0xcb, 0x62, 0xb9, 0x3b, 0xa2, 0xa6, 0xd9, 0x9d, 0x65, 0x77, 0x67, 0x25, 0x13,
+	0x45, 0x51, 0xa7, 0x87, 0xb6, 0xe8, 0x29, 0xc7, 0xa2, 0xd7, 0x5e, 0x82, 0x02, 0x45, 0x7d, 0xd0,
+	0xa9, 0xfd, 0x02, 0x81, 0xd1, 0x43, 0x90, 0x53, 0x91, 0x43, 0x12, 0xd8, 0x40, 0x7d, 0xe9, 0xa9,
+	0xfd, 0x02, 0xc5, 0xce, 0x0c, 0xd7, 0x4b, 0x72, 0xa9, 0x88, 0x8a, 0xcc, 0xc4, 0xd1, 0xc5, 0x16,
+	0xdf, 0x7b, 0xf3, 0xfe, 0xcd, 0x9b, 0xdf, 0x7b, 0x33, 0x24, 0x78, 0xf3, 0x40, 0xa7, 0xba, 0xa5,
+	0x1d, 0xb8, 0x3e, 0xa6, 0x65, 0xdd, 0x37, 0x28, 0x76, 0x48, 0xf9, 0xe8, 0x4e, 0xe7, 0x4f, 0xda,
+	0x6e, 0x21, 0xaf, 0xd4, 0x72, 0x1d, 0xea, 0xc0, 0x6b, 0x11, 0xd1, 0x92, 0xe0, 0x97, 0x8e, 0xee,
+	0x2c, 0xcc, 0x1b, 0x8e, 0x67, 0x3b, 0x9e, 0xc6, 0xa4, 0xca, 0xfc, 0x03, 0x5f, 0xb2, 0x30, 0xd7,
+	0x74, 0x9a, 0x0e, 0xa7, 0x07, 0x7f, 0x09, 0x6a, 0x81, 0xcb, 0x94, 0x1b, 0xba, 0x87, 0xca, 0x47,
+	0x77, 0x1a, 0x88, 0xea, 0x77, 0xca, 0x86, 0x83, 0x89, 0xe0, 0xcf, 0xe8, 0x36, 0x26, 0x4e, 0x99,
+	0xfd, 0x2b, 0x48, 0x8b, 0x4d, 0xc7, 0x69, 0x5a, 0xa8, 0xcc, 0x3e, 0x35, 0xfc, 0x83, 0x32, 0xc5,
+	0x36, 0xf2, 0xa8, 0x6e, 0xb7, 0x3a, 0x3a, 0x7b, 0x05, 0x4c, 0xdf, 0xd5, 0x99, 0x87, 0x9c, 0x3f,
+	0xdf, 0xcb, 0xd7, 0x49, 0x5b, 0xb0, 0x8a, 0x03, 0x52, 0x10, 0x89, 0xbd, 0xf8, 0x61, 0x06, 0x5c,
+	0x53, 0x91, 0x87, 0xdc, 0x23, 0xb4, 0xc1, 0x25, 0xee, 0x23, 0xaa, 0x9b, 0x3a, 0xd5, 0x61, 0x15,
+	0x24, 0x3b, 0xb6, 0xe4, 0xf1, 0x25, 0x69, 0x25, 0x7d, 0x77, 0xbe, 0xc4, 0x8d, 0x95, 0x3a, 0xc6,
+	0x4a, 0x55, 0x21, 0x50, 0xc9, 0x7e, 0xf4, 0xd9, 0xe2, 0xd8, 0x1f, 0x3f, 0x5f, 0x94, 0x3e, 0x7c,
+	0xf1, 0x64, 0x55, 0x52, 0xc3, 0x95, 0xf0, 0x1e, 0x00, 0x1e, 0xd5, 0x5d, 0xaa, 0x05, 0x81, 0xc9,
+	0xd3, 0x4c, 0xcf, 0x42, 0x9f, 0x9e, 0xbd, 0x4e, 0xd4, 0x5c, 0xd1, 0x07, 0xa1, 0xa2, 0x14, 0x5b,
+	0x1c, 0xb0, 0x03, 0x7f, 0x10, 0x31, 0xb9, 0x9e, 0xe4, 0xb0, 0x7a, 0xa6, 0x11, 0x31, 0x99, 0x96,
+	0xdf, 0x4a, 0x20, 0xeb, 0xf2, 0x80, 0xb5, 0x96, 0x8b, 0x0d, 0x24, 0x4f, 0x88, 0xd8, 0xc4, 0x06,
+	0x07, 0x9b, 0x57, 0x12, 0x9b, 0x57, 0xda, 0x74, 0x30, 0xa9, 0x6c, 0x05, 0xaa, 0xfe, 0xf2, 0xf9,
+	0xe2, 0x4a, 0x13, 0xd3, 0x43, 0xbf, 0x51, 0x32, 0x1c, 0x5b, 0x54, 0x83, 0xf8, 0x6f, 0xcd, 0x33,
+	0xdf, 0x13, 0x59, 0x0d, 0x16, 0x78, 0x7f, 0x7a, 0xf1, 0x64, 0x35, 0x63, 0xa1, 0xa6, 0x6e, 0xb4,
+	0xb5, 0x60, 0xfb, 0x3d, 0xee, 0x43, 0x46, 0xd8, 0xdd, 0x0d, 0xcc, 0xc2, 0x77, 0x41, 0xa2, 0x81,
+	0x4d, 0x4f, 0x4e, 0x2d, 0x4d, 0xac, 0xa4, 0xef, 0xde, 0x28, 0xc5, 0x17, 0x61, 0xa9, 0x82, 0xcd,
+	0xca, 0xb8, 0x2c, 0xa9, 0x4c, 0x18, 0x3e, 0x96, 0x00, 0xb0, 0x74, 0x8f, 0x0a, 0xd7, 0xc1, 0xa8,
+	0x5c, 0x4f, 0x05, 0x46, 0xb9, 0xdf, 0x5b, 0x20, 0xe9, 0x51, 0x57, 0xa7, 0xa8, 0xd9, 0x96, 0xd3,
+	0xcc, 0xfe, 0xed, 0x41, 0xbe, 0xd7, 0x11, 0xa5, 0x16, 0xaa, 0x0b, 0x69, 0x16, 0x46, 0xb8, 0x16,
+	0xae, 0x80, 0xbc, 0x8b, 0x0e, 0x7c, 0x62, 0x6a, 0x0e, 0xd1, 0x1c, 0x9f, 0x36, 0xb0, 0x29, 0x67,
+	0x96, 0xa4, 0x95, 0xa4, 0x9a, 0xe3, 0xf4, 0x07, 0xe4, 0x01, 0xa3, 0xc2, 0xef, 0x81, 0xf4, 0x21,
+	0x6e, 0x1e, 0x22, 0x8f, 0x6a, 0x81, 0x50, 0x96, 0x19, 0x3d, 0x2d, 0x61, 0x2a, 0x10, 0xf2, 0x15,
+	0x6c, 0xc2, 0x79, 0x90, 0x24, 0xbe, 0xad, 0xb1, 0x5c, 0xe7, 0x96, 0xa4, 0x95, 0x84, 0x3a, 0x4d,
+	0x7c, 0xbb, 0x12, 0x64, 0xf3, 0x26, 0xc8, 0x76, 0xdc, 0xd1, 0x82, 0x1c, 0xc8, 0x57, 0x96, 0xa4,
+	0x95, 0x94, 0x9a, 0xe9, 0x10, 0xf7, 0xda, 0x2d, 0x04, 0x7f, 0x0e, 0x66, 0x3d, 0x16, 0x87, 0x8d,
+	0x08, 0xd5, 0xc2, 0xd0, 0xf3, 0xcc, 0x8b, 0xb9, 0xbe, 0x0a, 0xdc, 0x20, 0xed, 0xca, 0xcd, 0xa7,
+	0x27, 0x6b, 0x8b, 0x83, 0x72, 0x22, 0x14, 0xa8, 0xf0, 0xa5, 0xd6, 0x0e, 0x0d, 0xd6, 0x41, 0x1e,
+	0x3d, 0xa2, 0x88, 0x78, 0xd8, 0x21, 0xda, 0x31, 0x26, 0xa6, 0x73, 0x2c, 0xcf, 0x0c, 0x79, 0xf4,
+	0xae, 0x84, 0x1a, 0x1e, 0x32, 0x05, 0xf0, 0x21, 0x80, 0x2f, 0x95, 0x86, 0x27, 0x1a, 0x0e, 0xa9,
+	0x76, 0x26, 0xd4, 0xd1, 0x91, 0x80, 0xb7, 0x40, 0xce, 0xd6, 0x1f, 0x69, 0x21, 0xc3, 0x93, 0x67,
+	0x97, 0xa4, 0x95, 0xac, 0x9a, 0xb5, 0xf5, 0x47, 0x4a, 0x48, 0x0c, 0xc4, 0x82, 0x0d, 0x88, 0x88,
+	0xcd, 0x71, 0x31, 0xe2, 0xdb, 0x11, 0xb1, 0xc7, 0x12, 0xc8, 0x36, 0xfc, 0xb6, 0x46, 0x9c, 0x63,
+	0x51, 0xdd, 0x57, 0xbf, 0xac, 0xba, 0x37, 0xbe, 0x72, 0x75, 0xab, 0xe9, 0x86, 0xdf, 0xde, 0x71,
+	0x8e, 0x79, 0x69, 0xbf, 0x2f, 0x81, 0xac, 0x8d, 0x89, 0x86, 0x89, 0xe1, 0xb2, 0x8d, 0x91, 0xaf,
+	0x8d, 0xc0, 0x85, 0x8c, 0x8d, 0x49, 0xad, 0x63, 0x11, 0xae, 0x82, 0x99, 0x2e, 0x17, 0xb4, 0x46,
+	0xcb, 0x93, 0xaf, 0xb3, 0x84, 0x5d, 0x89, 0x0a, 0x56, 0x5a, 0x1e, 0x5c, 0x06, 0x19, 0xdd, 0xa7,
+	0x8e, 0x86, 0x1e, 0x21, 0xc3, 0xa7, 0x48, 0x96, 0xd9, 0xf1, 0x49, 0x07, 0x34, 0x85, 0x93, 0xd6,
+	0x6b, 0x4f, 0x4f, 0xd6, 0x6e, 0x0f, 0x28, 0xc5, 0x1e, 0xc0, 0xff, 0xc3, 0x8b, 0x27, 0xab, 0x0b,
+	0x11, 0xa7, 0x7b, 0xd8, 0xc5, 0x7f, 0x4f, 0x80, 0x5c, 0x77, 0xab, 0x80, 0x39, 0x30, 0x8e, 0x4d,
+	0x59, 0x62, 0xa7, 0x6a, 0x1c, 0x9b, 0xf0, 0xbb, 0x20, 0x2b, 0x42, 0xf3, 0xa8, 0x4e, 0x7d, 0x8f,
+	0xf5, 0x8d, 0x14, 0x3b, 0xf8, 0x22, 0xe6, 0x3a, 0xa3, 0xc3, 0x12, 0x98, 0x74, 0x8e, 0x09, 0x72,
+	0x19, 0xf8, 0xa6, 0x2a, 0xf2, 0x27, 0x27, 0x6b, 0x73, 0x22, 0xc7, 0x1b, 0xa6, 0xe9, 0x22, 0xcf,
+	0xab, 0x53, 0x17, 0x93, 0xa6, 0xca, 0xc5, 0x78, 0xa4, 0xcc, 0x26, 0x3f, 0xa8, 0x09, 0x76, 0x50,
+	0xd3, 0x82, 0xc6, 0xce, 0xe9, 0x8f, 0x40, 0xd2, 0x16, 0xae, 0xca, 0x93, 0x6c, 0xdb, 0x4a, 0x83,
+	0x20, 0x22, 0xbe, 0xe1, 0xa9, 0xe1, 0x7a, 0xf8, 0x4b, 0x30, 0x6d, 0xa2, 0x96, 0xe3, 0x61, 0x2a,
+	0x4f, 0x31, 0x78, 0x1e, 0x01, 0xc4, 0x76, 0x2c, 0xc2, 0xef, 0x83, 0x29, 0x91, 0xbd, 0xa0, 0x5b,
+	0xe6, 0xee, 0xde, 0x2a, 0x9d, 0xbe, 0x7f, 0x3c, 0xa5, 0xaa, 0x58, 0xb4, 0xfe, 0xc3, 0xa7, 0x27,
+	0x6b, 0x85, 0xd3, 0x57, 0x04, 0x3b, 0x3d, 0x1f, 0x71, 0xae, 0x3b, 0x1f, 0xc5, 0x4f, 0x25, 0x90,
+	0xeb, 0x86, 0xee, 0x7e, 0xa4, 0x94, 0x62, 0x90, 0xf2, 0x6d, 0x00, 0x91, 0x67, 0xb8, 0xce, 0xb1,
+	0x66, 0x38, 0x84, 0xba, 0xba, 0x41, 0x35, 0x6c, 0xb2, 0x12, 0x48, 0xa8, 0x79, 0xce, 0xd9, 0x14,
+	0x8c, 0x9a, 0x09, 0x77, 0xc1, 0xf5, 0x5e, 0x69, 0x9d, 0x6f, 0xfd, 0x97, 0x16, 0xc5, 0xd5, 0x6e,
+	0x65, 0x82, 0xb9, 0x7e, 0x16, 0xd8, 0x2d, 0xfe, 0x37, 0x09, 0xe6, 0xaa, 0x3e, 0x35, 0x0e, 0x4f,
+	0x1b, 0x77, 0xa4, 0x0b, 0x1a, 0x77, 0xc6, 0x2f, 0x68, 0xdc, 0x99, 0x38, 0xf7, 0xb8, 0xf3, 0x1b,
+	0x09, 0xa4, 0xb9, 0x43, 0x1c, 0x53, 0x13, 0xa3, 0x9a, 0x18, 0x78, 0x1a, 0x38, 0xae, 0x06, 0x4e,
+	0x1c, 0x58, 0x8e, 0xe3, 0x0a, 0x27, 0x26, 0x47, 0xe6, 0x04, 0xb3, 0xca, 0x9d, 0x50, 0x40, 0xd2,
+	0x33, 0x0e, 0x91, 0xe9, 0x5b, 0x48, 0x9e, 0x62, 0x07, 0xeb, 0xcd, 0x41, 0x07, 0xab, 0x8a, 0x04,
+	0xc8, 0xd6, 0xc5, 0x02, 0x35, 0x5c, 0x0a, 0x7f, 0x0d, 0x52, 0x66, 0x87, 0x2d, 0xc6, 0xd9, 0x51,
+	0xcc, 0x5f, 0xa1, 0x4d, 0x78, 0x3f, 0x38, 0x8a, 0xa8, 0xa5, 0x61, 0x42, 0x91, 0x7b, 0xa4, 0x5b,
+	0x62, 0x16, 0x3e, 0x7b, 0xb1, 0x66, 0x82, 0xe5, 0x35, 0xb1, 0x3a, 0x18, 0xae, 0x8e, 0x31, 0x21,
+	0x98, 0x34, 0xd9, 0x70, 0x95, 0x3a, 0xc3, 0x70, 0x25, 0xe4, 0x83, 0xe1, 0x2a, 0x3a, 0x0c, 0x82,
+	0xaf, 0x30, 0x0c, 0x0e, 0x18, 0xb2, 0xd2, 0xaf, 0x62, 0xc8, 0xea, 0xed, 0x9a, 0x99, 0xfe, 0xae,
+	0xb9, 0x33, 0x5c, 0xd7, 0x5c, 0x8c, 0xec, 0x65, 0x1c, 0xb6, 0x14, 0xbf, 0x98, 0x00, 0x99, 0x28,
+	0xe3, 0x1b, 0xd5, 0x38, 0xef, 0xf5, 0x35, 0xce, 0xb7, 0x07, 0x1e, 0x8c, 0x98, 0xe0, 0xbe, 0x25,
+	0x6d, 0xf3, 0x07, 0x67, 0x6b, 0x9b, 0xd7, 0x07, 0x6c, 0x75, 0xf1, 0x9f, 0x49, 0x20, 0xd7, 0x91,
+	0x6e, 0x21, 0xb3, 0x82, 0xcd, 0x57, 0xd3, 0x5b, 0x7e, 0x0c, 0xae, 0xb8, 0xe8, 0x08, 0xe9, 0x96,
+	0x76, 0xee, 0x7b, 0x79, 0x8e, 0x2b, 0xa8, 0xc6, 0xb7, 0xab, 0x89, 0x0b, 0x6a, 0x57, 0x89, 0x73,
+	0xb7, 0xab, 0x97, 0x21, 0x86, 0xca, 0x26, 0x87, 0x55, 0x96, 0xe5, 0x1a, 0x94, 0x81, 0x17, 0xfe,
+	0xa9, 0xaf, 0xe7, 0xc2, 0xaf, 0x81, 0xab, 0x3e, 0xe1, 0xbe, 0x21, 0x33, 0x00, 0x5b, 0xad, 0xe5,
+	0x58, 0xd8, 0x68, 0x8b, 0x7a, 0x7d, 0x6b, 0x50, 0xbd, 0xee, 0x87, 0x8b, 0x2a, 0xd8, 0xdc, 0x65,
+	0x4b, 0xd4, 0x59, 0xbf, 0x9f, 0xd8, 0x7b, 0x4f, 0x4e, 0x9e, 0xff, 0x9e, 0x9c, 0xea, 0xbe, 0x27,
+	0x2f, 0x83, 0x4c, 0xc0, 0xea, 0x58, 0x64, 0x48, 0x9f, 0x50, 0xd3, 0xc4, 0xb7, 0x55, 0x41, 0xba,
+	0xb0, 0x57, 0x81, 0xbe, 0x41, 0x33, 0x73, 0xf6, 0x2b, 0x79, 0x76, 0x14, 0xdd, 0x22, 0xd7, 0xdf,
+	0x2d, 0xd4, 0xe1, 0xba, 0xc5, 0xcd, 0x48, 0x0d, 0x0d, 0x42, 0x8c, 0xe2, 0x7f, 0x26, 0x40, 0xbe,
+	0x97, 0xf9, 0x8d, 0xea, 0x1a, 0xdb, 0x7d, 0x5d, 0xe3, 0x9d, 0xc1, 0x1b, 0x1e, 0x1f, 0xe4, 0xb7,
+	0xa4, 0x73, 0x54, 0xce, 0xd6, 0x39, 0x6e, 0x9c, 0xb2, 0xed, 0xc5, 0xff, 0x49, 0x20, 0x15, 0x12,
+	0x61, 0x01, 0x00, 0xc3, 0xb1, 0x6d, 0x4c, 0xd9, 0x90, 0x19, 0xec, 0x77, 0x46, 0x8d, 0x50, 0xe0,
+	0xfb, 0x52, 0x20, 0x60, 0x59, 0x3a, 0x45, 0xae, 0x6e, 0x85, 0x4d, 0xe0, 0xd5, 0x8f, 0xd3, 0x2f,
+	0x8d, 0xc2, 0x05, 0x90, 0x0c, 0xf1, 0x60, 0x82, 0x9d, 0x89, 0xf0, 0xf3, 0xfa, 0xed, 0xa7, 0x27,
+	0x6b, 0xc5, 0xc1, 0xb0, 0x13, 0x16, 0xf9, 0x5f, 0x27, 0xc1, 0x5c, 0x45, 0xbf, 0x34, 0x77, 0xb1,
+	0xfe, 0x4e, 0x94, 0xf8, 0x7a, 0x3a, 0x51, 0x4f, 0xa3, 0x98, 0x3c, 0x7f, 0xa3, 0x98, 0xea, 0x6e,
+	0x14, 0x03, 0x80, 0x79, 0x7a, 0x14, 0xc0, 0x9c, 0xbc, 0xd0, 0x31, 0x3e, 0xae, 0x2c, 0xd9, 0x18,
+	0x1f, 0x65, 0xbc, 0xae, 0x63, 0x7c, 0x5c, 0x70, 0x97, 0x77, 0x8c, 0x8f, 0x66, 0xa3, 0xf8, 0x58,
+	0x02, 0x49, 0x46, 0x08, 0x2a, 0x7d, 0x1b, 0x24, 0x7f, 0xe1, 0xeb, 0x84, 0x62, 0xda, 0xe6, 0x0f,
+	0x5e, 0x95, 0x77, 0x82, 0x70, 0x3f, 0xfd, 0x6c, 0xf1, 0x2a, 0x5f, 0xef, 0x99, 0xef, 0x95, 0xb0,
+	0x53, 0xb6, 0x75, 0x7a, 0x58, 0xaa, 0x11, 0xfa, 0xc9, 0xc9, 0x1a, 0x10, 0x99, 0xaa, 0x11, 0x2a,
+	0xe0, 0xa8, 0xa3, 0xe1, 0xcc, 0xa8, 0xf8, 0x0f, 0x09, 0xcc, 0xed, 0x13, 0x7c, 0xe0, 0xb8, 0x36,
+	0x3b, 0xae, 0x61, 0xc5, 0xc7, 0xbf, 0xaf, 0x49, 0xc3, 0xbf, 0xaf, 0x8d, 0xbf, 0xc2, 0xf7, 0xb5,
+	0xbf, 0x4d, 0x82, 0x6b, 0xc1, 0x54, 0xe8, 0x7a, 0xe8, 0xf2, 0xa0, 0xba, 0x81, 0xb0, 0x85, 0x49,
+	0x73, 0xe4, 0xa8, 0x2e, 0xec, 0x72, 0x54, 0x5f, 0x07, 0xc0, 0x72, 0x8e, 0x87, 0x00, 0xf5, 0x14,
+	0x17, 0x7f, 0xbd, 0x30, 0x7d, 0x77, 0x38, 0x4c, 0x5f, 0xee, 0x7a, 0xe6, 0x8e, 0x2b, 0x4b, 0xf1,
+	0xbd, 0x46, 0x94, 0xf5, 0xfa, 0x7e, 0xaf, 0x11, 0x17, 0xe0, 0x65, 0xfe, 0x5e, 0x23, 0x9a, 0x8f,
+	0xe2, 0xdf, 0x25, 0x30, 0xbb, 0xeb, 0x3a, 0x86, 0xef, 0x76, 0x57, 0xdd, 0xeb, 0x80, 0xab, 0xab,
+	0xbf, 0x02, 0x33, 0x7d, 0xcf, 0xd2, 0xb0, 0x08, 0x0a, 0x55, 0x65, 0x53, 0x55, 0xee, 0x2b, 0x3b,
+	0x7b, 0x5a, 0x7d, 0xf3, 0x9e, 0x52, 0xdd, 0xdf, 0x56, 0xb4, 0xfd, 0x9d, 0xfa, 0xae, 0xb2, 0x59,
+	0xdb, 0xaa, 0x29, 0xd5, 0xfc, 0x18, 0x7c, 0x03, 0xcc, 0xc7, 0xc8, 0x6c, 0xd7, 0x76, 0x94, 0x0d,
+	0x35, 0x2f, 0xc1, 0x45, 0x70, 0x23, 0x86, 0x5d, 0xdf, 0x53, 0x76, 0x1f, 0xd6, 0xea, 0x4a, 0x7e,
+	0x7c, 0x21, 0xf1, 0xfb, 0x3f, 0x17, 0xc6, 0x56, 0x7f, 0x27, 0x81, 0xd9, 0x98, 0x87, 0x08, 0x78,
+	0x0b, 0x2c, 0xef, 0xef, 0xa8, 0xca, 0x4f, 0x94, 0x8d, 0x6d, 0xa5, 0xaa, 0x55, 0x6a, 0x55, 0x6d,
+	0xf7, 0xc1, 0x76, 0x6d, 0xf3, 0xa7, 0x3d, 0x4e, 0x2c, 0x81, 0xef, 0xc4, 0x8b, 0xa9, 0xca, 0xd6,
+	0xfe, 0x4e, 0x35, 0x2f, 0xc1, 0x65, 0xf0, 0x46, 0xbc, 0xc4, 0xd6, 0x03, 0x75, 0x4b, 0xa9, 0xed,
+	0x75, 0x3c, 0xa9, 0x28, 0x1f, 0x3d, 0x2b, 0x48, 0x1f, 0x3f, 0x2b, 0x48, 0x5f, 0x3c, 0x2b, 0x48,
+	0x1f, 0x3c, 0x2f, 0x8c, 0x7d, 0xfc, 0xbc, 0x30, 0xf6, 0xaf, 0xe7, 0x85, 0xb1, 0x9f, 0xbd, 0x15,
+	0xa9, 0x54, 0x96, 0xce, 0xb5, 0xee, 0x9f, 0xbe, 0x44, 0x7f, 0xfa, 0xd3, 0x98, 0x62, 0x88, 0xf5,
+	0xee, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x33, 0x0c, 0x0a, 0xd1, 0x28, 0x24, 0x00, 0x00,
+}
+
+func (m *ReserveAuctionMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReserveAuctionMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReserveAuctionMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoExecute {
+		i--
+		if m.AutoExecute {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.MinIncrementBps != 0 {
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(m.MinIncrementBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	{
+		size, err := m.MinIncrement.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	{
+		size, err := m.BuyNowPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if m.NumExtensions != 0 {
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(m.NumExtensions))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.MaxExtensions != 0 {
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(m.MaxExtensions))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ExtensionDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExtensionDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintAuctiontypes(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ExtensionWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExtensionWindow):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintAuctiontypes(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.SettlementStrategy != nil {
+		{
+			size, err := m.SettlementStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.StrategyType) > 0 {
+		i -= len(m.StrategyType)
+		copy(dAtA[i:], m.StrategyType)
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(len(m.StrategyType)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.NumBids != 0 {
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(m.NumBids))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.HighestBid != nil {
+		{
+			size, err := m.HighestBid.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.RefundOnOutbid {
+		i--
+		if m.RefundOnOutbid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Strategy != nil {
+		{
+			size, err := m.Strategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	{
+		size, err := m.LastPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintAuctiontypes(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x42
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintAuctiontypes(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.ReservePrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintAuctiontypes(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+
+func (m *ReserveAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReserveAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReserveAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AuctionType) > 0 {
+		i -= len(m.AuctionType)
+		copy(dAtA[i:], m.AuctionType)
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(len(m.AuctionType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LegacyStatus) > 0 {
+		i -= len(m.LegacyStatus)
+		copy(dAtA[i:], m.LegacyStatus)
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(len(m.LegacyStatus)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SettleStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SettleStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SettleStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EscrowContractAddress) > 0 {
+		i -= len(m.EscrowContractAddress)
+		copy(dAtA[i:], m.EscrowContractAddress)
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(len(m.EscrowContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EscrowContractId != 0 {
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(m.EscrowContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StrategyType) > 0 {
+		i -= len(m.StrategyType)
+		copy(dAtA[i:], m.StrategyType)
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(len(m.StrategyType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DutchAuctionMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DutchAuctionMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DutchAuctionMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoExecute {
+		i--
+		if m.AutoExecute {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.SettlementStrategy != nil {
+		{
+			size, err := m.SettlementStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Strategy != nil {
+		{
+			size, err := m.Strategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.WinningBid != nil {
+		{
+			size, err := m.WinningBid.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	n17, err17 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.StepInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StepInterval):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintAuctiontypes(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.Decrement.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Schedule != 0 {
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(m.Schedule))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.FloorPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.StartPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n21, err21 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintAuctiontypes(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x1a
+	n22, err22 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintAuctiontypes(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x12
+	n23, err23 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err23 != nil {
+		return 0, err23
+	}
+	i -= n23
+	i = encodeVarintAuctiontypes(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DutchAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DutchAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DutchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AuctionType) > 0 {
+		i -= len(m.AuctionType)
+		copy(dAtA[i:], m.AuctionType)
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(len(m.AuctionType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LegacyStatus) > 0 {
+		i -= len(m.LegacyStatus)
+		copy(dAtA[i:], m.LegacyStatus)
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(len(m.LegacyStatus)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SealedBidAuctionMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SealedBidAuctionMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SealedBidAuctionMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoExecute {
+		i--
+		if m.AutoExecute {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.SettlementStrategy != nil {
+		{
+			size, err := m.SettlementStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.StrategyType) > 0 {
+		i -= len(m.StrategyType)
+		copy(dAtA[i:], m.StrategyType)
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(len(m.StrategyType)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Strategy != nil {
+		{
+			size, err := m.Strategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.NumRevealed != 0 {
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(m.NumRevealed))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.NumBids != 0 {
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(m.NumBids))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.HighestBid != nil {
+		{
+			size, err := m.HighestBid.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.UnrevealedBidPolicy != 0 {
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(m.UnrevealedBidPolicy))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.ReservePrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n29, err29 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RevealEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RevealEndTime):])
+	if err29 != nil {
+		return 0, err29
+	}
+	i -= n29
+	i = encodeVarintAuctiontypes(dAtA, i, uint64(n29))
+	i--
+	dAtA[i] = 0x2a
+	n30, err30 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err30 != nil {
+		return 0, err30
+	}
+	i -= n30
+	i = encodeVarintAuctiontypes(dAtA, i, uint64(n30))
+	i--
+	dAtA[i] = 0x22
+	n31, err31 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err31 != nil {
+		return 0, err31
+	}
+	i -= n31
+	i = encodeVarintAuctiontypes(dAtA, i, uint64(n31))
+	i--
+	dAtA[i] = 0x1a
+	n32, err32 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RevealDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RevealDuration):])
+	if err32 != nil {
+		return 0, err32
+	}
+	i -= n32
+	i = encodeVarintAuctiontypes(dAtA, i, uint64(n32))
+	i--
+	dAtA[i] = 0x12
+	n33, err33 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err33 != nil {
+		return 0, err33
+	}
+	i -= n33
+	i = encodeVarintAuctiontypes(dAtA, i, uint64(n33))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SealedBidAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SealedBidAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SealedBidAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AuctionType) > 0 {
+		i -= len(m.AuctionType)
+		copy(dAtA[i:], m.AuctionType)
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(len(m.AuctionType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LegacyStatus) > 0 {
+		i -= len(m.LegacyStatus)
+		copy(dAtA[i:], m.LegacyStatus)
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(len(m.LegacyStatus)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SealedBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SealedBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SealedBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revealed {
+		i--
+		if m.Revealed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchAuctionMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchAuctionMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchAuctionMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoExecute {
+		i--
+		if m.AutoExecute {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SettlementStrategy != nil {
+		{
+			size, err := m.SettlementStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.NumBids != 0 {
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(m.NumBids))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.HighestBid != nil {
+		{
+			size, err := m.HighestBid.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.ReservePrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n39, err39 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err39 != nil {
+		return 0, err39
+	}
+	i -= n39
+	i = encodeVarintAuctiontypes(dAtA, i, uint64(n39))
+	i--
+	dAtA[i] = 0x1a
+	n40, err40 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err40 != nil {
+		return 0, err40
+	}
+	i -= n40
+	i = encodeVarintAuctiontypes(dAtA, i, uint64(n40))
+	i--
+	dAtA[i] = 0x12
+	n41, err41 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err41 != nil {
+		return 0, err41
+	}
+	i -= n41
+	i = encodeVarintAuctiontypes(dAtA, i, uint64(n41))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AuctionType) > 0 {
+		i -= len(m.AuctionType)
+		copy(dAtA[i:], m.AuctionType)
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(len(m.AuctionType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LegacyStatus) > 0 {
+		i -= len(m.LegacyStatus)
+		copy(dAtA[i:], m.LegacyStatus)
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(len(m.LegacyStatus)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UniformPriceStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UniformPriceStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UniformPriceStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EscrowContractAddress) > 0 {
+		i -= len(m.EscrowContractAddress)
+		copy(dAtA[i:], m.EscrowContractAddress)
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(len(m.EscrowContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EscrowContractId != 0 {
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(m.EscrowContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReverseAuctionMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReverseAuctionMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReverseAuctionMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoExecute {
+		i--
+		if m.AutoExecute {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SettlementStrategy != nil {
+		{
+			size, err := m.SettlementStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.NumBids != 0 {
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(m.NumBids))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LowestBid != nil {
+		{
+			size, err := m.LowestBid.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.CeilingPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n46, err46 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err46 != nil {
+		return 0, err46
+	}
+	i -= n46
+	i = encodeVarintAuctiontypes(dAtA, i, uint64(n46))
+	i--
+	dAtA[i] = 0x1a
+	n47, err47 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err47 != nil {
+		return 0, err47
+	}
+	i -= n47
+	i = encodeVarintAuctiontypes(dAtA, i, uint64(n47))
+	i--
+	dAtA[i] = 0x12
+	n48, err48 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err48 != nil {
+		return 0, err48
+	}
+	i -= n48
+	i = encodeVarintAuctiontypes(dAtA, i, uint64(n48))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReverseAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReverseAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReverseAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuctiontypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AuctionType) > 0 {
+		i -= len(m.AuctionType)
+		copy(dAtA[i:], m.AuctionType)
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(len(m.AuctionType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LegacyStatus) > 0 {
+		i -= len(m.LegacyStatus)
+		copy(dAtA[i:], m.LegacyStatus)
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(len(m.LegacyStatus)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProcurementStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProcurementStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProcurementStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EscrowContractAddress) > 0 {
+		i -= len(m.EscrowContractAddress)
+		copy(dAtA[i:], m.EscrowContractAddress)
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(len(m.EscrowContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EscrowContractId != 0 {
+		i = encodeVarintAuctiontypes(dAtA, i, uint64(m.EscrowContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuctiontypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuctiontypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ReserveAuctionMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovAuctiontypes(uint64(l))
+	l = m.ReservePrice.Size()
+	n += 1 + l + sovAuctiontypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovAuctiontypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovAuctiontypes(uint64(l))
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovAuctiontypes(uint64(l))
+		}
+	}
+	l = m.LastPrice.Size()
+	n += 1 + l + sovAuctiontypes(uint64(l))
+	if m.Strategy != nil {
+		l = m.Strategy.Size()
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	if m.RefundOnOutbid {
+		n += 2
+	}
+	if m.HighestBid != nil {
+		l = m.HighestBid.Size()
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	if m.NumBids != 0 {
+		n += 1 + sovAuctiontypes(uint64(m.NumBids))
+	}
+	l = len(m.StrategyType)
+	if l > 0 {
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	if m.SettlementStrategy != nil {
+		l = m.SettlementStrategy.Size()
+		n += 2 + l + sovAuctiontypes(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExtensionWindow)
+	n += 2 + l + sovAuctiontypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExtensionDuration)
+	n += 2 + l + sovAuctiontypes(uint64(l))
+	if m.MaxExtensions != 0 {
+		n += 2 + sovAuctiontypes(uint64(m.MaxExtensions))
+	}
+	if m.NumExtensions != 0 {
+		n += 2 + sovAuctiontypes(uint64(m.NumExtensions))
+	}
+	l = m.BuyNowPrice.Size()
+	n += 2 + l + sovAuctiontypes(uint64(l))
+	l = m.MinIncrement.Size()
+	n += 2 + l + sovAuctiontypes(uint64(l))
+	if m.MinIncrementBps != 0 {
+		n += 2 + sovAuctiontypes(uint64(m.MinIncrementBps))
+	}
+	if m.AutoExecute {
+		n += 3
+	}
+	return n
+}
+
+func (m *ReserveAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAuctiontypes(uint64(m.Id))
+	}
+	l = len(m.LegacyStatus)
+	if l > 0 {
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	l = len(m.AuctionType)
+	if l > 0 {
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovAuctiontypes(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovAuctiontypes(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *SettleStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StrategyType)
+	if l > 0 {
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	if m.EscrowContractId != 0 {
+		n += 1 + sovAuctiontypes(uint64(m.EscrowContractId))
+	}
+	l = len(m.EscrowContractAddress)
+	if l > 0 {
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	return n
+}
+
+func (m *DutchAuctionMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovAuctiontypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovAuctiontypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovAuctiontypes(uint64(l))
+	l = m.StartPrice.Size()
+	n += 1 + l + sovAuctiontypes(uint64(l))
+	l = m.FloorPrice.Size()
+	n += 1 + l + sovAuctiontypes(uint64(l))
+	if m.Schedule != 0 {
+		n += 1 + sovAuctiontypes(uint64(m.Schedule))
+	}
+	l = m.Decrement.Size()
+	n += 1 + l + sovAuctiontypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StepInterval)
+	n += 1 + l + sovAuctiontypes(uint64(l))
+	if m.WinningBid != nil {
+		l = m.WinningBid.Size()
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	if m.Strategy != nil {
+		l = m.Strategy.Size()
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	if m.SettlementStrategy != nil {
+		l = m.SettlementStrategy.Size()
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	if m.AutoExecute {
+		n += 2
+	}
+	return n
+}
+
+func (m *DutchAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAuctiontypes(uint64(m.Id))
+	}
+	l = len(m.LegacyStatus)
+	if l > 0 {
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	l = len(m.AuctionType)
+	if l > 0 {
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovAuctiontypes(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovAuctiontypes(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *SealedBidAuctionMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovAuctiontypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RevealDuration)
+	n += 1 + l + sovAuctiontypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovAuctiontypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovAuctiontypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RevealEndTime)
+	n += 1 + l + sovAuctiontypes(uint64(l))
+	l = m.ReservePrice.Size()
+	n += 1 + l + sovAuctiontypes(uint64(l))
+	if m.UnrevealedBidPolicy != 0 {
+		n += 1 + sovAuctiontypes(uint64(m.UnrevealedBidPolicy))
+	}
+	if m.HighestBid != nil {
+		l = m.HighestBid.Size()
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	if m.NumBids != 0 {
+		n += 1 + sovAuctiontypes(uint64(m.NumBids))
+	}
+	if m.NumRevealed != 0 {
+		n += 1 + sovAuctiontypes(uint64(m.NumRevealed))
+	}
+	if m.Strategy != nil {
+		l = m.Strategy.Size()
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	l = len(m.StrategyType)
+	if l > 0 {
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	if m.SettlementStrategy != nil {
+		l = m.SettlementStrategy.Size()
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	if m.AutoExecute {
+		n += 2
+	}
+	return n
+}
+
+func (m *SealedBidAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAuctiontypes(uint64(m.Id))
+	}
+	l = len(m.LegacyStatus)
+	if l > 0 {
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	l = len(m.AuctionType)
+	if l > 0 {
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovAuctiontypes(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovAuctiontypes(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *SealedBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovAuctiontypes(uint64(l))
+	if m.Revealed {
+		n += 2
+	}
+	return n
+}
+
+func (m *BatchAuctionMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovAuctiontypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovAuctiontypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovAuctiontypes(uint64(l))
+	l = m.ReservePrice.Size()
+	n += 1 + l + sovAuctiontypes(uint64(l))
+	if m.HighestBid != nil {
+		l = m.HighestBid.Size()
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	if m.NumBids != 0 {
+		n += 1 + sovAuctiontypes(uint64(m.NumBids))
+	}
+	if m.SettlementStrategy != nil {
+		l = m.SettlementStrategy.Size()
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	if m.AutoExecute {
+		n += 2
+	}
+	return n
+}
+
+func (m *BatchAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAuctiontypes(uint64(m.Id))
+	}
+	l = len(m.LegacyStatus)
+	if l > 0 {
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	l = len(m.AuctionType)
+	if l > 0 {
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovAuctiontypes(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovAuctiontypes(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *BatchBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Quantity.Size()
+	n += 1 + l + sovAuctiontypes(uint64(l))
+	return n
+}
+
+func (m *UniformPriceStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowContractId != 0 {
+		n += 1 + sovAuctiontypes(uint64(m.EscrowContractId))
+	}
+	l = len(m.EscrowContractAddress)
+	if l > 0 {
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ReverseAuctionMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovAuctiontypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovAuctiontypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovAuctiontypes(uint64(l))
+	l = m.CeilingPrice.Size()
+	n += 1 + l + sovAuctiontypes(uint64(l))
+	if m.LowestBid != nil {
+		l = m.LowestBid.Size()
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	if m.NumBids != 0 {
+		n += 1 + sovAuctiontypes(uint64(m.NumBids))
+	}
+	if m.SettlementStrategy != nil {
+		l = m.SettlementStrategy.Size()
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	if m.AutoExecute {
+		n += 2
+	}
+	return n
+}
+
+func (m *ReverseAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAuctiontypes(uint64(m.Id))
+	}
+	l = len(m.LegacyStatus)
+	if l > 0 {
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	l = len(m.AuctionType)
+	if l > 0 {
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovAuctiontypes(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovAuctiontypes(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *ProcurementStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowContractId != 0 {
+		n += 1 + sovAuctiontypes(uint64(m.EscrowContractId))
+	}
+	l = len(m.EscrowContractAddress)
+	if l > 0 {
+		n += 1 + l + sovAuctiontypes(uint64(l))
+	}
+	return n
+}
+
+func sovAuctiontypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuctiontypes(x uint64) (n int) {
+	return sovAuctiontypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ReserveAuctionMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuctiontypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReserveAuctionMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReserveAuctionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservePrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReservePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, &types1.Bid{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Strategy == nil {
+				m.Strategy = &SettleStrategy{}
+			}
+			if err := m.Strategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundOnOutbid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RefundOnOutbid = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestBid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HighestBid == nil {
+				m.HighestBid = &types1.Bid{}
+			}
+			if err := m.HighestBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBids", wireType)
+			}
+			m.NumBids = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumBids |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategyType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StrategyType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SettlementStrategy == nil {
+				m.SettlementStrategy = &types2.Any{}
+			}
+			if err := m.SettlementStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ExtensionWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ExtensionDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExtensions", wireType)
+			}
+			m.MaxExtensions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExtensions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumExtensions", wireType)
+			}
+			m.NumExtensions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumExtensions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyNowPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BuyNowPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinIncrement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinIncrement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinIncrementBps", wireType)
+			}
+			m.MinIncrementBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinIncrementBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoExecute", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoExecute = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuctiontypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReserveAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuctiontypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReserveAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReserveAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LegacyStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &ReserveAuctionMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types1.AuctionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuctiontypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SettleStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuctiontypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SettleStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SettleStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategyType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StrategyType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowContractId", wireType)
+			}
+			m.EscrowContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EscrowContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuctiontypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DutchAuctionMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuctiontypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DutchAuctionMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DutchAuctionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FloorPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			m.Schedule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Schedule |= DecrementSchedule(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decrement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Decrement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.StepInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinningBid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WinningBid == nil {
+				m.WinningBid = &types1.Bid{}
+			}
+			if err := m.WinningBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Strategy == nil {
+				m.Strategy = &SettleStrategy{}
+			}
+			if err := m.Strategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SettlementStrategy == nil {
+				m.SettlementStrategy = &types2.Any{}
+			}
+			if err := m.SettlementStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoExecute", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoExecute = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuctiontypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DutchAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuctiontypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DutchAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DutchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LegacyStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &DutchAuctionMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types1.AuctionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuctiontypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SealedBidAuctionMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuctiontypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SealedBidAuctionMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SealedBidAuctionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RevealDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RevealEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservePrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReservePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrevealedBidPolicy", wireType)
+			}
+			m.UnrevealedBidPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnrevealedBidPolicy |= UnrevealedBidPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestBid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HighestBid == nil {
+				m.HighestBid = &types1.Bid{}
+			}
+			if err := m.HighestBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBids", wireType)
+			}
+			m.NumBids = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumBids |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumRevealed", wireType)
+			}
+			m.NumRevealed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumRevealed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Strategy == nil {
+				m.Strategy = &SettleStrategy{}
+			}
+			if err := m.Strategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategyType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StrategyType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SettlementStrategy == nil {
+				m.SettlementStrategy = &types2.Any{}
+			}
+			if err := m.SettlementStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoExecute", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoExecute = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuctiontypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SealedBidAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuctiontypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SealedBidAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SealedBidAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LegacyStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &SealedBidAuctionMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types1.AuctionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuctiontypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SealedBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuctiontypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SealedBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SealedBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revealed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revealed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuctiontypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchAuctionMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuctiontypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchAuctionMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchAuctionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservePrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReservePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestBid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HighestBid == nil {
+				m.HighestBid = &types1.Bid{}
+			}
+			if err := m.HighestBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBids", wireType)
+			}
+			m.NumBids = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumBids |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SettlementStrategy == nil {
+				m.SettlementStrategy = &types2.Any{}
+			}
+			if err := m.SettlementStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoExecute", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoExecute = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuctiontypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuctiontypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LegacyStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &BatchAuctionMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types1.AuctionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuctiontypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuctiontypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuctiontypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovAuctiontypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAuctiontypes(x uint64) (n int) {
-	return sovAuctiontypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ReserveAuctionMetadata) Unmarshal(dAtA []byte) error {
+func (m *UniformPriceStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -636,17 +6776,36 @@ func (m *ReserveAuctionMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReserveAuctionMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: UniformPriceStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReserveAuctionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UniformPriceStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowContractId", wireType)
+			}
+			m.EscrowContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EscrowContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowContractAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuctiontypes
@@ -656,28 +6815,77 @@ func (m *ReserveAuctionMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuctiontypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuctiontypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+			m.EscrowContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuctiontypes(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReverseAuctionMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuctiontypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReverseAuctionMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReverseAuctionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReservePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -704,11 +6912,11 @@ func (m *ReserveAuctionMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReservePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
@@ -741,7 +6949,7 @@ func (m *ReserveAuctionMetadata) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
@@ -774,9 +6982,9 @@ func (m *ReserveAuctionMetadata) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CeilingPrice", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -803,14 +7011,13 @@ func (m *ReserveAuctionMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bids = append(m.Bids, &types1.Bid{})
-			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CeilingPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LowestBid", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -837,13 +7044,35 @@ func (m *ReserveAuctionMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LowestBid == nil {
+				m.LowestBid = &types1.Bid{}
+			}
+			if err := m.LowestBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBids", wireType)
+			}
+			m.NumBids = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumBids |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementStrategy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -870,13 +7099,33 @@ func (m *ReserveAuctionMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Strategy == nil {
-				m.Strategy = &SettleStrategy{}
+			if m.SettlementStrategy == nil {
+				m.SettlementStrategy = &types2.Any{}
 			}
-			if err := m.Strategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SettlementStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoExecute", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoExecute = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuctiontypes(dAtA[iNdEx:])
@@ -898,7 +7147,7 @@ func (m *ReserveAuctionMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReserveAuction) Unmarshal(dAtA []byte) error {
+func (m *ReverseAuction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -921,10 +7170,10 @@ func (m *ReserveAuction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReserveAuction: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReverseAuction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReserveAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReverseAuction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -948,7 +7197,7 @@ func (m *ReserveAuction) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyStatus", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -976,7 +7225,7 @@ func (m *ReserveAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.LegacyStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -1072,12 +7321,65 @@ func (m *ReserveAuction) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Metadata == nil {
-				m.Metadata = &ReserveAuctionMetadata{}
+				m.Metadata = &ReverseAuctionMetadata{}
 			}
 			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctiontypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctiontypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types1.AuctionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuctiontypes(dAtA[iNdEx:])
@@ -1099,7 +7401,7 @@ func (m *ReserveAuction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SettleStrategy) Unmarshal(dAtA []byte) error {
+func (m *ProcurementStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1122,45 +7424,13 @@ func (m *SettleStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SettleStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProcurementStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SettleStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProcurementStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StrategyType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuctiontypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuctiontypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuctiontypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StrategyType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field EscrowContractId", wireType)
 			}
@@ -1179,7 +7449,7 @@ func (m *SettleStrategy) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field EscrowContractAddress", wireType)
 			}
@@ -0,0 +1,221 @@
+package auctiontypes
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/fatal-fruit/auction/types"
+)
+
+var (
+	_ types.Auction         = &BatchAuction{}
+	_ types.AuctionMetadata = &BatchAuctionMetadata{}
+	_ types.DepositAuction  = &BatchAuction{}
+	_ types.BidMetadata     = &BatchBid{}
+
+	_ codectypes.UnpackInterfacesMessage = &BatchAuction{}
+)
+
+// BatchFill is the number of units a bid wins when a batch auction clears.
+type BatchFill struct {
+	Bid      *types.Bid
+	Quantity sdkmath.Int
+}
+
+// GetBatchBid returns the batch bid metadata packed in a bid, or nil if it has none.
+func GetBatchBid(data *codectypes.Any) *BatchBid {
+	if data == nil {
+		return nil
+	}
+	bb, _ := data.GetCachedValue().(*BatchBid)
+	return bb
+}
+
+// ClearBatch fills bids for the given number of units from the highest price per unit down,
+// with ties going to the bid placed first. The last filled bid is partially filled if it
+// asks for more units than remain. It returns the clearing price, which is the price of the
+// last filled bid, along with the fills.
+func ClearBatch(units sdkmath.Int, bids []*types.Bid) (sdk.Coin, []BatchFill) {
+	var sorted []*types.Bid
+	for _, b := range bids {
+		if GetBatchBid(b.Data) != nil {
+			sorted = append(sorted, b)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[j].BidPrice.IsLT(sorted[i].BidPrice)
+	})
+
+	var (
+		price sdk.Coin
+		fills []BatchFill
+	)
+	remaining := units
+	for _, b := range sorted {
+		if !remaining.IsPositive() {
+			break
+		}
+
+		quantity := sdkmath.MinInt(GetBatchBid(b.Data).Quantity, remaining)
+		fills = append(fills, BatchFill{Bid: b, Quantity: quantity})
+		remaining = remaining.Sub(quantity)
+		price = b.BidPrice
+	}
+
+	return price, fills
+}
+
+// batchCost returns the cost of a quantity of units at a price per unit.
+func batchCost(price sdk.Coin, quantity sdkmath.Int) sdk.Coin {
+	return sdk.NewCoin(price.Denom, price.Amount.Mul(quantity))
+}
+
+func (ba *BatchAuction) GetType() string {
+	return ba.AuctionType
+}
+
+func (ba *BatchAuction) GetAuctionMetadata() types.AuctionMetadata {
+	return ba.GetMetadata()
+}
+
+func (ba *BatchAuction) GetDuration() time.Duration {
+	return ba.Metadata.Duration
+}
+
+func (ba *BatchAuction) GetEndTime() time.Time {
+	return ba.Metadata.EndTime
+}
+
+func (ba *BatchAuction) GetAutoExecute() bool {
+	return ba.Metadata.AutoExecute
+}
+
+func (ba *BatchAuction) SetAutoExecute(autoExecute bool) {
+	ba.Metadata.AutoExecute = autoExecute
+}
+
+func (ba *BatchAuction) HasBids() bool {
+	return ba.Metadata.NumBids > 0
+}
+
+func (ba *BatchAuction) NumBids() uint64 {
+	return ba.Metadata.NumBids
+}
+
+// GetLeadingBid returns the bid with the highest price per unit.
+func (ba *BatchAuction) GetLeadingBid() *types.Bid {
+	return ba.Metadata.HighestBid
+}
+
+func (ba *BatchAuction) IsExpired(blockTime time.Time) bool {
+	return ba.Metadata.EndTime.Before(blockTime)
+}
+
+func (ba *BatchAuction) SetOwner(owner sdk.AccAddress) {
+	ba.Owner = owner.String()
+}
+
+func (ba *BatchAuction) SetDeposit(deposit sdk.Coins) {
+	ba.Deposit = deposit
+}
+
+// ValidateDeposit checks that the deposit holds units of a single denom.
+func (ba *BatchAuction) ValidateDeposit(deposit sdk.Coins) error {
+	if len(deposit) != 1 || !deposit[0].IsPositive() {
+		return fmt.Errorf("batch auction deposit must be a single coin :: %s", deposit)
+	}
+	return nil
+}
+
+// Units returns the number of units on sale.
+func (ba *BatchAuction) Units() sdkmath.Int {
+	if len(ba.Deposit) != 1 {
+		return sdkmath.ZeroInt()
+	}
+	return ba.Deposit[0].Amount
+}
+
+func (ba *BatchAuction) StartAuction(blockTime time.Time) {
+	ba.Metadata.StartTime = blockTime
+	ba.Metadata.EndTime = blockTime.Add(ba.Metadata.Duration)
+}
+
+// SubmitBid accepts a bid for a quantity of units at a maximum price per unit. The quantity
+// is carried in the bid metadata.
+func (ba *BatchAuction) SubmitBid(blockTime time.Time, bidMsg *types.MsgNewBid) error {
+	// Validate auction is active
+	if blockTime.Before(ba.Metadata.StartTime) || blockTime.After(ba.Metadata.EndTime) {
+		return fmt.Errorf("auction not accepting bids :: %d", ba.Id)
+	}
+
+	bb := GetBatchBid(bidMsg.Data)
+	if bb == nil {
+		return fmt.Errorf("missing batch bid metadata")
+	}
+	if bb.Quantity.IsNil() || !bb.Quantity.IsPositive() {
+		return fmt.Errorf("invalid quantity :: %s", bb.Quantity)
+	}
+	if bb.Quantity.GT(ba.Units()) {
+		return fmt.Errorf("quantity exceeds units on sale :: %s", ba.Units())
+	}
+
+	// Validate price per unit is over Reserve Price
+	if bidMsg.BidAmount.Denom != ba.Metadata.ReservePrice.Denom {
+		return fmt.Errorf("invalid bid denom :: %s", bidMsg.BidAmount.Denom)
+	}
+	if bidMsg.BidAmount.IsLT(ba.Metadata.ReservePrice) {
+		return fmt.Errorf("bid lower than reserve price :: %s", ba.Metadata.ReservePrice.String())
+	}
+
+	bid := &types.Bid{
+		AuctionId: bidMsg.AuctionId,
+		Bidder:    bidMsg.Owner,
+		BidPrice:  bidMsg.BidAmount,
+		Timestamp: blockTime,
+		Data:      bidMsg.Data,
+	}
+	// Ties go to the bid placed first
+	if ba.Metadata.HighestBid == nil || ba.Metadata.HighestBid.BidPrice.IsLT(bid.BidPrice) {
+		ba.Metadata.HighestBid = bid
+	}
+	ba.Metadata.NumBids++
+	return nil
+}
+
+func (ba *BatchAuction) UpdateStatus(newStatus types.AuctionStatus) {
+	ba.Status = newStatus
+}
+
+// ValidateBasic checks that the reserve price is well formed.
+func (m *BatchAuctionMetadata) ValidateBasic() error {
+	if !m.ReservePrice.IsValid() {
+		return fmt.Errorf("invalid reserve price :: %s", m.ReservePrice.String())
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (ba *BatchAuction) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if ba.Metadata == nil {
+		return nil
+	}
+	return ba.Metadata.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *BatchAuctionMetadata) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if m.HighestBid != nil {
+		if err := m.HighestBid.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	if m.SettlementStrategy == nil {
+		return nil
+	}
+	var s types.Strategy
+	return unpacker.UnpackAny(m.SettlementStrategy, &s)
+}
@@ -0,0 +1,127 @@
+package auctiontypes
+
+import (
+	"context"
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/fatal-fruit/auction/types"
+)
+
+var _ types.AuctionHandler = &BatchAuctionHandler{}
+
+type BatchAuctionHandler struct {
+	es types.EscrowService
+	bk types.BankKeeper
+	sr types.StrategyResolver
+}
+
+func NewBatchAuctionHandler(es types.EscrowService, bk types.BankKeeper, sr types.StrategyResolver) *BatchAuctionHandler {
+	return &BatchAuctionHandler{
+		bk: bk,
+		es: es,
+		sr: sr,
+	}
+}
+
+func (ah *BatchAuctionHandler) CreateAuction(ctx context.Context, id uint64, am types.AuctionMetadata) (types.Auction, error) {
+	md, ok := am.(proto.Message)
+	if !ok {
+		return &BatchAuction{}, fmt.Errorf("%T does not implement proto.Message", md)
+	}
+
+	a := &BatchAuction{
+		Id:          id,
+		Status:      types.CREATED,
+		AuctionType: sdk.MsgTypeURL(&BatchAuction{}),
+		Metadata:    &BatchAuctionMetadata{},
+	}
+
+	var selected *codectypes.Any
+	switch m := am.(type) {
+	case *BatchAuctionMetadata:
+		if err := m.ValidateBasic(); err != nil {
+			return &BatchAuction{}, err
+		}
+		a.Metadata.Duration = m.Duration
+		a.Metadata.ReservePrice = m.ReservePrice
+		a.Metadata.AutoExecute = m.AutoExecute
+		selected = m.SettlementStrategy
+	default:
+		return &BatchAuction{}, fmt.Errorf("invalid auction metadata :: %s", m.String())
+	}
+
+	strategy, err := InitStrategy(ctx, ah.sr, id, selected, &UniformPriceStrategy{})
+	if err != nil {
+		return &BatchAuction{}, fmt.Errorf("error creating settlement strategy for auction id %d: %w", id, err)
+	}
+	// Bids are filled and units delivered by the uniform price strategy
+	if _, ok := GetStrategy(strategy).(*UniformPriceStrategy); !ok {
+		return &BatchAuction{}, fmt.Errorf("invalid settlement strategy for batch auction :: %s", strategy.GetTypeUrl())
+	}
+	a.Metadata.SettlementStrategy = strategy
+
+	return a, nil
+}
+
+func (ah *BatchAuctionHandler) SubmitBid(ctx context.Context, auction types.Auction, bidMsg *types.MsgNewBid) (types.Auction, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	ba, ok := auction.(*BatchAuction)
+	if !ok {
+		return nil, fmt.Errorf("invalid auction metadata type")
+	}
+
+	err := ba.SubmitBid(sdkCtx.BlockTime(), bidMsg)
+	if err != nil {
+		return nil, fmt.Errorf("error submitting bid from auction handler: %w", err)
+	}
+
+	s := GetStrategy(ba.Metadata.SettlementStrategy)
+	if s == nil {
+		return nil, fmt.Errorf("missing settlement strategy for auction :: %d", ba.Id)
+	}
+
+	// Send the cost of every unit bid for at the maximum price to escrow
+	err = escrowBid(ctx, s, bidMsg.Owner, batchCost(bidMsg.BidAmount, GetBatchBid(bidMsg.Data).Quantity), ah.bk)
+	if err != nil {
+		return nil, err
+	}
+
+	return ba, nil
+}
+
+// ExecAuction clears the auction with its settlement strategy, which fills the winning bids
+// at the clearing price, delivers their units and refunds the rest of every bid.
+func (ah *BatchAuctionHandler) ExecAuction(ctx context.Context, auction types.Auction, bids []*types.Bid) error {
+	ba, ok := auction.(*BatchAuction)
+	if !ok {
+		return fmt.Errorf("invalid auction metadata")
+	}
+
+	winningBid := ba.GetLeadingBid()
+	if winningBid == nil {
+		return fmt.Errorf("no winning bid for auction :: %d", ba.Id)
+	}
+
+	_, err := settle(ctx, ah.sr, ba, ba.Metadata.SettlementStrategy, winningBid, bids, ba.Metadata.ReservePrice)
+	return err
+}
+
+// CancelAuction returns the full cost of every bid from escrow and releases the rest of the
+// escrow to the auction owner.
+func (ah *BatchAuctionHandler) CancelAuction(ctx context.Context, auction types.Auction, bids []*types.Bid) error {
+	a, ok := auction.(*BatchAuction)
+	if !ok {
+		return fmt.Errorf("invalid auction metadata")
+	}
+
+	return cancelEscrow(ctx, ah.es, ah.bk, a, a.Metadata.SettlementStrategy, bids, func(b *types.Bid) sdk.Coin {
+		bb := GetBatchBid(b.Data)
+		if bb == nil {
+			return sdk.Coin{}
+		}
+		return batchCost(b.BidPrice, bb.Quantity)
+	})
+}
@@ -0,0 +1,175 @@
+package auctiontypes_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	at "github.com/fatal-fruit/auction/auctiontypes"
+	auctiontypes "github.com/fatal-fruit/auction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClearBatch(t *testing.T) {
+	newBid := func(bidder string, quantity, price int64) *auctiontypes.Bid {
+		return &auctiontypes.Bid{
+			Bidder:   bidder,
+			BidPrice: sdk.NewInt64Coin("stake", price),
+			Data:     codectypes.UnsafePackAny(&at.BatchBid{Quantity: sdkmath.NewInt(quantity)}),
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		units    int64
+		bids     []*auctiontypes.Bid
+		expPrice int64
+		expFills map[string]int64
+	}{
+		{
+			name:     "marginal bid is partially filled",
+			units:    100,
+			bids:     []*auctiontypes.Bid{newBid("a", 60, 12), newBid("b", 50, 20), newBid("c", 30, 11)},
+			expPrice: 12,
+			expFills: map[string]int64{"b": 50, "a": 50},
+		},
+		{
+			name:     "undersubscribed auction fills every bid",
+			units:    100,
+			bids:     []*auctiontypes.Bid{newBid("a", 20, 12), newBid("b", 30, 15)},
+			expPrice: 12,
+			expFills: map[string]int64{"b": 30, "a": 20},
+		},
+		{
+			name:     "ties go to the bid placed first",
+			units:    50,
+			bids:     []*auctiontypes.Bid{newBid("a", 40, 15), newBid("b", 40, 15)},
+			expPrice: 15,
+			expFills: map[string]int64{"a": 40, "b": 10},
+		},
+		{
+			name:     "bids without batch metadata are ignored",
+			units:    10,
+			bids:     []*auctiontypes.Bid{{Bidder: "a", BidPrice: sdk.NewInt64Coin("stake", 100)}, newBid("b", 10, 15)},
+			expPrice: 15,
+			expFills: map[string]int64{"b": 10},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			price, fills := at.ClearBatch(sdkmath.NewInt(tc.units), tc.bids)
+			require.Equal(t, sdk.NewInt64Coin("stake", tc.expPrice), price)
+
+			actual := make(map[string]int64)
+			for _, f := range fills {
+				actual[f.Bid.Bidder] = f.Quantity.Int64()
+			}
+			require.Equal(t, tc.expFills, actual)
+		})
+	}
+}
+
+func TestBatchAuctionSubmitBid(t *testing.T) {
+	start := time.Now().UTC()
+	newAuction := func() *at.BatchAuction {
+		return &at.BatchAuction{
+			Deposit: sdk.NewCoins(sdk.NewInt64Coin("uasset", 100)),
+			Metadata: &at.BatchAuctionMetadata{
+				ReservePrice: sdk.NewInt64Coin("stake", 10),
+				StartTime:    start,
+				EndTime:      start.Add(time.Minute),
+			},
+		}
+	}
+	newMsg := func(quantity, price int64) *auctiontypes.MsgNewBid {
+		return &auctiontypes.MsgNewBid{
+			Owner:     "bidder",
+			BidAmount: sdk.NewInt64Coin("stake", price),
+			Data:      codectypes.UnsafePackAny(&at.BatchBid{Quantity: sdkmath.NewInt(quantity)}),
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		msg     *auctiontypes.MsgNewBid
+		bidTime time.Time
+		expErr  bool
+	}{
+		{"valid bid", newMsg(50, 12), start, false},
+		{"bid for every unit", newMsg(100, 10), start, false},
+		{"missing batch bid", &auctiontypes.MsgNewBid{Owner: "bidder", BidAmount: sdk.NewInt64Coin("stake", 12)}, start, true},
+		{"zero quantity", newMsg(0, 12), start, true},
+		{"quantity exceeds units", newMsg(101, 12), start, true},
+		{"price below reserve", newMsg(50, 9), start, true},
+		{"invalid denom", &auctiontypes.MsgNewBid{Owner: "bidder", BidAmount: sdk.NewInt64Coin("atom", 12), Data: newMsg(50, 12).Data}, start, true},
+		{"auction ended", newMsg(50, 12), start.Add(2 * time.Minute), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ba := newAuction()
+			err := ba.SubmitBid(tc.bidTime, tc.msg)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), ba.NumBids())
+			require.Equal(t, tc.msg.BidAmount, ba.GetLeadingBid().BidPrice)
+		})
+	}
+}
+
+func TestBatchAuctionValidateDeposit(t *testing.T) {
+	ba := &at.BatchAuction{}
+	require.NoError(t, ba.ValidateDeposit(sdk.NewCoins(sdk.NewInt64Coin("uasset", 100))))
+	require.Error(t, ba.ValidateDeposit(sdk.NewCoins()))
+	require.Error(t, ba.ValidateDeposit(sdk.NewCoins(sdk.NewInt64Coin("uasset", 100), sdk.NewInt64Coin("uatom", 1))))
+}
+
+func TestUniformPriceSettlementPrice(t *testing.T) {
+	newBid := func(quantity, price int64) *auctiontypes.Bid {
+		return &auctiontypes.Bid{
+			BidPrice: sdk.NewInt64Coin("stake", price),
+			Data:     codectypes.UnsafePackAny(&at.BatchBid{Quantity: sdkmath.NewInt(quantity)}),
+		}
+	}
+	reserve := sdk.NewInt64Coin("stake", 10)
+	auction := &at.BatchAuction{Deposit: sdk.NewCoins(sdk.NewInt64Coin("utoken", 100))}
+
+	testCases := []struct {
+		name     string
+		bids     []*auctiontypes.Bid
+		expPrice int64
+	}{
+		{
+			name:     "oversubscribed auction clears at the marginal bid",
+			bids:     []*auctiontypes.Bid{newBid(60, 12), newBid(50, 20)},
+			expPrice: 12,
+		},
+		{
+			name:     "fully subscribed auction clears at the marginal bid",
+			bids:     []*auctiontypes.Bid{newBid(50, 12), newBid(50, 20)},
+			expPrice: 12,
+		},
+		{
+			name:     "undersubscribed auction clears at the reserve price",
+			bids:     []*auctiontypes.Bid{newBid(20, 12), newBid(30, 15)},
+			expPrice: 10,
+		},
+	}
+
+	sh := at.NewUniformPriceStrategyHandler(nil, nil)
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			price := sh.SettlementPrice(auction, &at.UniformPriceStrategy{}, tc.bids[0], tc.bids, reserve)
+			require.Equal(t, sdk.NewInt64Coin("stake", tc.expPrice), price)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package auctiontypes
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/fatal-fruit/auction/types"
+)
+
+// RegisterInterfaces registers the auction implementations with the interface registry.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*types.Auction)(nil),
+		&ReserveAuction{},
+		&DutchAuction{},
+		&SealedBidAuction{},
+		&BatchAuction{},
+		&ReverseAuction{},
+	)
+	registry.RegisterImplementations((*types.AuctionMetadata)(nil),
+		&ReserveAuctionMetadata{},
+		&DutchAuctionMetadata{},
+		&SealedBidAuctionMetadata{},
+		&BatchAuctionMetadata{},
+		&ReverseAuctionMetadata{},
+	)
+	registry.RegisterImplementations((*types.BidMetadata)(nil),
+		&SealedBid{},
+		&BatchBid{},
+	)
+	registry.RegisterImplementations((*types.Strategy)(nil),
+		&SettleStrategy{},
+		&UniformPriceStrategy{},
+		&ProcurementStrategy{},
+	)
+}
@@ -0,0 +1,205 @@
+package auctiontypes
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/fatal-fruit/auction/types"
+)
+
+var (
+	_ types.Auction         = &DutchAuction{}
+	_ types.AuctionMetadata = &DutchAuctionMetadata{}
+	_ types.ClosingAuction  = &DutchAuction{}
+
+	_ codectypes.UnpackInterfacesMessage = &DutchAuction{}
+)
+
+func (da *DutchAuction) GetType() string {
+	return da.AuctionType
+}
+
+func (da *DutchAuction) GetAuctionMetadata() types.AuctionMetadata {
+	return da.GetMetadata()
+}
+
+func (da *DutchAuction) GetDuration() time.Duration {
+	return da.Metadata.Duration
+}
+
+func (da *DutchAuction) GetEndTime() time.Time {
+	return da.Metadata.EndTime
+}
+
+func (da *DutchAuction) GetAutoExecute() bool {
+	return da.Metadata.AutoExecute
+}
+
+func (da *DutchAuction) SetAutoExecute(autoExecute bool) {
+	da.Metadata.AutoExecute = autoExecute
+}
+
+func (da *DutchAuction) HasBids() bool {
+	return da.Metadata.WinningBid != nil
+}
+
+// NumBids returns 1 once the auction has been won, as a Dutch auction only accepts a single bid.
+func (da *DutchAuction) NumBids() uint64 {
+	if da.HasBids() {
+		return 1
+	}
+	return 0
+}
+
+// GetLeadingBid returns the winning bid, or nil if no bid has been accepted.
+func (da *DutchAuction) GetLeadingBid() *types.Bid {
+	return da.Metadata.WinningBid
+}
+
+// IsClosed returns true once a bid has been accepted, as the first bid at or above the
+// current price wins the auction.
+func (da *DutchAuction) IsClosed() bool {
+	return da.HasBids()
+}
+
+func (da *DutchAuction) IsExpired(blockTime time.Time) bool {
+	return da.Metadata.EndTime.Before(blockTime)
+}
+
+func (da *DutchAuction) SetOwner(owner sdk.AccAddress) {
+	da.Owner = owner.String()
+}
+
+func (da *DutchAuction) SetDeposit(deposit sdk.Coins) {
+	da.Deposit = deposit
+}
+
+func (da *DutchAuction) StartAuction(blockTime time.Time) {
+	da.Metadata.StartTime = blockTime
+	da.Metadata.EndTime = blockTime.Add(da.Metadata.Duration)
+}
+
+// CurrentPrice returns the asking price at the given block time according to the
+// auction's decrement schedule. The price never falls below the floor price.
+func (da *DutchAuction) CurrentPrice(blockTime time.Time) sdk.Coin {
+	md := da.Metadata
+	elapsed := blockTime.Sub(md.StartTime)
+	if elapsed <= 0 {
+		return md.StartPrice
+	}
+	if elapsed > md.Duration {
+		elapsed = md.Duration
+	}
+
+	var drop math.Int
+	switch md.Schedule {
+	case DECREMENT_SCHEDULE_LINEAR:
+		// Price falls from the start price to the floor price over the duration
+		spread := md.StartPrice.Amount.Sub(md.FloorPrice.Amount)
+		drop = spread.Mul(math.NewInt(int64(elapsed))).Quo(math.NewInt(int64(md.Duration)))
+	case DECREMENT_SCHEDULE_STEPWISE:
+		steps := int64(elapsed / md.StepInterval)
+		drop = md.Decrement.Amount.Mul(math.NewInt(steps))
+	default:
+		return md.StartPrice
+	}
+
+	price := md.StartPrice.Amount.Sub(drop)
+	if price.LT(md.FloorPrice.Amount) {
+		return md.FloorPrice
+	}
+	return sdk.NewCoin(md.StartPrice.Denom, price)
+}
+
+// AcceptedPrice returns the asking price when the winning bid was accepted, which is the
+// price the winner pays.
+func (da *DutchAuction) AcceptedPrice() sdk.Coin {
+	return da.CurrentPrice(da.Metadata.WinningBid.GetTimestamp())
+}
+
+// SubmitBid accepts the first bid at or above the current price and closes the auction.
+func (da *DutchAuction) SubmitBid(blockTime time.Time, bidMsg *types.MsgNewBid) error {
+	// Validate auction is active
+	if da.HasBids() {
+		return fmt.Errorf("auction already closed :: %d", da.Id)
+	}
+	if blockTime.Before(da.Metadata.StartTime) || blockTime.After(da.Metadata.EndTime) {
+		return fmt.Errorf("auction not accepting bids :: %d", da.Id)
+	}
+
+	price := da.CurrentPrice(blockTime)
+	if bidMsg.BidAmount.Denom != price.Denom {
+		return fmt.Errorf("invalid bid denom :: %s", bidMsg.BidAmount.Denom)
+	}
+	if bidMsg.BidAmount.IsLT(price) {
+		return fmt.Errorf("bid lower than current price :: %s", price.String())
+	}
+
+	da.Metadata.WinningBid = &types.Bid{
+		AuctionId: bidMsg.AuctionId,
+		Bidder:    bidMsg.Owner,
+		BidPrice:  bidMsg.BidAmount,
+		Timestamp: blockTime,
+	}
+
+	// The auction closes with the winning bid
+	da.Metadata.EndTime = blockTime
+	return nil
+}
+
+func (da *DutchAuction) UpdateStatus(newStatus types.AuctionStatus) {
+	da.Status = newStatus
+}
+
+// ValidateBasic checks that the price schedule is well formed.
+func (m *DutchAuctionMetadata) ValidateBasic() error {
+	if !m.StartPrice.IsValid() || !m.StartPrice.IsPositive() {
+		return fmt.Errorf("invalid start price :: %s", m.StartPrice.String())
+	}
+	if !m.FloorPrice.IsValid() || m.FloorPrice.Denom != m.StartPrice.Denom {
+		return fmt.Errorf("invalid floor price :: %s", m.FloorPrice.String())
+	}
+	if m.FloorPrice.IsGTE(m.StartPrice) {
+		return fmt.Errorf("floor price %s must be lower than start price %s", m.FloorPrice, m.StartPrice)
+	}
+
+	switch m.Schedule {
+	case DECREMENT_SCHEDULE_LINEAR:
+	case DECREMENT_SCHEDULE_STEPWISE:
+		if !m.Decrement.IsValid() || !m.Decrement.IsPositive() || m.Decrement.Denom != m.StartPrice.Denom {
+			return fmt.Errorf("invalid decrement :: %s", m.Decrement.String())
+		}
+		if m.StepInterval <= 0 {
+			return fmt.Errorf("invalid step interval :: %s", m.StepInterval)
+		}
+	default:
+		return fmt.Errorf("invalid decrement schedule :: %s", m.Schedule)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (da *DutchAuction) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if da.Metadata == nil {
+		return nil
+	}
+	return da.Metadata.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *DutchAuctionMetadata) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if m.WinningBid != nil {
+		if err := m.WinningBid.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	if m.SettlementStrategy == nil {
+		return nil
+	}
+	var s types.Strategy
+	return unpacker.UnpackAny(m.SettlementStrategy, &s)
+}
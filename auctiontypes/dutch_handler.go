@@ -0,0 +1,146 @@
+package auctiontypes
+
+import (
+	"context"
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/fatal-fruit/auction/types"
+)
+
+var _ types.AuctionHandler = &DutchAuctionHandler{}
+
+type DutchAuctionHandler struct {
+	es types.EscrowService
+	bk types.BankKeeper
+	sr types.StrategyResolver
+}
+
+func NewDutchAuctionHandler(es types.EscrowService, bk types.BankKeeper, sr types.StrategyResolver) *DutchAuctionHandler {
+	return &DutchAuctionHandler{
+		bk: bk,
+		es: es,
+		sr: sr,
+	}
+}
+
+func (ah *DutchAuctionHandler) CreateAuction(ctx context.Context, id uint64, am types.AuctionMetadata) (types.Auction, error) {
+	md, ok := am.(proto.Message)
+	if !ok {
+		return &DutchAuction{}, fmt.Errorf("%T does not implement proto.Message", md)
+	}
+
+	a := &DutchAuction{
+		Id:          id,
+		Status:      types.CREATED,
+		AuctionType: sdk.MsgTypeURL(&DutchAuction{}),
+		Metadata:    &DutchAuctionMetadata{},
+	}
+
+	var selected *codectypes.Any
+	switch m := am.(type) {
+	case *DutchAuctionMetadata:
+		if err := m.ValidateBasic(); err != nil {
+			return &DutchAuction{}, err
+		}
+		a.Metadata.Duration = m.Duration
+		a.Metadata.StartPrice = m.StartPrice
+		a.Metadata.FloorPrice = m.FloorPrice
+		a.Metadata.Schedule = m.Schedule
+		a.Metadata.Decrement = m.Decrement
+		a.Metadata.StepInterval = m.StepInterval
+		a.Metadata.AutoExecute = m.AutoExecute
+		selected = m.SettlementStrategy
+	default:
+		return &DutchAuction{}, fmt.Errorf("invalid auction metadata :: %s", m.String())
+	}
+
+	strategy, err := InitStrategy(ctx, ah.sr, id, selected, &SettleStrategy{StrategyType: types.SETTLE})
+	if err != nil {
+		return &DutchAuction{}, fmt.Errorf("error creating settlement strategy for auction id %d: %w", id, err)
+	}
+	a.Metadata.SettlementStrategy = strategy
+
+	return a, nil
+}
+
+func (ah *DutchAuctionHandler) SubmitBid(ctx context.Context, auction types.Auction, bidMsg *types.MsgNewBid) (types.Auction, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	da, ok := auction.(*DutchAuction)
+	if !ok {
+		return nil, fmt.Errorf("invalid auction metadata type")
+	}
+
+	// Accept the bid if it meets the price at the current block time
+	err := da.SubmitBid(sdkCtx.BlockTime(), bidMsg)
+	if err != nil {
+		return nil, fmt.Errorf("error submitting bid from auction handler: %w", err)
+	}
+
+	s := GetStrategy(da.Metadata.SettlementStrategy)
+	if s == nil {
+		return nil, fmt.Errorf("missing settlement strategy for auction :: %d", da.Id)
+	}
+
+	// Send bid amount to escrow contract
+	err = escrowBid(ctx, s, bidMsg.Owner, bidMsg.BidAmount, ah.bk)
+	if err != nil {
+		return nil, err
+	}
+
+	return da, nil
+}
+
+// ExecAuction settles the auction at the asking price when the winning bid was accepted,
+// refunds the part of the winning bid above the settlement price and delivers the deposit
+// to the winner. The floor price stands in for the reserve price of strategies that use one.
+func (ah *DutchAuctionHandler) ExecAuction(ctx context.Context, auction types.Auction, bids []*types.Bid) error {
+	a, ok := auction.(*DutchAuction)
+	if !ok {
+		return fmt.Errorf("invalid auction metadata")
+	}
+
+	winningBid := a.GetLeadingBid()
+	if winningBid == nil {
+		return fmt.Errorf("no winning bid for auction :: %d", a.Id)
+	}
+
+	// The winner pays the asking price, not the amount they bid
+	accepted := *winningBid
+	accepted.BidPrice = a.AcceptedPrice()
+	price, err := settle(ctx, ah.sr, a, a.Metadata.SettlementStrategy, &accepted, bids, a.Metadata.FloorPrice)
+	if err != nil {
+		return err
+	}
+
+	// Return the part of the winning bid above the settlement price
+	if price.IsLT(winningBid.BidPrice) {
+		s := GetStrategy(a.Metadata.SettlementStrategy)
+		err = refund(ctx, s, a, winningBid.Bidder, winningBid.BidPrice.Sub(price), ah.bk)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Deliver the auctioned deposit to the winner
+	if !a.Deposit.IsZero() {
+		winner := sdk.MustAccAddressFromBech32(winningBid.Bidder)
+		return ah.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, winner, a.Deposit)
+	}
+	return nil
+}
+
+// CancelAuction returns the accepted bid from escrow and releases the rest of the escrow to
+// the auction owner.
+func (ah *DutchAuctionHandler) CancelAuction(ctx context.Context, auction types.Auction, bids []*types.Bid) error {
+	a, ok := auction.(*DutchAuction)
+	if !ok {
+		return fmt.Errorf("invalid auction metadata")
+	}
+
+	return cancelEscrow(ctx, ah.es, ah.bk, a, a.Metadata.SettlementStrategy, bids, func(b *types.Bid) sdk.Coin {
+		return b.BidPrice
+	})
+}
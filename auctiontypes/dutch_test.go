@@ -0,0 +1,134 @@
+package auctiontypes_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	at "github.com/fatal-fruit/auction/auctiontypes"
+	auctiontypes "github.com/fatal-fruit/auction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDutchAuctionCurrentPrice(t *testing.T) {
+	start := time.Now().UTC()
+	newAuction := func(schedule at.DecrementSchedule) *at.DutchAuction {
+		return &at.DutchAuction{
+			Metadata: &at.DutchAuctionMetadata{
+				Duration:     100 * time.Second,
+				StartTime:    start,
+				EndTime:      start.Add(100 * time.Second),
+				StartPrice:   sdk.NewInt64Coin("stake", 1000),
+				FloorPrice:   sdk.NewInt64Coin("stake", 500),
+				Schedule:     schedule,
+				Decrement:    sdk.NewInt64Coin("stake", 150),
+				StepInterval: 20 * time.Second,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		schedule at.DecrementSchedule
+		elapsed  time.Duration
+		expPrice int64
+	}{
+		{"linear before start", at.DECREMENT_SCHEDULE_LINEAR, -5 * time.Second, 1000},
+		{"linear at start", at.DECREMENT_SCHEDULE_LINEAR, 0, 1000},
+		{"linear halfway", at.DECREMENT_SCHEDULE_LINEAR, 50 * time.Second, 750},
+		{"linear at end", at.DECREMENT_SCHEDULE_LINEAR, 100 * time.Second, 500},
+		{"linear past end", at.DECREMENT_SCHEDULE_LINEAR, 200 * time.Second, 500},
+		{"stepwise before first step", at.DECREMENT_SCHEDULE_STEPWISE, 19 * time.Second, 1000},
+		{"stepwise after first step", at.DECREMENT_SCHEDULE_STEPWISE, 20 * time.Second, 850},
+		{"stepwise after three steps", at.DECREMENT_SCHEDULE_STEPWISE, 65 * time.Second, 550},
+		{"stepwise clamped to floor", at.DECREMENT_SCHEDULE_STEPWISE, 80 * time.Second, 500},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			price := newAuction(tc.schedule).CurrentPrice(start.Add(tc.elapsed))
+			require.Equal(t, sdk.NewInt64Coin("stake", tc.expPrice), price)
+		})
+	}
+}
+
+func TestDutchAuctionMetadataValidateBasic(t *testing.T) {
+	valid := func() *at.DutchAuctionMetadata {
+		return &at.DutchAuctionMetadata{
+			Duration:     100 * time.Second,
+			StartPrice:   sdk.NewInt64Coin("stake", 1000),
+			FloorPrice:   sdk.NewInt64Coin("stake", 500),
+			Schedule:     at.DECREMENT_SCHEDULE_STEPWISE,
+			Decrement:    sdk.NewInt64Coin("stake", 100),
+			StepInterval: 10 * time.Second,
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(md *at.DutchAuctionMetadata)
+		expErr   bool
+	}{
+		{name: "valid stepwise", malleate: func(md *at.DutchAuctionMetadata) {}},
+		{name: "valid linear", malleate: func(md *at.DutchAuctionMetadata) {
+			md.Schedule = at.DECREMENT_SCHEDULE_LINEAR
+			md.Decrement = sdk.Coin{}
+			md.StepInterval = 0
+		}},
+		{name: "floor above start", malleate: func(md *at.DutchAuctionMetadata) {
+			md.FloorPrice = sdk.NewInt64Coin("stake", 1500)
+		}, expErr: true},
+		{name: "mismatched floor denom", malleate: func(md *at.DutchAuctionMetadata) {
+			md.FloorPrice = sdk.NewInt64Coin("uatom", 500)
+		}, expErr: true},
+		{name: "missing decrement", malleate: func(md *at.DutchAuctionMetadata) {
+			md.Decrement = sdk.Coin{}
+		}, expErr: true},
+		{name: "missing step interval", malleate: func(md *at.DutchAuctionMetadata) {
+			md.StepInterval = 0
+		}, expErr: true},
+		{name: "unspecified schedule", malleate: func(md *at.DutchAuctionMetadata) {
+			md.Schedule = at.DECREMENT_SCHEDULE_UNSPECIFIED
+		}, expErr: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			md := valid()
+			tc.malleate(md)
+			err := md.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDutchAuctionAcceptedPrice(t *testing.T) {
+	start := time.Now().UTC()
+	a := &at.DutchAuction{
+		Id: 1,
+		Metadata: &at.DutchAuctionMetadata{
+			Duration:   100 * time.Second,
+			StartTime:  start,
+			EndTime:    start.Add(100 * time.Second),
+			StartPrice: sdk.NewInt64Coin("stake", 1000),
+			FloorPrice: sdk.NewInt64Coin("stake", 500),
+			Schedule:   at.DECREMENT_SCHEDULE_LINEAR,
+		},
+	}
+
+	// A bid above the asking price is accepted, but the winner pays the asking price
+	bidTime := start.Add(50 * time.Second)
+	require.NoError(t, a.SubmitBid(bidTime, &auctiontypes.MsgNewBid{
+		AuctionId: 1,
+		Owner:     "bidder",
+		BidAmount: sdk.NewInt64Coin("stake", 900),
+	}))
+	require.Equal(t, sdk.NewInt64Coin("stake", 900), a.GetLeadingBid().GetBidPrice())
+	require.Equal(t, sdk.NewInt64Coin("stake", 750), a.AcceptedPrice())
+}
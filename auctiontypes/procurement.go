@@ -0,0 +1,80 @@
+package auctiontypes
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/fatal-fruit/auction/types"
+)
+
+var (
+	_ types.Strategy        = &ProcurementStrategy{}
+	_ types.StrategyHandler = &ProcurementStrategyHandler{}
+)
+
+// ProcurementStrategyHandler handles the ProcurementStrategy. It pays the winning provider
+// of a reverse auction from the auctioneer's budget and returns the rest of the budget.
+type ProcurementStrategyHandler struct {
+	bk types.BankKeeper
+}
+
+func NewProcurementStrategyHandler(bk types.BankKeeper) *ProcurementStrategyHandler {
+	return &ProcurementStrategyHandler{
+		bk: bk,
+	}
+}
+
+// CreateStrategy creates a ProcurementStrategy. The budget is the auction deposit held by
+// the module account and offers escrow no funds, so no escrow contract is created.
+func (sh *ProcurementStrategyHandler) CreateStrategy(_ context.Context, _ uint64, s types.Strategy) (types.Strategy, error) {
+	if _, ok := s.(*ProcurementStrategy); !ok {
+		return nil, fmt.Errorf("invalid strategy type :: %T", s)
+	}
+
+	return &ProcurementStrategy{}, nil
+}
+
+// SettlementPrice returns the winning provider's bid.
+func (sh *ProcurementStrategyHandler) SettlementPrice(_ types.Auction, _ types.Strategy, winningBid *types.Bid, _ []*types.Bid, _ sdk.Coin) sdk.Coin {
+	return winningBid.BidPrice
+}
+
+// Settle pays the winning provider the settlement price from the budget held for the auction
+// and refunds the remainder of the budget to the auction owner.
+func (sh *ProcurementStrategyHandler) Settle(ctx context.Context, a types.Auction, _ types.Strategy, price sdk.Coin, _ []*types.Bid) error {
+	budget := a.GetDeposit()
+	if !budget.IsAllGTE(sdk.Coins{price}) {
+		return fmt.Errorf("budget %s does not cover settlement price %s", budget, price)
+	}
+
+	winner := a.GetLeadingBid()
+	if winner == nil {
+		return fmt.Errorf("no winning bid for auction :: %d", a.GetId())
+	}
+
+	provider := sdk.MustAccAddressFromBech32(winner.Bidder)
+	err := sh.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, provider, sdk.Coins{price})
+	if err != nil {
+		return err
+	}
+
+	remainder := budget.Sub(price)
+	if remainder.IsZero() {
+		return nil
+	}
+
+	owner := sdk.MustAccAddressFromBech32(a.GetOwner())
+	err = sh.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, remainder)
+	if err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventFundsRefunded{
+		AuctionId:   a.GetId(),
+		AuctionType: a.GetType(),
+		Owner:       a.GetOwner(),
+		Recipient:   a.GetOwner(),
+		Amount:      remainder,
+	})
+}
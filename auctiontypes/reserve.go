@@ -1,16 +1,24 @@
 package auctiontypes
 
 import (
-	"context"
+	sdkmath "cosmossdk.io/math"
 	"fmt"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/fatal-fruit/auction/types"
 	"time"
 )
 
+// MaxBasisPoints is the number of basis points in 100%.
+const MaxBasisPoints = 10000
+
 var (
-	_ types.Auction         = &ReserveAuction{}
-	_ types.AuctionMetadata = &ReserveAuctionMetadata{}
+	_ types.Auction           = &ReserveAuction{}
+	_ types.AuctionMetadata   = &ReserveAuctionMetadata{}
+	_ types.ClosingAuction    = &ReserveAuction{}
+	_ types.MinimumBidAuction = &ReserveAuction{}
+
+	_ codectypes.UnpackInterfacesMessage = &ReserveAuction{}
 )
 
 func (ra *ReserveAuction) GetType() string {
@@ -21,8 +29,42 @@ func (ra *ReserveAuction) GetAuctionMetadata() types.AuctionMetadata {
 	return ra.GetMetadata()
 }
 
+func (ra *ReserveAuction) GetDuration() time.Duration {
+	return ra.Metadata.Duration
+}
+
+func (ra *ReserveAuction) GetEndTime() time.Time {
+	return ra.Metadata.EndTime
+}
+
+func (ra *ReserveAuction) GetAutoExecute() bool {
+	return ra.Metadata.AutoExecute
+}
+
+func (ra *ReserveAuction) SetAutoExecute(autoExecute bool) {
+	ra.Metadata.AutoExecute = autoExecute
+}
+
 func (ra *ReserveAuction) HasBids() bool {
-	return len(ra.Metadata.Bids) > 0
+	return ra.Metadata.HasBids()
+}
+
+func (ra *ReserveAuction) NumBids() uint64 {
+	return ra.Metadata.NumBids
+}
+
+// GetLeadingBid returns the bid that would win the auction if it were settled now.
+func (ra *ReserveAuction) GetLeadingBid() *types.Bid {
+	return ra.Metadata.HighestBid
+}
+
+// IsClosed returns true once a bid has met the buy-now price.
+func (ra *ReserveAuction) IsClosed() bool {
+	bid := ra.Metadata.HighestBid
+	if bid == nil || !ra.Metadata.HasBuyNowPrice() || bid.BidPrice.Denom != ra.Metadata.BuyNowPrice.Denom {
+		return false
+	}
+	return bid.BidPrice.IsGTE(ra.Metadata.BuyNowPrice)
 }
 
 func (ra *ReserveAuction) IsExpired(blockTime time.Time) bool {
@@ -33,6 +75,10 @@ func (ra *ReserveAuction) SetOwner(owner sdk.AccAddress) {
 	ra.Owner = owner.String()
 }
 
+func (ra *ReserveAuction) SetDeposit(deposit sdk.Coins) {
+	ra.Deposit = deposit
+}
+
 func (ra *ReserveAuction) StartAuction(blockTime time.Time) {
 	end := blockTime.Add(ra.Metadata.Duration)
 
@@ -43,9 +89,8 @@ func (ra *ReserveAuction) StartAuction(blockTime time.Time) {
 
 // TODO: Implement logic to transfer funds
 func (ra *ReserveAuction) SubmitBid(blockTime time.Time, bidMsg *types.MsgNewBid) error {
-	// Validate bid price is over Reserve Price
-	if bidMsg.BidAmount.IsLT(ra.Metadata.ReservePrice) {
-		return fmt.Errorf("bid lower than reserve price :: %s", ra.Metadata.ReservePrice.String())
+	if ra.IsClosed() {
+		return fmt.Errorf("auction already closed :: %d", ra.Id)
 	}
 
 	// Validate auction is active
@@ -53,95 +98,155 @@ func (ra *ReserveAuction) SubmitBid(blockTime time.Time, bidMsg *types.MsgNewBid
 		return fmt.Errorf("expired auction :: %s", ra.String())
 	}
 
-	// Validate bid price is competitive
-	if len(ra.Metadata.Bids) > 0 && bidMsg.BidAmount.IsLTE(ra.Metadata.LastPrice) {
-		return fmt.Errorf("bid lower than latest price :: %s", ra.Metadata.LastPrice)
+	// Validate bid price meets the reserve price and the minimum increment
+	minBid := ra.NextMinimumBid()
+	if bidMsg.BidAmount.Denom != minBid.Denom {
+		return fmt.Errorf("invalid bid denom :: %s", bidMsg.BidAmount.Denom)
+	}
+	if bidMsg.BidAmount.IsLT(minBid) {
+		return fmt.Errorf("bid lower than minimum bid, next valid bid is %s", minBid)
+	}
+	// The buy-now price is the most a bidder can be charged
+	if ra.Metadata.HasBuyNowPrice() && ra.Metadata.BuyNowPrice.IsLT(bidMsg.BidAmount) {
+		return fmt.Errorf("bid higher than buy now price %s", ra.Metadata.BuyNowPrice)
 	}
 
-	ra.Metadata.Bids = append(ra.Metadata.Bids, &types.Bid{
+	ra.Metadata.HighestBid = &types.Bid{
 		AuctionId: bidMsg.AuctionId,
 		Bidder:    bidMsg.Owner,
 		BidPrice:  bidMsg.BidAmount,
 		Timestamp: blockTime,
-	})
+	}
+	ra.Metadata.NumBids++
 
 	ra.Metadata.LastPrice = bidMsg.BidAmount
-	return nil
-}
 
-// TODO: Implement safer logic to advance status
-func (ra *ReserveAuction) UpdateStatus(newStatus string) {
-	ra.Status = newStatus
-}
+	// A bid at the buy-now price closes the auction
+	if ra.IsClosed() {
+		ra.Metadata.EndTime = blockTime
+		return nil
+	}
 
-type Strategy interface {
-	UpdateBid(ctx context.Context, bid types.Bid, service types.EscrowService, keeper types.BankKeeper) error
-	ExecuteStrategy(context.Context, ReserveAuction, types.EscrowService, types.BankKeeper) error
+	// Extend the auction if the bid landed inside the extension window
+	if ra.inExtensionWindow(blockTime) {
+		ra.Metadata.EndTime = ra.Metadata.EndTime.Add(ra.Metadata.ExtensionDuration)
+		ra.Metadata.NumExtensions++
+	}
+	return nil
 }
 
-func NewSettleStrategy(ctx context.Context, es types.EscrowService, id uint64) (*SettleStrategy, error) {
-	contract, err := es.NewContract(ctx, id)
-	if err != nil {
-		return nil, fmt.Errorf("Unable to create escrow contract")
+// NextMinimumBid returns the lowest bid the auction will accept. It is the reserve price
+// until a bid has been placed, and then the last price plus the minimum increment. Without
+// a minimum increment, a bid must exceed the last price by one unit. A bid at the buy-now
+// price is always accepted.
+func (ra *ReserveAuction) NextMinimumBid() sdk.Coin {
+	md := ra.Metadata
+	if !md.HasBids() {
+		return md.ReservePrice
+	}
+
+	increment := sdkmath.OneInt()
+	switch {
+	case md.HasMinIncrement():
+		increment = md.MinIncrement.Amount
+	case md.MinIncrementBps > 0:
+		// Round up so the increment is never below the configured rate
+		increment = md.LastPrice.Amount.MulRaw(int64(md.MinIncrementBps)).AddRaw(MaxBasisPoints - 1).QuoRaw(MaxBasisPoints)
+		increment = sdkmath.MaxInt(increment, sdkmath.OneInt())
 	}
-	s := &SettleStrategy{
-		StrategyType:          types.SETTLE,
-		EscrowContractId:      contract.GetId(),
-		EscrowContractAddress: contract.GetAddress().String(),
+	minBid := md.LastPrice.AddAmount(increment)
+	if md.HasBuyNowPrice() && md.BuyNowPrice.IsLT(minBid) {
+		return md.BuyNowPrice
 	}
-	return s, nil
+	return minBid
 }
 
-func (s *SettleStrategy) ExecuteStrategy(ctx context.Context, auction *ReserveAuction, es types.EscrowService, bk types.BankKeeper) error {
-	// Select Winner
-	winningBid, err := s.GetWinner(auction)
-	if err != nil {
-		return err
+// inExtensionWindow returns true if a bid placed at blockTime extends the auction.
+func (ra *ReserveAuction) inExtensionWindow(blockTime time.Time) bool {
+	md := ra.Metadata
+	if md.ExtensionWindow <= 0 || md.ExtensionDuration <= 0 {
+		return false
 	}
-
-	bidder := sdk.MustAccAddressFromBech32(winningBid.Bidder)
-	auctioneer := sdk.MustAccAddressFromBech32(auction.Owner)
-
-	// Send bid amount to auction owner
-	err = bk.SendCoins(ctx, bidder, auctioneer, sdk.Coins{winningBid.BidPrice})
-	if err != nil {
-		return err
+	if md.MaxExtensions > 0 && md.NumExtensions >= md.MaxExtensions {
+		return false
 	}
+	return md.EndTime.Sub(blockTime) <= md.ExtensionWindow
+}
 
-	// Release escrowed auction bounty
-	escrowAddr := sdk.MustAccAddressFromBech32(s.EscrowContractAddress)
-	err = es.Release(ctx, s.EscrowContractId, escrowAddr, bidder)
-	if err != nil {
-		return err
-	}
-	return nil
+func (ra *ReserveAuction) UpdateStatus(newStatus types.AuctionStatus) {
+	ra.Status = newStatus
 }
 
-func (s *SettleStrategy) SubmitBid(ctx context.Context, bid *types.MsgNewBid, bk types.BankKeeper) error {
-	// Handle funds
-	bidder := sdk.MustAccAddressFromBech32(bid.GetOwner())
-	amt := bid.GetBidAmount()
-	escrowAddr := sdk.MustAccAddressFromBech32(s.EscrowContractAddress)
+// HasBids returns true if a bid has been accepted.
+func (m *ReserveAuctionMetadata) HasBids() bool {
+	return m.NumBids > 0
+}
 
-	// Send bid amount to escrow account
-	return bk.SendCoins(ctx, bidder, escrowAddr, sdk.Coins{amt})
+// HasMinIncrement returns true if bids must exceed the last price by an absolute amount.
+func (m *ReserveAuctionMetadata) HasMinIncrement() bool {
+	return !m.MinIncrement.Amount.IsNil() && !m.MinIncrement.IsZero()
 }
 
-func (s *SettleStrategy) GetWinner(auction *ReserveAuction) (*types.Bid, error) {
-	var highestBid *types.Bid
-	for _, b := range auction.Metadata.Bids {
-		if highestBid.GetBidPrice().IsNil() || b.GetBidPrice().IsGTE(highestBid.GetBidPrice()) {
-			highestBid = b
+// HasBuyNowPrice returns true if the auction can be won at a buy-now price.
+func (m *ReserveAuctionMetadata) HasBuyNowPrice() bool {
+	return !m.BuyNowPrice.Amount.IsNil() && !m.BuyNowPrice.IsZero()
+}
+
+// ValidateBasic checks that the settlement, bid increment, buy-now price and extension
+// settings are well formed.
+func (m *ReserveAuctionMetadata) ValidateBasic() error {
+	if m.StrategyType != "" && m.SettlementStrategy != nil {
+		return fmt.Errorf("strategy type and settlement strategy cannot both be set")
+	}
+	if m.HasMinIncrement() {
+		if m.MinIncrementBps > 0 {
+			return fmt.Errorf("min increment and min increment bps cannot both be set")
 		}
+		if !m.MinIncrement.IsValid() || m.MinIncrement.Denom != m.ReservePrice.Denom {
+			return fmt.Errorf("invalid min increment :: %s", m.MinIncrement.String())
+		}
+	}
+	if m.MinIncrementBps > MaxBasisPoints {
+		return fmt.Errorf("min increment bps %d exceeds %d", m.MinIncrementBps, MaxBasisPoints)
+	}
+	if m.HasBuyNowPrice() {
+		if !m.BuyNowPrice.IsValid() || m.BuyNowPrice.Denom != m.ReservePrice.Denom {
+			return fmt.Errorf("invalid buy now price :: %s", m.BuyNowPrice.String())
+		}
+		if m.BuyNowPrice.IsLT(m.ReservePrice) {
+			return fmt.Errorf("buy now price %s must not be lower than reserve price %s", m.BuyNowPrice, m.ReservePrice)
+		}
+	}
+	if m.ExtensionWindow < 0 {
+		return fmt.Errorf("invalid extension window :: %s", m.ExtensionWindow)
+	}
+	if m.ExtensionDuration < 0 {
+		return fmt.Errorf("invalid extension duration :: %s", m.ExtensionDuration)
 	}
+	if m.ExtensionWindow > 0 && m.ExtensionDuration == 0 {
+		return fmt.Errorf("extension duration required with extension window :: %s", m.ExtensionWindow)
+	}
+	return nil
+}
 
-	return highestBid, nil
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (ra *ReserveAuction) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if ra.Metadata == nil {
+		return nil
+	}
+	return ra.Metadata.UnpackInterfaces(unpacker)
 }
 
-func (s *SettleStrategy) ToProto() *SettleStrategy {
-	return &SettleStrategy{
-		StrategyType:          s.GetStrategyType(),
-		EscrowContractId:      s.GetEscrowContractId(),
-		EscrowContractAddress: s.GetEscrowContractAddress(),
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *ReserveAuctionMetadata) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if m.HighestBid != nil {
+		if err := m.HighestBid.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	if m.SettlementStrategy == nil {
+		return nil
 	}
+	var s types.Strategy
+	return unpacker.UnpackAny(m.SettlementStrategy, &s)
 }
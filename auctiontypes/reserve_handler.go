@@ -3,6 +3,7 @@ package auctiontypes
 import (
 	"context"
 	"fmt"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/fatal-fruit/auction/types"
@@ -13,12 +14,14 @@ var _ types.AuctionHandler = &ReserveAuctionHandler{}
 type ReserveAuctionHandler struct {
 	es types.EscrowService
 	bk types.BankKeeper
+	sr types.StrategyResolver
 }
 
-func NewReserveAuctionHandler(es types.EscrowService, bk types.BankKeeper) *ReserveAuctionHandler {
+func NewReserveAuctionHandler(es types.EscrowService, bk types.BankKeeper, sr types.StrategyResolver) *ReserveAuctionHandler {
 	return &ReserveAuctionHandler{
 		bk: bk,
 		es: es,
+		sr: sr,
 	}
 }
 
@@ -30,65 +33,177 @@ func (ah *ReserveAuctionHandler) CreateAuction(ctx context.Context, id uint64, a
 
 	a := &ReserveAuction{
 		Id:          id,
-		Status:      types.ACTIVE,
+		Status:      types.CREATED,
 		AuctionType: sdk.MsgTypeURL(&ReserveAuction{}),
-		Metadata: &ReserveAuctionMetadata{
-			Bids: []*types.Bid{},
-		},
+		Metadata:    &ReserveAuctionMetadata{},
 	}
 
+	var selected *codectypes.Any
 	switch m := am.(type) {
 	case *ReserveAuctionMetadata:
+		if err := m.ValidateBasic(); err != nil {
+			return &ReserveAuction{}, err
+		}
 		a.Metadata.Duration = m.Duration
 		a.Metadata.ReservePrice = m.ReservePrice
+		a.Metadata.RefundOnOutbid = m.RefundOnOutbid
+		a.Metadata.StrategyType = m.StrategyType
+		a.Metadata.ExtensionWindow = m.ExtensionWindow
+		a.Metadata.ExtensionDuration = m.ExtensionDuration
+		a.Metadata.MaxExtensions = m.MaxExtensions
+		a.Metadata.BuyNowPrice = m.BuyNowPrice
+		a.Metadata.MinIncrement = m.MinIncrement
+		a.Metadata.MinIncrementBps = m.MinIncrementBps
+		a.Metadata.AutoExecute = m.AutoExecute
+		selected = m.SettlementStrategy
 	default:
 		return &ReserveAuction{}, fmt.Errorf("invalid auction metadata :: %s", m.String())
 	}
 
-	strategy, err := NewSettleStrategy(ctx, ah.es, id)
+	strategy, err := InitStrategy(ctx, ah.sr, id, selected, &SettleStrategy{StrategyType: a.Metadata.StrategyType})
 	if err != nil {
-		return &ReserveAuction{}, fmt.Errorf("error creating escrow contract for auction id :: %d", id)
+		return &ReserveAuction{}, fmt.Errorf("error creating settlement strategy for auction id %d: %w", id, err)
 	}
-	a.Metadata.Strategy = strategy.ToProto()
+	a.Metadata.SettlementStrategy = strategy
 
 	return a, nil
 }
 
 func (ah *ReserveAuctionHandler) SubmitBid(ctx context.Context, auction types.Auction, bidMsg *types.MsgNewBid) (types.Auction, error) {
-	// Update auction with bid logic
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	ra, ok := auction.(*ReserveAuction)
+	if !ok {
+		return nil, fmt.Errorf("invalid auction metadata type")
+	}
+
+	// Capture the current leader before the new bid is accepted
+	s := GetStrategy(ra.Metadata.SettlementStrategy)
+	if s == nil {
+		return nil, fmt.Errorf("missing settlement strategy for auction :: %d", ra.Id)
+	}
+	leading := ra.GetLeadingBid()
+	extensions := ra.Metadata.NumExtensions
+
+	// Update auction with bid logic
 	err := auction.SubmitBid(sdkCtx.BlockTime(), bidMsg)
 	if err != nil {
-		return nil, fmt.Errorf("error submitting bid from auction handler")
+		return nil, fmt.Errorf("error submitting bid from auction handler: %w", err)
 	}
 
-	am := auction.GetAuctionMetadata()
-	switch resMd := am.(type) {
-	case *ReserveAuctionMetadata:
-		// Send bid amount to escrow contract
-		s := resMd.GetStrategy()
-		err = s.SubmitBid(ctx, bidMsg, ah.bk)
+	if ra.IsClosed() {
+		err = sdkCtx.EventManager().EmitTypedEvent(&types.EventAuctionBoughtNow{
+			AuctionId:   ra.Id,
+			AuctionType: ra.AuctionType,
+			Owner:       ra.Owner,
+			Buyer:       bidMsg.Owner,
+			Price:       bidMsg.BidAmount,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if ra.Metadata.NumExtensions > extensions {
+		err = sdkCtx.EventManager().EmitTypedEvent(&types.EventAuctionExtended{
+			AuctionId:     ra.Id,
+			EndTime:       ra.Metadata.EndTime,
+			NumExtensions: ra.Metadata.NumExtensions,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Send bid amount to escrow contract
+	err = escrowBid(ctx, s, bidMsg.Owner, bidMsg.BidAmount, ah.bk)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the outbid bidder's funds right away
+	if ra.Metadata.RefundOnOutbid && leading != nil {
+		err = refund(ctx, s, ra, leading.Bidder, leading.BidPrice, ah.bk)
+		if err != nil {
+			return nil, err
+		}
+
+		err = sdkCtx.EventManager().EmitTypedEvent(&types.EventOutbid{
+			AuctionId: ra.Id,
+			Bidder:    leading.Bidder,
+			Refund:    leading.BidPrice,
+			NewBid:    bidMsg.BidAmount,
+		})
 		if err != nil {
 			return nil, err
 		}
-	default:
-		return nil, fmt.Errorf("invalid auction metadata type")
 	}
 
 	return auction, nil
 }
 
-func (ah *ReserveAuctionHandler) ExecAuction(ctx context.Context, auction types.Auction) error {
-	switch a := auction.(type) {
-	case *ReserveAuction:
-		es := a.Metadata.GetStrategy()
-		err := es.ExecuteStrategy(ctx, a, ah.es, ah.bk)
+// ExecAuction settles the auction with its settlement strategy, refunds the part of the
+// winning bid above the settlement price and every losing bid, and delivers the deposit
+// to the winner.
+func (ah *ReserveAuctionHandler) ExecAuction(ctx context.Context, auction types.Auction, bids []*types.Bid) error {
+	a, ok := auction.(*ReserveAuction)
+	if !ok {
+		return fmt.Errorf("invalid auction metadata")
+	}
+
+	winningBid := a.GetLeadingBid()
+	if winningBid == nil {
+		return fmt.Errorf("no winning bid for auction :: %d", a.Id)
+	}
+
+	price, err := settle(ctx, ah.sr, a, a.Metadata.SettlementStrategy, winningBid, bids, a.Metadata.ReservePrice)
+	if err != nil {
+		return err
+	}
+
+	// Return the part of the winning bid above the settlement price
+	s := GetStrategy(a.Metadata.SettlementStrategy)
+	if price.IsLT(winningBid.BidPrice) {
+		err = refund(ctx, s, a, winningBid.Bidder, winningBid.BidPrice.Sub(price), ah.bk)
 		if err != nil {
 			return err
 		}
-	default:
-		return fmt.Errorf("invalid auction metadata")
 	}
 
+	// Return all other bids from escrow to their bidders, unless they were refunded when outbid
+	if !a.Metadata.RefundOnOutbid {
+		for _, b := range bids {
+			// Accepted bids strictly increase, so only the winning bid has the winning price
+			if b.GetBidPrice().IsEqual(winningBid.GetBidPrice()) {
+				continue
+			}
+			err = refund(ctx, s, a, b.Bidder, b.BidPrice, ah.bk)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// Deliver the auctioned deposit to the winner
+	if !a.Deposit.IsZero() {
+		winner := sdk.MustAccAddressFromBech32(winningBid.Bidder)
+		return ah.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, winner, a.Deposit)
+	}
 	return nil
 }
+
+// CancelAuction returns every bid still held in escrow and releases the rest of the escrow
+// to the auction owner. Only the leading bid is held if outbid bidders were already refunded.
+func (ah *ReserveAuctionHandler) CancelAuction(ctx context.Context, auction types.Auction, bids []*types.Bid) error {
+	a, ok := auction.(*ReserveAuction)
+	if !ok {
+		return fmt.Errorf("invalid auction metadata")
+	}
+
+	return cancelEscrow(ctx, ah.es, ah.bk, a, a.Metadata.SettlementStrategy, bids, func(b *types.Bid) sdk.Coin {
+		// Accepted bids strictly increase, so only the leading bid has the leading price
+		if a.Metadata.RefundOnOutbid && !b.GetBidPrice().IsEqual(a.GetLeadingBid().GetBidPrice()) {
+			return sdk.Coin{}
+		}
+		return b.BidPrice
+	})
+}
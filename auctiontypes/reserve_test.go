@@ -0,0 +1,246 @@
+package auctiontypes_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	at "github.com/fatal-fruit/auction/auctiontypes"
+	auctiontypes "github.com/fatal-fruit/auction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSettlementPrice(t *testing.T) {
+	reserve := sdk.NewInt64Coin("stake", 1000)
+	newBid := func(bidder string, amount int64) *auctiontypes.Bid {
+		return &auctiontypes.Bid{Bidder: bidder, BidPrice: sdk.NewInt64Coin("stake", amount)}
+	}
+	winning := newBid("winner", 1500)
+
+	testCases := []struct {
+		name         string
+		strategyType string
+		bids         []*auctiontypes.Bid
+		expPrice     int64
+	}{
+		{
+			name:         "settle charges the winning bid",
+			strategyType: auctiontypes.SETTLE,
+			bids:         []*auctiontypes.Bid{newBid("a", 1200), winning},
+			expPrice:     1500,
+		},
+		{
+			name:         "second price charges the highest competing bid",
+			strategyType: auctiontypes.SECOND_PRICE,
+			bids:         []*auctiontypes.Bid{newBid("a", 1100), newBid("b", 1300), newBid("a", 1200), winning},
+			expPrice:     1300,
+		},
+		{
+			name:         "second price ignores the winner's own bids",
+			strategyType: auctiontypes.SECOND_PRICE,
+			bids:         []*auctiontypes.Bid{newBid("a", 1100), newBid("winner", 1400), winning},
+			expPrice:     1100,
+		},
+		{
+			name:         "second price with a single bid charges the reserve price",
+			strategyType: auctiontypes.SECOND_PRICE,
+			bids:         []*auctiontypes.Bid{winning},
+			expPrice:     1000,
+		},
+		{
+			name:         "second price ignores bids without a price",
+			strategyType: auctiontypes.SECOND_PRICE,
+			bids:         []*auctiontypes.Bid{{Bidder: "sealed"}, winning},
+			expPrice:     1000,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			s := &at.SettleStrategy{StrategyType: tc.strategyType}
+			price := s.SettlementPrice(winning, tc.bids, reserve)
+			require.Equal(t, sdk.NewInt64Coin("stake", tc.expPrice), price)
+		})
+	}
+}
+
+func TestValidateStrategyType(t *testing.T) {
+	require.NoError(t, at.ValidateStrategyType(auctiontypes.SETTLE))
+	require.NoError(t, at.ValidateStrategyType(auctiontypes.SECOND_PRICE))
+	require.Error(t, at.ValidateStrategyType(""))
+	require.Error(t, at.ValidateStrategyType("FIRST_PRICE"))
+}
+
+func TestReserveAuctionExtension(t *testing.T) {
+	end := time.Now().UTC()
+	newAuction := func(maxExtensions, numExtensions uint32) *at.ReserveAuction {
+		return &at.ReserveAuction{
+			Metadata: &at.ReserveAuctionMetadata{
+				ReservePrice:      sdk.NewInt64Coin("stake", 1000),
+				EndTime:           end,
+				ExtensionWindow:   5 * time.Minute,
+				ExtensionDuration: 2 * time.Minute,
+				MaxExtensions:     maxExtensions,
+				NumExtensions:     numExtensions,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		auction       *at.ReserveAuction
+		bidTime       time.Time
+		expEndTime    time.Time
+		expExtensions uint32
+	}{
+		{
+			name:       "bid before window",
+			auction:    newAuction(0, 0),
+			bidTime:    end.Add(-6 * time.Minute),
+			expEndTime: end,
+		},
+		{
+			name:          "bid inside window",
+			auction:       newAuction(0, 0),
+			bidTime:       end.Add(-1 * time.Minute),
+			expEndTime:    end.Add(2 * time.Minute),
+			expExtensions: 1,
+		},
+		{
+			name:          "bid at window start",
+			auction:       newAuction(0, 0),
+			bidTime:       end.Add(-5 * time.Minute),
+			expEndTime:    end.Add(2 * time.Minute),
+			expExtensions: 1,
+		},
+		{
+			name:          "unlimited extensions",
+			auction:       newAuction(0, 10),
+			bidTime:       end.Add(-1 * time.Minute),
+			expEndTime:    end.Add(2 * time.Minute),
+			expExtensions: 11,
+		},
+		{
+			name:          "extension cap reached",
+			auction:       newAuction(2, 2),
+			bidTime:       end.Add(-1 * time.Minute),
+			expEndTime:    end,
+			expExtensions: 2,
+		},
+		{
+			name: "extensions disabled",
+			auction: &at.ReserveAuction{Metadata: &at.ReserveAuctionMetadata{
+				ReservePrice: sdk.NewInt64Coin("stake", 1000),
+				EndTime:      end,
+			}},
+			bidTime:    end.Add(-1 * time.Second),
+			expEndTime: end,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.auction.SubmitBid(tc.bidTime, &auctiontypes.MsgNewBid{
+				Owner:     "bidder",
+				BidAmount: sdk.NewInt64Coin("stake", 1100),
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.expEndTime, tc.auction.Metadata.EndTime)
+			require.Equal(t, tc.expExtensions, tc.auction.Metadata.NumExtensions)
+		})
+	}
+}
+
+func TestReserveAuctionMetadataValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		md     *at.ReserveAuctionMetadata
+		expErr string
+	}{
+		{"extensions disabled", &at.ReserveAuctionMetadata{}, ""},
+		{"valid extension", &at.ReserveAuctionMetadata{ExtensionWindow: time.Minute, ExtensionDuration: time.Minute, MaxExtensions: 3}, ""},
+		{"negative window", &at.ReserveAuctionMetadata{ExtensionWindow: -time.Minute, ExtensionDuration: time.Minute}, "invalid extension window"},
+		{"negative duration", &at.ReserveAuctionMetadata{ExtensionWindow: time.Minute, ExtensionDuration: -time.Minute}, "invalid extension duration"},
+		{"window without duration", &at.ReserveAuctionMetadata{ExtensionWindow: time.Minute}, "extension duration required with extension window"},
+		{"valid min increment", &at.ReserveAuctionMetadata{ReservePrice: sdk.NewInt64Coin("stake", 1000), MinIncrement: sdk.NewInt64Coin("stake", 50)}, ""},
+		{"valid min increment bps", &at.ReserveAuctionMetadata{ReservePrice: sdk.NewInt64Coin("stake", 1000), MinIncrementBps: 500}, ""},
+		{"min increment denom mismatch", &at.ReserveAuctionMetadata{ReservePrice: sdk.NewInt64Coin("stake", 1000), MinIncrement: sdk.NewInt64Coin("atom", 50)}, "invalid min increment"},
+		{"min increment and bps", &at.ReserveAuctionMetadata{ReservePrice: sdk.NewInt64Coin("stake", 1000), MinIncrement: sdk.NewInt64Coin("stake", 50), MinIncrementBps: 500}, "min increment and min increment bps cannot both be set"},
+		{"min increment bps above 100%", &at.ReserveAuctionMetadata{ReservePrice: sdk.NewInt64Coin("stake", 1000), MinIncrementBps: 10001}, "min increment bps 10001 exceeds 10000"},
+		{"valid buy now price", &at.ReserveAuctionMetadata{ReservePrice: sdk.NewInt64Coin("stake", 1000), BuyNowPrice: sdk.NewInt64Coin("stake", 5000)}, ""},
+		{"buy now price below reserve", &at.ReserveAuctionMetadata{ReservePrice: sdk.NewInt64Coin("stake", 1000), BuyNowPrice: sdk.NewInt64Coin("stake", 500)}, "buy now price 500stake must not be lower than reserve price 1000stake"},
+		{"buy now price denom mismatch", &at.ReserveAuctionMetadata{ReservePrice: sdk.NewInt64Coin("stake", 1000), BuyNowPrice: sdk.NewInt64Coin("atom", 5000)}, "invalid buy now price"},
+		{"strategy type only", &at.ReserveAuctionMetadata{StrategyType: auctiontypes.SECOND_PRICE}, ""},
+		{"settlement strategy only", &at.ReserveAuctionMetadata{SettlementStrategy: &codectypes.Any{}}, ""},
+		{"strategy type and settlement strategy", &at.ReserveAuctionMetadata{StrategyType: auctiontypes.SECOND_PRICE, SettlementStrategy: &codectypes.Any{}}, "strategy type and settlement strategy cannot both be set"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.md.ValidateBasic()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNextMinimumBid(t *testing.T) {
+	newAuction := func(numBids uint64, lastPrice int64, increment sdk.Coin, bps uint32) *at.ReserveAuction {
+		return &at.ReserveAuction{
+			Metadata: &at.ReserveAuctionMetadata{
+				ReservePrice:    sdk.NewInt64Coin("stake", 1000),
+				EndTime:         time.Now().Add(time.Hour),
+				LastPrice:       sdk.NewInt64Coin("stake", lastPrice),
+				NumBids:         numBids,
+				MinIncrement:    increment,
+				MinIncrementBps: bps,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		auction *at.ReserveAuction
+		expMin  int64
+	}{
+		{"reserve price before first bid", newAuction(0, 0, sdk.NewInt64Coin("stake", 50), 0), 1000},
+		{"one unit without increment", newAuction(1, 1200, sdk.Coin{}, 0), 1201},
+		{"absolute increment", newAuction(1, 1200, sdk.NewInt64Coin("stake", 50), 0), 1250},
+		{"basis points increment", newAuction(1, 1200, sdk.Coin{}, 500), 1260},
+		{"basis points increment rounds up", newAuction(1, 1210, sdk.Coin{}, 500), 1271},
+		{"basis points increment is at least one unit", newAuction(1, 10, sdk.Coin{}, 1), 11},
+		{"capped at buy now price", func() *at.ReserveAuction {
+			a := newAuction(1, 4990, sdk.NewInt64Coin("stake", 50), 0)
+			a.Metadata.BuyNowPrice = sdk.NewInt64Coin("stake", 5000)
+			return a
+		}(), 5000},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			minBid := tc.auction.NextMinimumBid()
+			require.Equal(t, sdk.NewInt64Coin("stake", tc.expMin), minBid)
+
+			// Bids below the minimum are rejected with the next valid bid
+			err := tc.auction.SubmitBid(time.Now(), &auctiontypes.MsgNewBid{
+				Owner:     "bidder",
+				BidAmount: minBid.SubAmount(sdkmath.OneInt()),
+			})
+			require.ErrorContains(t, err, minBid.String())
+
+			require.NoError(t, tc.auction.SubmitBid(time.Now(), &auctiontypes.MsgNewBid{
+				Owner:     "bidder",
+				BidAmount: minBid,
+			}))
+		})
+	}
+}
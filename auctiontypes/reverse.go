@@ -0,0 +1,147 @@
+package auctiontypes
+
+import (
+	"fmt"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/fatal-fruit/auction/types"
+)
+
+var (
+	_ types.Auction         = &ReverseAuction{}
+	_ types.AuctionMetadata = &ReverseAuctionMetadata{}
+	_ types.DepositAuction  = &ReverseAuction{}
+
+	_ codectypes.UnpackInterfacesMessage = &ReverseAuction{}
+)
+
+func (ra *ReverseAuction) GetType() string {
+	return ra.AuctionType
+}
+
+func (ra *ReverseAuction) GetAuctionMetadata() types.AuctionMetadata {
+	return ra.GetMetadata()
+}
+
+func (ra *ReverseAuction) GetDuration() time.Duration {
+	return ra.Metadata.Duration
+}
+
+func (ra *ReverseAuction) GetEndTime() time.Time {
+	return ra.Metadata.EndTime
+}
+
+func (ra *ReverseAuction) GetAutoExecute() bool {
+	return ra.Metadata.AutoExecute
+}
+
+func (ra *ReverseAuction) SetAutoExecute(autoExecute bool) {
+	ra.Metadata.AutoExecute = autoExecute
+}
+
+func (ra *ReverseAuction) HasBids() bool {
+	return ra.Metadata.NumBids > 0
+}
+
+func (ra *ReverseAuction) NumBids() uint64 {
+	return ra.Metadata.NumBids
+}
+
+// GetLeadingBid returns the lowest bid.
+func (ra *ReverseAuction) GetLeadingBid() *types.Bid {
+	return ra.Metadata.LowestBid
+}
+
+func (ra *ReverseAuction) IsExpired(blockTime time.Time) bool {
+	return ra.Metadata.EndTime.Before(blockTime)
+}
+
+func (ra *ReverseAuction) SetOwner(owner sdk.AccAddress) {
+	ra.Owner = owner.String()
+}
+
+func (ra *ReverseAuction) SetDeposit(deposit sdk.Coins) {
+	ra.Deposit = deposit
+}
+
+// ValidateDeposit checks that the budget can pay any accepted bid.
+func (ra *ReverseAuction) ValidateDeposit(deposit sdk.Coins) error {
+	ceiling := ra.Metadata.CeilingPrice
+	if len(deposit) != 1 || deposit[0].Denom != ceiling.Denom {
+		return fmt.Errorf("reverse auction budget must be a single coin in %s :: %s", ceiling.Denom, deposit)
+	}
+	if deposit[0].IsLT(ceiling) {
+		return fmt.Errorf("budget %s lower than ceiling price %s", deposit, ceiling)
+	}
+	return nil
+}
+
+func (ra *ReverseAuction) StartAuction(blockTime time.Time) {
+	ra.Metadata.StartTime = blockTime
+	ra.Metadata.EndTime = blockTime.Add(ra.Metadata.Duration)
+}
+
+// SubmitBid accepts a bid strictly below the ceiling price and the lowest bid so far.
+func (ra *ReverseAuction) SubmitBid(blockTime time.Time, bidMsg *types.MsgNewBid) error {
+	// Validate auction is active
+	if blockTime.Before(ra.Metadata.StartTime) || blockTime.After(ra.Metadata.EndTime) {
+		return fmt.Errorf("auction not accepting bids :: %d", ra.Id)
+	}
+
+	if bidMsg.BidAmount.Denom != ra.Metadata.CeilingPrice.Denom || !bidMsg.BidAmount.IsPositive() {
+		return fmt.Errorf("invalid bid :: %s", bidMsg.BidAmount)
+	}
+	if !bidMsg.BidAmount.IsLT(ra.Metadata.CeilingPrice) {
+		return fmt.Errorf("bid not lower than ceiling price :: %s", ra.Metadata.CeilingPrice)
+	}
+
+	// Validate bid price is competitive
+	if ra.Metadata.LowestBid != nil && !bidMsg.BidAmount.IsLT(ra.Metadata.LowestBid.BidPrice) {
+		return fmt.Errorf("bid not lower than best price :: %s", ra.Metadata.LowestBid.BidPrice)
+	}
+
+	ra.Metadata.LowestBid = &types.Bid{
+		AuctionId: bidMsg.AuctionId,
+		Bidder:    bidMsg.Owner,
+		BidPrice:  bidMsg.BidAmount,
+		Timestamp: blockTime,
+	}
+	ra.Metadata.NumBids++
+	return nil
+}
+
+func (ra *ReverseAuction) UpdateStatus(newStatus types.AuctionStatus) {
+	ra.Status = newStatus
+}
+
+// ValidateBasic checks that the ceiling price is well formed.
+func (m *ReverseAuctionMetadata) ValidateBasic() error {
+	if !m.CeilingPrice.IsValid() || !m.CeilingPrice.IsPositive() {
+		return fmt.Errorf("invalid ceiling price :: %s", m.CeilingPrice.String())
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (ra *ReverseAuction) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if ra.Metadata == nil {
+		return nil
+	}
+	return ra.Metadata.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *ReverseAuctionMetadata) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if m.LowestBid != nil {
+		if err := m.LowestBid.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	if m.SettlementStrategy == nil {
+		return nil
+	}
+	var s types.Strategy
+	return unpacker.UnpackAny(m.SettlementStrategy, &s)
+}
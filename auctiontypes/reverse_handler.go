@@ -0,0 +1,107 @@
+package auctiontypes
+
+import (
+	"context"
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/fatal-fruit/auction/types"
+)
+
+var _ types.AuctionHandler = &ReverseAuctionHandler{}
+
+type ReverseAuctionHandler struct {
+	bk types.BankKeeper
+	sr types.StrategyResolver
+}
+
+func NewReverseAuctionHandler(bk types.BankKeeper, sr types.StrategyResolver) *ReverseAuctionHandler {
+	return &ReverseAuctionHandler{
+		bk: bk,
+		sr: sr,
+	}
+}
+
+func (ah *ReverseAuctionHandler) CreateAuction(ctx context.Context, id uint64, am types.AuctionMetadata) (types.Auction, error) {
+	md, ok := am.(proto.Message)
+	if !ok {
+		return &ReverseAuction{}, fmt.Errorf("%T does not implement proto.Message", md)
+	}
+
+	a := &ReverseAuction{
+		Id:          id,
+		Status:      types.CREATED,
+		AuctionType: sdk.MsgTypeURL(&ReverseAuction{}),
+		Metadata:    &ReverseAuctionMetadata{},
+	}
+
+	var selected *codectypes.Any
+	switch m := am.(type) {
+	case *ReverseAuctionMetadata:
+		if err := m.ValidateBasic(); err != nil {
+			return &ReverseAuction{}, err
+		}
+		a.Metadata.Duration = m.Duration
+		a.Metadata.CeilingPrice = m.CeilingPrice
+		a.Metadata.AutoExecute = m.AutoExecute
+		selected = m.SettlementStrategy
+	default:
+		return &ReverseAuction{}, fmt.Errorf("invalid auction metadata :: %s", m.String())
+	}
+
+	strategy, err := InitStrategy(ctx, ah.sr, id, selected, &ProcurementStrategy{})
+	if err != nil {
+		return &ReverseAuction{}, fmt.Errorf("error creating settlement strategy for auction id %d: %w", id, err)
+	}
+	// The winner is paid from the budget by the procurement strategy
+	if _, ok := GetStrategy(strategy).(*ProcurementStrategy); !ok {
+		return &ReverseAuction{}, fmt.Errorf("invalid settlement strategy for reverse auction :: %s", strategy.GetTypeUrl())
+	}
+	a.Metadata.SettlementStrategy = strategy
+
+	return a, nil
+}
+
+// SubmitBid records a provider's offer. Offers are paid from the owner's budget, so no
+// funds are escrowed with the bid.
+func (ah *ReverseAuctionHandler) SubmitBid(ctx context.Context, auction types.Auction, bidMsg *types.MsgNewBid) (types.Auction, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	ra, ok := auction.(*ReverseAuction)
+	if !ok {
+		return nil, fmt.Errorf("invalid auction metadata type")
+	}
+
+	err := ra.SubmitBid(sdkCtx.BlockTime(), bidMsg)
+	if err != nil {
+		return nil, fmt.Errorf("error submitting bid from auction handler: %w", err)
+	}
+
+	return ra, nil
+}
+
+// ExecAuction settles the lowest bid with the auction's settlement strategy.
+func (ah *ReverseAuctionHandler) ExecAuction(ctx context.Context, auction types.Auction, bids []*types.Bid) error {
+	ra, ok := auction.(*ReverseAuction)
+	if !ok {
+		return fmt.Errorf("invalid auction metadata")
+	}
+
+	winningBid := ra.GetLeadingBid()
+	if winningBid == nil {
+		return fmt.Errorf("no winning bid for auction :: %d", ra.Id)
+	}
+
+	_, err := settle(ctx, ah.sr, ra, ra.Metadata.SettlementStrategy, winningBid, bids, ra.Metadata.CeilingPrice)
+	return err
+}
+
+// CancelAuction has nothing to return. Offers escrow no funds and the budget is the auction
+// deposit, which is refunded to the owner with the deposit of every cancelled auction.
+func (ah *ReverseAuctionHandler) CancelAuction(_ context.Context, auction types.Auction, _ []*types.Bid) error {
+	if _, ok := auction.(*ReverseAuction); !ok {
+		return fmt.Errorf("invalid auction metadata")
+	}
+	return nil
+}
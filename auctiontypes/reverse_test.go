@@ -0,0 +1,79 @@
+package auctiontypes_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	at "github.com/fatal-fruit/auction/auctiontypes"
+	auctiontypes "github.com/fatal-fruit/auction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReverseAuctionSubmitBid(t *testing.T) {
+	start := time.Now()
+	newMsg := func(price int64) *auctiontypes.MsgNewBid {
+		return &auctiontypes.MsgNewBid{
+			Owner:     "provider",
+			BidAmount: sdk.NewInt64Coin("stake", price),
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		lowestBid *auctiontypes.Bid
+		msg       *auctiontypes.MsgNewBid
+		bidTime   time.Time
+		expErr    bool
+	}{
+		{"first bid below ceiling", nil, newMsg(90), start, false},
+		{"first bid at ceiling", nil, newMsg(100), start, true},
+		{"first bid above ceiling", nil, newMsg(110), start, true},
+		{"bid below best price", &auctiontypes.Bid{BidPrice: sdk.NewInt64Coin("stake", 80)}, newMsg(79), start, false},
+		{"bid at best price", &auctiontypes.Bid{BidPrice: sdk.NewInt64Coin("stake", 80)}, newMsg(80), start, true},
+		{"bid above best price", &auctiontypes.Bid{BidPrice: sdk.NewInt64Coin("stake", 80)}, newMsg(85), start, true},
+		{"zero bid", nil, newMsg(0), start, true},
+		{"invalid denom", nil, &auctiontypes.MsgNewBid{Owner: "provider", BidAmount: sdk.NewInt64Coin("atom", 90)}, start, true},
+		{"auction ended", nil, newMsg(90), start.Add(2 * time.Minute), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			numBids := uint64(0)
+			if tc.lowestBid != nil {
+				numBids = 1
+			}
+			ra := &at.ReverseAuction{
+				Metadata: &at.ReverseAuctionMetadata{
+					CeilingPrice: sdk.NewInt64Coin("stake", 100),
+					StartTime:    start,
+					EndTime:      start.Add(time.Minute),
+					LowestBid:    tc.lowestBid,
+					NumBids:      numBids,
+				},
+			}
+
+			err := ra.SubmitBid(tc.bidTime, tc.msg)
+			if tc.expErr {
+				require.Error(t, err)
+				require.Equal(t, tc.lowestBid, ra.GetLeadingBid())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, numBids+1, ra.NumBids())
+			require.Equal(t, tc.msg.BidAmount, ra.GetLeadingBid().BidPrice)
+		})
+	}
+}
+
+func TestReverseAuctionValidateDeposit(t *testing.T) {
+	ra := &at.ReverseAuction{
+		Metadata: &at.ReverseAuctionMetadata{CeilingPrice: sdk.NewInt64Coin("stake", 100)},
+	}
+	require.NoError(t, ra.ValidateDeposit(sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	require.NoError(t, ra.ValidateDeposit(sdk.NewCoins(sdk.NewInt64Coin("stake", 150))))
+	require.Error(t, ra.ValidateDeposit(sdk.NewCoins(sdk.NewInt64Coin("stake", 99))))
+	require.Error(t, ra.ValidateDeposit(sdk.NewCoins(sdk.NewInt64Coin("atom", 100))))
+	require.Error(t, ra.ValidateDeposit(sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("atom", 1))))
+}
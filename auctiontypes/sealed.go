@@ -0,0 +1,238 @@
+package auctiontypes
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/fatal-fruit/auction/types"
+)
+
+var (
+	_ types.Auction          = &SealedBidAuction{}
+	_ types.AuctionMetadata  = &SealedBidAuctionMetadata{}
+	_ types.RevealingAuction = &SealedBidAuction{}
+	_ types.BidMetadata      = &SealedBid{}
+
+	_ codectypes.UnpackInterfacesMessage = &SealedBidAuction{}
+)
+
+// SealedBidCommitment returns the commitment a bidder submits with a sealed bid: the sha256
+// hash of the auction id, the bidder address, the bid amount and a secret salt.
+func SealedBidCommitment(auctionId uint64, bidder sdk.AccAddress, amount sdk.Coin, salt []byte) []byte {
+	h := sha256.New()
+	h.Write(sdk.Uint64ToBigEndian(auctionId))
+	h.Write(bidder)
+	h.Write([]byte(amount.String()))
+	h.Write(salt)
+	return h.Sum(nil)
+}
+
+// GetSealedBid returns the sealed bid metadata packed in a bid, or nil if it has none.
+func GetSealedBid(data *codectypes.Any) *SealedBid {
+	if data == nil {
+		return nil
+	}
+	sb, _ := data.GetCachedValue().(*SealedBid)
+	return sb
+}
+
+func (sa *SealedBidAuction) GetType() string {
+	return sa.AuctionType
+}
+
+func (sa *SealedBidAuction) GetAuctionMetadata() types.AuctionMetadata {
+	return sa.GetMetadata()
+}
+
+func (sa *SealedBidAuction) GetDuration() time.Duration {
+	return sa.Metadata.Duration
+}
+
+// GetEndTime returns the end of the bidding phase.
+func (sa *SealedBidAuction) GetEndTime() time.Time {
+	return sa.Metadata.EndTime
+}
+
+func (sa *SealedBidAuction) GetAutoExecute() bool {
+	return sa.Metadata.AutoExecute
+}
+
+func (sa *SealedBidAuction) SetAutoExecute(autoExecute bool) {
+	sa.Metadata.AutoExecute = autoExecute
+}
+
+func (sa *SealedBidAuction) HasBids() bool {
+	return sa.Metadata.NumBids > 0
+}
+
+func (sa *SealedBidAuction) NumBids() uint64 {
+	return sa.Metadata.NumBids
+}
+
+// GetLeadingBid returns the highest revealed bid.
+func (sa *SealedBidAuction) GetLeadingBid() *types.Bid {
+	return sa.Metadata.HighestBid
+}
+
+// IsBiddingClosed returns true once the bidding phase has ended and the reveal phase started.
+func (sa *SealedBidAuction) IsBiddingClosed(blockTime time.Time) bool {
+	return sa.Metadata.EndTime.Before(blockTime)
+}
+
+// IsExpired returns true once the reveal phase has ended.
+func (sa *SealedBidAuction) IsExpired(blockTime time.Time) bool {
+	return sa.Metadata.RevealEndTime.Before(blockTime)
+}
+
+func (sa *SealedBidAuction) SetOwner(owner sdk.AccAddress) {
+	sa.Owner = owner.String()
+}
+
+func (sa *SealedBidAuction) SetDeposit(deposit sdk.Coins) {
+	sa.Deposit = deposit
+}
+
+func (sa *SealedBidAuction) StartAuction(blockTime time.Time) {
+	end := blockTime.Add(sa.Metadata.Duration)
+
+	sa.Metadata.StartTime = blockTime
+	sa.Metadata.EndTime = end
+	sa.Metadata.RevealEndTime = end.Add(sa.Metadata.RevealDuration)
+}
+
+// SubmitBid accepts a sealed bid during the bidding phase. The bid amount is committed to
+// in the bid metadata and only disclosed when the bid is revealed.
+func (sa *SealedBidAuction) SubmitBid(blockTime time.Time, bidMsg *types.MsgNewBid) error {
+	// Validate auction is in its bidding phase
+	if blockTime.Before(sa.Metadata.StartTime) || blockTime.After(sa.Metadata.EndTime) {
+		return fmt.Errorf("auction not accepting bids :: %d", sa.Id)
+	}
+
+	if !bidMsg.BidAmount.IsNil() && !bidMsg.BidAmount.IsZero() {
+		return fmt.Errorf("bid amount must be sealed in the bid metadata")
+	}
+
+	sb := GetSealedBid(bidMsg.Data)
+	if sb == nil {
+		return fmt.Errorf("missing sealed bid metadata")
+	}
+	if len(sb.Commitment) != sha256.Size {
+		return fmt.Errorf("invalid commitment length :: %d", len(sb.Commitment))
+	}
+	if sb.Revealed {
+		return fmt.Errorf("sealed bid cannot be submitted as revealed")
+	}
+	if !sb.Collateral.IsValid() || sb.Collateral.Denom != sa.Metadata.ReservePrice.Denom {
+		return fmt.Errorf("invalid collateral :: %s", sb.Collateral.String())
+	}
+	if sb.Collateral.IsLT(sa.Metadata.ReservePrice) {
+		return fmt.Errorf("collateral lower than reserve price :: %s", sa.Metadata.ReservePrice.String())
+	}
+
+	sa.Metadata.NumBids++
+	return nil
+}
+
+// RevealBid opens a sealed bid during the reveal phase. The bid is updated with the revealed
+// amount and becomes the highest bid if it beats every bid revealed so far.
+func (sa *SealedBidAuction) RevealBid(blockTime time.Time, bid *types.Bid, revealMsg *types.MsgRevealBid) error {
+	// Validate auction is in its reveal phase
+	if !sa.IsBiddingClosed(blockTime) || sa.IsExpired(blockTime) {
+		return fmt.Errorf("auction not accepting reveals :: %d", sa.Id)
+	}
+
+	sb := GetSealedBid(bid.Data)
+	if sb == nil {
+		return fmt.Errorf("bid is not sealed")
+	}
+	if sb.Revealed {
+		return fmt.Errorf("bid already revealed")
+	}
+
+	bidder, err := sdk.AccAddressFromBech32(revealMsg.Bidder)
+	if err != nil {
+		return err
+	}
+	commitment := SealedBidCommitment(sa.Id, bidder, revealMsg.Amount, revealMsg.Salt)
+	if !bytes.Equal(commitment, sb.Commitment) {
+		return fmt.Errorf("reveal does not match commitment")
+	}
+
+	if revealMsg.Amount.Denom != sb.Collateral.Denom || sb.Collateral.IsLT(revealMsg.Amount) {
+		return fmt.Errorf("bid exceeds collateral :: %s", sb.Collateral.String())
+	}
+	if revealMsg.Amount.IsLT(sa.Metadata.ReservePrice) {
+		return fmt.Errorf("bid lower than reserve price :: %s", sa.Metadata.ReservePrice.String())
+	}
+
+	sb.Revealed = true
+	data, err := codectypes.NewAnyWithValue(sb)
+	if err != nil {
+		return err
+	}
+	bid.Data = data
+	bid.BidPrice = revealMsg.Amount
+
+	// Ties go to the bid revealed first
+	if sa.Metadata.HighestBid == nil || sa.Metadata.HighestBid.BidPrice.IsLT(bid.BidPrice) {
+		sa.Metadata.HighestBid = bid
+	}
+	sa.Metadata.NumRevealed++
+	return nil
+}
+
+func (sa *SealedBidAuction) UpdateStatus(newStatus types.AuctionStatus) {
+	sa.Status = newStatus
+}
+
+// ValidateBasic checks that the auction phases, reserve price and settlement are well
+// formed.
+func (m *SealedBidAuctionMetadata) ValidateBasic() error {
+	if m.RevealDuration <= 0 {
+		return fmt.Errorf("invalid reveal duration :: %s", m.RevealDuration)
+	}
+	if !m.ReservePrice.IsValid() {
+		return fmt.Errorf("invalid reserve price :: %s", m.ReservePrice.String())
+	}
+
+	switch m.UnrevealedBidPolicy {
+	case UNREVEALED_BID_POLICY_REFUND, UNREVEALED_BID_POLICY_FORFEIT:
+	default:
+		return fmt.Errorf("invalid unrevealed bid policy :: %s", m.UnrevealedBidPolicy)
+	}
+
+	if m.StrategyType != "" {
+		if m.SettlementStrategy != nil {
+			return fmt.Errorf("strategy type and settlement strategy cannot both be set")
+		}
+		return ValidateStrategyType(m.StrategyType)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (sa *SealedBidAuction) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if sa.Metadata == nil {
+		return nil
+	}
+	return sa.Metadata.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *SealedBidAuctionMetadata) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if m.HighestBid != nil {
+		if err := m.HighestBid.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	if m.SettlementStrategy == nil {
+		return nil
+	}
+	var s types.Strategy
+	return unpacker.UnpackAny(m.SettlementStrategy, &s)
+}
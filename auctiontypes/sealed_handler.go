@@ -0,0 +1,230 @@
+package auctiontypes
+
+import (
+	"context"
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/fatal-fruit/auction/types"
+)
+
+var (
+	_ types.AuctionHandler = &SealedBidAuctionHandler{}
+	_ types.RevealHandler  = &SealedBidAuctionHandler{}
+)
+
+type SealedBidAuctionHandler struct {
+	es types.EscrowService
+	bk types.BankKeeper
+	sr types.StrategyResolver
+}
+
+func NewSealedBidAuctionHandler(es types.EscrowService, bk types.BankKeeper, sr types.StrategyResolver) *SealedBidAuctionHandler {
+	return &SealedBidAuctionHandler{
+		bk: bk,
+		es: es,
+		sr: sr,
+	}
+}
+
+func (ah *SealedBidAuctionHandler) CreateAuction(ctx context.Context, id uint64, am types.AuctionMetadata) (types.Auction, error) {
+	md, ok := am.(proto.Message)
+	if !ok {
+		return &SealedBidAuction{}, fmt.Errorf("%T does not implement proto.Message", md)
+	}
+
+	a := &SealedBidAuction{
+		Id:          id,
+		Status:      types.CREATED,
+		AuctionType: sdk.MsgTypeURL(&SealedBidAuction{}),
+		Metadata:    &SealedBidAuctionMetadata{},
+	}
+
+	var selected *codectypes.Any
+	switch m := am.(type) {
+	case *SealedBidAuctionMetadata:
+		if err := m.ValidateBasic(); err != nil {
+			return &SealedBidAuction{}, err
+		}
+		a.Metadata.Duration = m.Duration
+		a.Metadata.RevealDuration = m.RevealDuration
+		a.Metadata.ReservePrice = m.ReservePrice
+		a.Metadata.UnrevealedBidPolicy = m.UnrevealedBidPolicy
+		a.Metadata.StrategyType = m.StrategyType
+		a.Metadata.AutoExecute = m.AutoExecute
+		selected = m.SettlementStrategy
+	default:
+		return &SealedBidAuction{}, fmt.Errorf("invalid auction metadata :: %s", m.String())
+	}
+
+	strategy, err := InitStrategy(ctx, ah.sr, id, selected, &SettleStrategy{StrategyType: a.Metadata.StrategyType})
+	if err != nil {
+		return &SealedBidAuction{}, fmt.Errorf("error creating settlement strategy for auction id %d: %w", id, err)
+	}
+	a.Metadata.SettlementStrategy = strategy
+
+	return a, nil
+}
+
+func (ah *SealedBidAuctionHandler) SubmitBid(ctx context.Context, auction types.Auction, bidMsg *types.MsgNewBid) (types.Auction, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sa, ok := auction.(*SealedBidAuction)
+	if !ok {
+		return nil, fmt.Errorf("invalid auction metadata type")
+	}
+
+	err := sa.SubmitBid(sdkCtx.BlockTime(), bidMsg)
+	if err != nil {
+		return nil, fmt.Errorf("error submitting bid from auction handler: %w", err)
+	}
+
+	s := GetStrategy(sa.Metadata.SettlementStrategy)
+	if s == nil {
+		return nil, fmt.Errorf("missing settlement strategy for auction :: %d", sa.Id)
+	}
+
+	// Send collateral to escrow contract
+	err = escrowBid(ctx, s, bidMsg.Owner, GetSealedBid(bidMsg.Data).Collateral, ah.bk)
+	if err != nil {
+		return nil, err
+	}
+
+	return sa, nil
+}
+
+func (ah *SealedBidAuctionHandler) RevealBid(ctx context.Context, auction types.Auction, bid *types.Bid, revealMsg *types.MsgRevealBid) (types.Auction, *types.Bid, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sa, ok := auction.(*SealedBidAuction)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid auction metadata type")
+	}
+
+	err := sa.RevealBid(sdkCtx.BlockTime(), bid, revealMsg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error revealing bid from auction handler: %w", err)
+	}
+
+	return sa, bid, nil
+}
+
+// FinalizeReveal refunds or forfeits the collateral of every sealed bid that was not
+// revealed, according to the auction's unrevealed bid policy.
+func (ah *SealedBidAuctionHandler) FinalizeReveal(ctx context.Context, auction types.Auction, bids []*types.Bid) error {
+	sa, ok := auction.(*SealedBidAuction)
+	if !ok {
+		return fmt.Errorf("invalid auction metadata type")
+	}
+
+	s := GetStrategy(sa.Metadata.SettlementStrategy)
+	for _, b := range bids {
+		sb := GetSealedBid(b.Data)
+		if sb == nil || sb.Revealed {
+			continue
+		}
+
+		switch sa.Metadata.UnrevealedBidPolicy {
+		case UNREVEALED_BID_POLICY_FORFEIT:
+			err := ah.forfeit(ctx, sa, b.Bidder, sb.Collateral)
+			if err != nil {
+				return err
+			}
+		default:
+			err := refund(ctx, s, sa, b.Bidder, sb.Collateral, ah.bk)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (ah *SealedBidAuctionHandler) forfeit(ctx context.Context, sa *SealedBidAuction, bidder string, amount sdk.Coin) error {
+	owner := sdk.MustAccAddressFromBech32(sa.Owner)
+	escrowAddr := sdk.MustAccAddressFromBech32(GetStrategy(sa.Metadata.SettlementStrategy).GetEscrowContractAddress())
+
+	// Pay forfeited collateral from escrow to the auction owner
+	err := ah.bk.SendCoins(ctx, escrowAddr, owner, sdk.Coins{amount})
+	if err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventBidForfeited{
+		AuctionId:   sa.Id,
+		AuctionType: sa.AuctionType,
+		Owner:       sa.Owner,
+		Bidder:      bidder,
+		Amount:      amount,
+	})
+}
+
+// ExecAuction settles the highest revealed bid with the auction's settlement strategy,
+// returns the unused collateral of every revealed bid and delivers the deposit to the winner.
+func (ah *SealedBidAuctionHandler) ExecAuction(ctx context.Context, auction types.Auction, bids []*types.Bid) error {
+	sa, ok := auction.(*SealedBidAuction)
+	if !ok {
+		return fmt.Errorf("invalid auction metadata")
+	}
+
+	winningBid := sa.GetLeadingBid()
+	if winningBid == nil {
+		return fmt.Errorf("no winning bid for auction :: %d", sa.Id)
+	}
+
+	// Only revealed bids compete for the settlement price
+	var revealed []*types.Bid
+	for _, b := range bids {
+		if sb := GetSealedBid(b.Data); sb != nil && sb.Revealed {
+			revealed = append(revealed, b)
+		}
+	}
+
+	price, err := settle(ctx, ah.sr, sa, sa.Metadata.SettlementStrategy, winningBid, revealed, sa.Metadata.ReservePrice)
+	if err != nil {
+		return err
+	}
+
+	// Return collateral not used to pay the settlement price. Unrevealed bids were settled
+	// when the reveal phase ended.
+	s := GetStrategy(sa.Metadata.SettlementStrategy)
+	for _, b := range revealed {
+		unused := GetSealedBid(b.Data).Collateral
+		if b.Bidder == winningBid.Bidder {
+			unused = unused.Sub(price)
+		}
+		if unused.IsZero() {
+			continue
+		}
+
+		err = refund(ctx, s, sa, b.Bidder, unused, ah.bk)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Deliver the auctioned deposit to the winner
+	if !sa.Deposit.IsZero() {
+		winner := sdk.MustAccAddressFromBech32(winningBid.Bidder)
+		return ah.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, winner, sa.Deposit)
+	}
+	return nil
+}
+
+// CancelAuction returns the collateral of every revealed bid and releases the rest of the
+// escrow to the auction owner. Unrevealed bids were settled when the reveal phase ended.
+func (ah *SealedBidAuctionHandler) CancelAuction(ctx context.Context, auction types.Auction, bids []*types.Bid) error {
+	a, ok := auction.(*SealedBidAuction)
+	if !ok {
+		return fmt.Errorf("invalid auction metadata")
+	}
+
+	return cancelEscrow(ctx, ah.es, ah.bk, a, a.Metadata.SettlementStrategy, bids, func(b *types.Bid) sdk.Coin {
+		sb := GetSealedBid(b.Data)
+		if sb == nil || !sb.Revealed {
+			return sdk.Coin{}
+		}
+		return sb.Collateral
+	})
+}
@@ -0,0 +1,197 @@
+package auctiontypes_test
+
+import (
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	at "github.com/fatal-fruit/auction/auctiontypes"
+	auctiontypes "github.com/fatal-fruit/auction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSealedBidAuctionSubmitAndReveal(t *testing.T) {
+	start := time.Now().UTC()
+	bidder := simtestutil.CreateIncrementalAccounts(1)[0]
+	salt := []byte("salt")
+	amount := sdk.NewInt64Coin("stake", 1200)
+
+	newAuction := func() *at.SealedBidAuction {
+		a := &at.SealedBidAuction{
+			Id: 1,
+			Metadata: &at.SealedBidAuctionMetadata{
+				Duration:       100 * time.Second,
+				RevealDuration: 50 * time.Second,
+				ReservePrice:   sdk.NewInt64Coin("stake", 1000),
+			},
+		}
+		a.StartAuction(start)
+		return a
+	}
+	sealedBid := func(commitment []byte, collateral sdk.Coin) *codectypes.Any {
+		data, err := codectypes.NewAnyWithValue(&at.SealedBid{Commitment: commitment, Collateral: collateral})
+		require.NoError(t, err)
+		return data
+	}
+	commitment := at.SealedBidCommitment(1, bidder, amount, salt)
+
+	testCases := []struct {
+		name      string
+		bidTime   time.Duration
+		bidAmount sdk.Coin
+		data      *codectypes.Any
+		revealMsg *auctiontypes.MsgRevealBid
+		expBidErr bool
+		expRevErr bool
+	}{
+		{
+			name:      "valid commit and reveal",
+			bidTime:   10 * time.Second,
+			data:      sealedBid(commitment, sdk.NewInt64Coin("stake", 1500)),
+			revealMsg: &auctiontypes.MsgRevealBid{Bidder: bidder.String(), AuctionId: 1, Amount: amount, Salt: salt},
+		},
+		{
+			name:      "bid after bidding closed",
+			bidTime:   110 * time.Second,
+			data:      sealedBid(commitment, sdk.NewInt64Coin("stake", 1500)),
+			expBidErr: true,
+		},
+		{
+			name:      "bid amount disclosed",
+			bidTime:   10 * time.Second,
+			bidAmount: amount,
+			data:      sealedBid(commitment, sdk.NewInt64Coin("stake", 1500)),
+			expBidErr: true,
+		},
+		{
+			name:      "missing sealed bid",
+			bidTime:   10 * time.Second,
+			expBidErr: true,
+		},
+		{
+			name:      "invalid commitment",
+			bidTime:   10 * time.Second,
+			data:      sealedBid([]byte("short"), sdk.NewInt64Coin("stake", 1500)),
+			expBidErr: true,
+		},
+		{
+			name:      "collateral below reserve",
+			bidTime:   10 * time.Second,
+			data:      sealedBid(commitment, sdk.NewInt64Coin("stake", 500)),
+			expBidErr: true,
+		},
+		{
+			name:      "reveal with wrong salt",
+			bidTime:   10 * time.Second,
+			data:      sealedBid(commitment, sdk.NewInt64Coin("stake", 1500)),
+			revealMsg: &auctiontypes.MsgRevealBid{Bidder: bidder.String(), AuctionId: 1, Amount: amount, Salt: []byte("other")},
+			expRevErr: true,
+		},
+		{
+			name:      "reveal with wrong amount",
+			bidTime:   10 * time.Second,
+			data:      sealedBid(commitment, sdk.NewInt64Coin("stake", 1500)),
+			revealMsg: &auctiontypes.MsgRevealBid{Bidder: bidder.String(), AuctionId: 1, Amount: sdk.NewInt64Coin("stake", 1300), Salt: salt},
+			expRevErr: true,
+		},
+		{
+			name:      "revealed amount exceeds collateral",
+			bidTime:   10 * time.Second,
+			data:      sealedBid(commitment, sdk.NewInt64Coin("stake", 1100)),
+			revealMsg: &auctiontypes.MsgRevealBid{Bidder: bidder.String(), AuctionId: 1, Amount: amount, Salt: salt},
+			expRevErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			a := newAuction()
+			err := a.SubmitBid(start.Add(tc.bidTime), &auctiontypes.MsgNewBid{
+				Owner:     bidder.String(),
+				AuctionId: 1,
+				BidAmount: tc.bidAmount,
+				Data:      tc.data,
+			})
+			if tc.expBidErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), a.NumBids())
+			require.Nil(t, a.GetLeadingBid())
+
+			bid := &auctiontypes.Bid{AuctionId: 1, Bidder: bidder.String(), Data: tc.data}
+
+			// Bids cannot be revealed while bidding is open
+			require.Error(t, a.RevealBid(start.Add(tc.bidTime), bid, tc.revealMsg))
+
+			err = a.RevealBid(start.Add(120*time.Second), bid, tc.revealMsg)
+			if tc.expRevErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, amount, bid.BidPrice)
+			require.True(t, at.GetSealedBid(bid.Data).Revealed)
+			require.Equal(t, bid, a.GetLeadingBid())
+
+			// A bid can only be revealed once
+			require.Error(t, a.RevealBid(start.Add(120*time.Second), bid, tc.revealMsg))
+		})
+	}
+}
+
+func TestSealedBidAuctionMetadataValidateBasic(t *testing.T) {
+	valid := func() *at.SealedBidAuctionMetadata {
+		return &at.SealedBidAuctionMetadata{
+			Duration:            100 * time.Second,
+			RevealDuration:      50 * time.Second,
+			ReservePrice:        sdk.NewInt64Coin("stake", 1000),
+			UnrevealedBidPolicy: at.UNREVEALED_BID_POLICY_REFUND,
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(md *at.SealedBidAuctionMetadata)
+		expErr   bool
+	}{
+		{name: "valid refund policy", malleate: func(md *at.SealedBidAuctionMetadata) {}},
+		{name: "valid forfeit policy", malleate: func(md *at.SealedBidAuctionMetadata) {
+			md.UnrevealedBidPolicy = at.UNREVEALED_BID_POLICY_FORFEIT
+		}},
+		{name: "missing reveal duration", malleate: func(md *at.SealedBidAuctionMetadata) {
+			md.RevealDuration = 0
+		}, expErr: true},
+		{name: "invalid reserve price", malleate: func(md *at.SealedBidAuctionMetadata) {
+			md.ReservePrice = sdk.Coin{}
+		}, expErr: true},
+		{name: "unspecified policy", malleate: func(md *at.SealedBidAuctionMetadata) {
+			md.UnrevealedBidPolicy = at.UNREVEALED_BID_POLICY_UNSPECIFIED
+		}, expErr: true},
+		{name: "second price strategy type", malleate: func(md *at.SealedBidAuctionMetadata) {
+			md.StrategyType = auctiontypes.SECOND_PRICE
+		}},
+		{name: "strategy type and settlement strategy", malleate: func(md *at.SealedBidAuctionMetadata) {
+			md.StrategyType = auctiontypes.SECOND_PRICE
+			md.SettlementStrategy = &codectypes.Any{}
+		}, expErr: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			md := valid()
+			tc.malleate(md)
+			err := md.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package auctiontypes
+
+import (
+	"context"
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/fatal-fruit/auction/types"
+)
+
+var (
+	_ types.Strategy        = &SettleStrategy{}
+	_ types.StrategyHandler = &SettleStrategyHandler{}
+)
+
+// InitStrategy creates the settlement strategy of a new auction. The strategy is the one
+// selected in the auction metadata, or the auction type's default strategy if none was
+// selected. It is returned packed for storage on the auction.
+func InitStrategy(ctx context.Context, sr types.StrategyResolver, id uint64, selected *codectypes.Any, defaultStrategy types.Strategy) (*codectypes.Any, error) {
+	s := defaultStrategy
+	if selected != nil {
+		cached, ok := selected.GetCachedValue().(types.Strategy)
+		if !ok {
+			return nil, fmt.Errorf("invalid settlement strategy :: %s", selected.GetTypeUrl())
+		}
+		s = cached
+	}
+
+	key := sdk.MsgTypeURL(s)
+	if !sr.HasType(key) {
+		return nil, fmt.Errorf("strategy type %s is not registered", key)
+	}
+
+	s, err := sr.GetHandler(key).CreateStrategy(ctx, id, s)
+	if err != nil {
+		return nil, err
+	}
+	return codectypes.NewAnyWithValue(s)
+}
+
+// GetStrategy returns the settlement strategy packed on an auction, or nil if it has none.
+func GetStrategy(data *codectypes.Any) types.Strategy {
+	if data == nil {
+		return nil
+	}
+	s, _ := data.GetCachedValue().(types.Strategy)
+	return s
+}
+
+// settle executes the settlement strategy of an auction and returns the price paid by the
+// winning bidder.
+func settle(ctx context.Context, sr types.StrategyResolver, auction types.Auction, data *codectypes.Any, winningBid *types.Bid, bids []*types.Bid, reservePrice sdk.Coin) (sdk.Coin, error) {
+	s := GetStrategy(data)
+	if s == nil {
+		return sdk.Coin{}, fmt.Errorf("missing settlement strategy for auction :: %d", auction.GetId())
+	}
+	if !sr.HasType(data.GetTypeUrl()) {
+		return sdk.Coin{}, fmt.Errorf("strategy type %s is not registered", data.GetTypeUrl())
+	}
+
+	h := sr.GetHandler(data.GetTypeUrl())
+	price := h.SettlementPrice(auction, s, winningBid, bids, reservePrice)
+	return price, h.Settle(ctx, auction, s, price, bids)
+}
+
+// escrowBid sends a bid amount from the bidder to the auction's escrow contract.
+func escrowBid(ctx context.Context, s types.Strategy, bidder string, amount sdk.Coin, bk types.BankKeeper) error {
+	bidderAddr := sdk.MustAccAddressFromBech32(bidder)
+	escrowAddr := sdk.MustAccAddressFromBech32(s.GetEscrowContractAddress())
+
+	// Send bid amount to escrow account
+	return bk.SendCoins(ctx, bidderAddr, escrowAddr, sdk.Coins{amount})
+}
+
+// refund returns an amount held in escrow for an auction to the recipient.
+func refund(ctx context.Context, s types.Strategy, auction types.Auction, recipient string, amount sdk.Coin, bk types.BankKeeper) error {
+	recipientAddr := sdk.MustAccAddressFromBech32(recipient)
+	escrowAddr := sdk.MustAccAddressFromBech32(s.GetEscrowContractAddress())
+
+	// Return amount from escrow account
+	err := bk.SendCoins(ctx, escrowAddr, recipientAddr, sdk.Coins{amount})
+	if err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventFundsRefunded{
+		AuctionId:   auction.GetId(),
+		AuctionType: auction.GetType(),
+		Owner:       auction.GetOwner(),
+		Recipient:   recipient,
+		Amount:      sdk.Coins{amount},
+	})
+}
+
+// releaseEscrow returns any funds held by an auction's escrow contract to the auction owner.
+func releaseEscrow(ctx context.Context, es types.EscrowService, auction types.Auction, data *codectypes.Any) error {
+	s := GetStrategy(data)
+	if s == nil {
+		return fmt.Errorf("missing settlement strategy for auction :: %d", auction.GetId())
+	}
+
+	owner := sdk.MustAccAddressFromBech32(auction.GetOwner())
+	escrowAddr := sdk.MustAccAddressFromBech32(s.GetEscrowContractAddress())
+	return es.Release(ctx, s.GetEscrowContractId(), escrowAddr, owner)
+}
+
+// cancelEscrow returns the amount each bid holds in escrow to its bidder, then releases any
+// funds left in escrow to the auction owner. Bids with nothing left in escrow are skipped.
+func cancelEscrow(ctx context.Context, es types.EscrowService, bk types.BankKeeper, auction types.Auction, data *codectypes.Any, bids []*types.Bid, escrowed func(*types.Bid) sdk.Coin) error {
+	s := GetStrategy(data)
+	if s == nil {
+		return fmt.Errorf("missing settlement strategy for auction :: %d", auction.GetId())
+	}
+
+	for _, b := range bids {
+		amount := escrowed(b)
+		if amount.IsNil() || amount.IsZero() {
+			continue
+		}
+
+		err := refund(ctx, s, auction, b.Bidder, amount, bk)
+		if err != nil {
+			return err
+		}
+	}
+
+	return releaseEscrow(ctx, es, auction, data)
+}
+
+// SettleStrategyHandler handles the default SettleStrategy, which pays the settlement
+// price to the auction owner.
+type SettleStrategyHandler struct {
+	es types.EscrowService
+	bk types.BankKeeper
+}
+
+func NewSettleStrategyHandler(es types.EscrowService, bk types.BankKeeper) *SettleStrategyHandler {
+	return &SettleStrategyHandler{
+		es: es,
+		bk: bk,
+	}
+}
+
+// CreateStrategy creates the escrow contract for an auction settled with a SettleStrategy.
+// An empty strategy type defaults to SETTLE.
+func (sh *SettleStrategyHandler) CreateStrategy(ctx context.Context, auctionId uint64, s types.Strategy) (types.Strategy, error) {
+	ss, ok := s.(*SettleStrategy)
+	if !ok {
+		return nil, fmt.Errorf("invalid strategy type :: %T", s)
+	}
+
+	strategyType := ss.StrategyType
+	if strategyType == "" {
+		strategyType = types.SETTLE
+	}
+	if err := ValidateStrategyType(strategyType); err != nil {
+		return nil, err
+	}
+
+	contract, err := sh.es.NewContract(ctx, auctionId)
+	if err != nil {
+		return nil, fmt.Errorf("error creating escrow contract for auction id :: %d", auctionId)
+	}
+
+	return &SettleStrategy{
+		StrategyType:          strategyType,
+		EscrowContractId:      contract.GetId(),
+		EscrowContractAddress: contract.GetAddress().String(),
+	}, nil
+}
+
+func (sh *SettleStrategyHandler) SettlementPrice(_ types.Auction, s types.Strategy, winningBid *types.Bid, bids []*types.Bid, reservePrice sdk.Coin) sdk.Coin {
+	ss, ok := s.(*SettleStrategy)
+	if !ok {
+		return winningBid.BidPrice
+	}
+	return ss.SettlementPrice(winningBid, bids, reservePrice)
+}
+
+// Settle sends the settlement price from escrow to the auction owner.
+func (sh *SettleStrategyHandler) Settle(ctx context.Context, auction types.Auction, s types.Strategy, price sdk.Coin, _ []*types.Bid) error {
+	auctioneer := sdk.MustAccAddressFromBech32(auction.GetOwner())
+	escrowAddr := sdk.MustAccAddressFromBech32(s.GetEscrowContractAddress())
+
+	return sh.bk.SendCoins(ctx, escrowAddr, auctioneer, sdk.Coins{price})
+}
+
+// SettlementPrice returns the amount the winner pays. SETTLE charges the winning bid and
+// SECOND_PRICE charges the highest bid placed by another bidder, or the reserve price if
+// there is none. Bids without a price, such as unrevealed sealed bids, are ignored.
+func (s *SettleStrategy) SettlementPrice(winningBid *types.Bid, bids []*types.Bid, reservePrice sdk.Coin) sdk.Coin {
+	if s.StrategyType != types.SECOND_PRICE {
+		return winningBid.BidPrice
+	}
+
+	price := reservePrice
+	for _, b := range bids {
+		if b.Bidder == winningBid.Bidder || b.BidPrice.Amount.IsNil() || b.BidPrice.Denom != price.Denom {
+			continue
+		}
+		if price.IsLT(b.BidPrice) {
+			price = b.BidPrice
+		}
+	}
+
+	// The winner is never charged more than their own bid
+	if winningBid.BidPrice.IsLT(price) {
+		return winningBid.BidPrice
+	}
+	return price
+}
+
+// ValidateStrategyType returns an error if the strategy type is not supported.
+func ValidateStrategyType(strategyType string) error {
+	switch strategyType {
+	case types.SETTLE, types.SECOND_PRICE:
+		return nil
+	default:
+		return fmt.Errorf("invalid strategy type :: %s", strategyType)
+	}
+}
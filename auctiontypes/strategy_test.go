@@ -0,0 +1,66 @@
+package auctiontypes_test
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	at "github.com/fatal-fruit/auction/auctiontypes"
+	auctiontestutil "github.com/fatal-fruit/auction/testutil"
+	auctiontypes "github.com/fatal-fruit/auction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitStrategy(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	contract := &auctiontestutil.EscrowModContract{
+		Id:      7,
+		Address: f.Addrs[2],
+	}
+	f.MockEscrowService.EXPECT().NewContract(f.Ctx, uint64(7)).Return(contract, nil).AnyTimes()
+
+	testCases := []struct {
+		name         string
+		selected     *codectypes.Any
+		strategyType string
+		expStrategy  *at.SettleStrategy
+		expErr       bool
+	}{
+		{
+			name:        "defaults to settle",
+			expStrategy: &at.SettleStrategy{StrategyType: auctiontypes.SETTLE, EscrowContractId: 7, EscrowContractAddress: f.Addrs[2].String()},
+		},
+		{
+			name:         "falls back to the strategy type",
+			strategyType: auctiontypes.SECOND_PRICE,
+			expStrategy:  &at.SettleStrategy{StrategyType: auctiontypes.SECOND_PRICE, EscrowContractId: 7, EscrowContractAddress: f.Addrs[2].String()},
+		},
+		{
+			name:         "selected strategy takes precedence",
+			selected:     codectypes.UnsafePackAny(&at.SettleStrategy{StrategyType: auctiontypes.SECOND_PRICE}),
+			strategyType: auctiontypes.SETTLE,
+			expStrategy:  &at.SettleStrategy{StrategyType: auctiontypes.SECOND_PRICE, EscrowContractId: 7, EscrowContractAddress: f.Addrs[2].String()},
+		},
+		{
+			name:     "invalid strategy type",
+			selected: codectypes.UnsafePackAny(&at.SettleStrategy{StrategyType: "INVALID"}),
+			expErr:   true,
+		},
+		{
+			name:     "unregistered strategy",
+			selected: codectypes.UnsafePackAny(&at.SealedBid{}),
+			expErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := at.InitStrategy(f.Ctx, f.StrategyResolver, 7, tc.selected, &at.SettleStrategy{StrategyType: tc.strategyType})
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expStrategy, at.GetStrategy(data))
+		})
+	}
+}
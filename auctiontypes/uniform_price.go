@@ -0,0 +1,146 @@
+package auctiontypes
+
+import (
+	"context"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/fatal-fruit/auction/types"
+)
+
+var (
+	_ types.Strategy        = &UniformPriceStrategy{}
+	_ types.StrategyHandler = &UniformPriceStrategyHandler{}
+)
+
+// UniformPriceStrategyHandler handles the UniformPriceStrategy. It fills the winning bids
+// of a batch auction at a single clearing price and distributes the deposit units between
+// them in proportion to their fills.
+type UniformPriceStrategyHandler struct {
+	es types.EscrowService
+	bk types.BankKeeper
+}
+
+func NewUniformPriceStrategyHandler(es types.EscrowService, bk types.BankKeeper) *UniformPriceStrategyHandler {
+	return &UniformPriceStrategyHandler{
+		es: es,
+		bk: bk,
+	}
+}
+
+// CreateStrategy creates the escrow contract for an auction settled with a
+// UniformPriceStrategy.
+func (sh *UniformPriceStrategyHandler) CreateStrategy(ctx context.Context, auctionId uint64, s types.Strategy) (types.Strategy, error) {
+	if _, ok := s.(*UniformPriceStrategy); !ok {
+		return nil, fmt.Errorf("invalid strategy type :: %T", s)
+	}
+
+	contract, err := sh.es.NewContract(ctx, auctionId)
+	if err != nil {
+		return nil, fmt.Errorf("error creating escrow contract for auction id :: %d", auctionId)
+	}
+
+	return &UniformPriceStrategy{
+		EscrowContractId:      contract.GetId(),
+		EscrowContractAddress: contract.GetAddress().String(),
+	}, nil
+}
+
+// SettlementPrice returns the clearing price per unit of the auction's deposit. An
+// undersubscribed auction has no marginal bid competing for its last units, so it clears
+// at the reserve price.
+func (sh *UniformPriceStrategyHandler) SettlementPrice(a types.Auction, _ types.Strategy, winningBid *types.Bid, bids []*types.Bid, reservePrice sdk.Coin) sdk.Coin {
+	units, err := depositUnits(a)
+	if err != nil {
+		return winningBid.BidPrice
+	}
+
+	price, fills := ClearBatch(units.Amount, bids)
+	sold := sdkmath.ZeroInt()
+	for _, f := range fills {
+		sold = sold.Add(f.Quantity)
+	}
+	if sold.LT(units.Amount) && reservePrice.IsValid() && reservePrice.Denom == price.Denom {
+		return reservePrice
+	}
+	return price
+}
+
+// Settle fills the winning bids at the clearing price. Each winner receives its filled
+// units of the deposit and the part of its escrowed bid it did not pay. The owner is paid
+// for the filled units and receives the units left unsold.
+func (sh *UniformPriceStrategyHandler) Settle(ctx context.Context, a types.Auction, s types.Strategy, price sdk.Coin, bids []*types.Bid) error {
+	units, err := depositUnits(a)
+	if err != nil {
+		return err
+	}
+
+	_, fills := ClearBatch(units.Amount, bids)
+	filled := make(map[*types.Bid]sdkmath.Int, len(fills))
+	for _, f := range fills {
+		filled[f.Bid] = f.Quantity
+	}
+
+	paid := sdk.NewCoin(price.Denom, sdkmath.ZeroInt())
+	sold := sdkmath.ZeroInt()
+	for _, b := range bids {
+		bb := GetBatchBid(b.Data)
+		if bb == nil {
+			continue
+		}
+
+		quantity, ok := filled[b]
+		if !ok {
+			quantity = sdkmath.ZeroInt()
+		}
+		cost := batchCost(price, quantity)
+
+		// Return the part of the escrowed bid not used to pay for filled units
+		unused := batchCost(b.BidPrice, bb.Quantity).Sub(cost)
+		if unused.IsPositive() {
+			err = refund(ctx, s, a, b.Bidder, unused, sh.bk)
+			if err != nil {
+				return err
+			}
+		}
+
+		if !quantity.IsPositive() {
+			continue
+		}
+
+		// Deliver the filled units of the deposit to the bidder
+		bidder := sdk.MustAccAddressFromBech32(b.Bidder)
+		err = sh.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidder, sdk.NewCoins(sdk.NewCoin(units.Denom, quantity)))
+		if err != nil {
+			return err
+		}
+		paid = paid.Add(cost)
+		sold = sold.Add(quantity)
+	}
+
+	owner := sdk.MustAccAddressFromBech32(a.GetOwner())
+	if paid.IsPositive() {
+		escrowAddr := sdk.MustAccAddressFromBech32(s.GetEscrowContractAddress())
+		err = sh.bk.SendCoins(ctx, escrowAddr, owner, sdk.Coins{paid})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Return unsold units to the owner
+	unsold := units.Amount.Sub(sold)
+	if unsold.IsPositive() {
+		return sh.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(sdk.NewCoin(units.Denom, unsold)))
+	}
+	return nil
+}
+
+// depositUnits returns the units sold by an auction, which must be a single deposit coin.
+func depositUnits(a types.Auction) (sdk.Coin, error) {
+	deposit := a.GetDeposit()
+	if len(deposit) != 1 {
+		return sdk.Coin{}, fmt.Errorf("invalid deposit for uniform price settlement :: %s", deposit)
+	}
+	return deposit[0], nil
+}
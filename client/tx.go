@@ -1,12 +1,16 @@
 package client
 
 import (
+	sdkmath "cosmossdk.io/math"
+	"encoding/hex"
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	at "github.com/fatal-fruit/auction/auctiontypes"
 	auctiontypes "github.com/fatal-fruit/auction/types"
 	"github.com/spf13/cobra"
 	"strconv"
@@ -32,7 +36,15 @@ func NewAuctionCmd() *cobra.Command {
 				"reserve_price": {
 					"denom":"stake",
 					"amount":"250"
-				}
+				},
+			"extension_window": "300s",
+			"extension_duration": "120s",
+			"max_extensions": 5,
+			"min_increment_bps": 500,
+			"buy_now_price": {
+				"denom":"stake",
+				"amount":"1000"
+			}
 		}
 		`, version.AppName, auctiontypes.ModuleName),
 		),
@@ -174,6 +186,165 @@ func BidCmd() *cobra.Command {
 	return cmd
 }
 
+// BatchBidCmd creates a CLI command for a MsgNewBid on a batch auction.
+func BatchBidCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-bid [auction-id] [max-price] [quantity] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "bid for a quantity of units on a batch auction",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s batch-bid <auction-id> <max-price> <quantity> --from <sender> --chain-id <chain-id>
+
+		max-price is the most the bidder pays per unit. The max price of every unit is escrowed,
+		and the part not used at the clearing price is refunded when the auction is executed.`, version.AppName, auctiontypes.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			maxPrice, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			quantity, ok := sdkmath.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid quantity :: %s", args[2])
+			}
+
+			data, err := codectypes.NewAnyWithValue(&at.BatchBid{Quantity: quantity})
+			if err != nil {
+				return err
+			}
+
+			msg := auctiontypes.MsgNewBid{
+				Owner:     clientCtx.GetFromAddress().String(),
+				AuctionId: id,
+				BidAmount: maxPrice,
+				Data:      data,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// SealedBidCmd creates a CLI command for a MsgNewBid carrying a sealed bid.
+func SealedBidCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sealed-bid [auction-id] [bid-price] [collateral] [salt-hex] --from [sender]",
+		Args:  cobra.ExactArgs(4),
+		Short: "place a sealed bid on a sealed-bid auction",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s sealed-bid <auction-id> <bid-price> <collateral> <salt-hex> --from <sender> --chain-id <chain-id>
+
+		Only the commitment to the bid price and the collateral are submitted. Keep the bid price
+		and salt to reveal the bid with the reveal-bid command once bidding closes.`, version.AppName, auctiontypes.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			bidPrice, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			collateral, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			salt, err := hex.DecodeString(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid salt: %w", err)
+			}
+
+			owner := clientCtx.GetFromAddress()
+			data, err := codectypes.NewAnyWithValue(&at.SealedBid{
+				Commitment: at.SealedBidCommitment(id, owner, bidPrice, salt),
+				Collateral: collateral,
+			})
+			if err != nil {
+				return err
+			}
+
+			msg := auctiontypes.MsgNewBid{
+				Owner:     owner.String(),
+				AuctionId: id,
+				Data:      data,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// RevealBidCmd creates a CLI command for MsgRevealBid.
+func RevealBidCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-bid [auction-id] [bid-price] [salt-hex] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "reveal a sealed bid",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s reveal-bid <auction-id> <bid-price> <salt-hex> --from <sender> --chain-id <chain-id>`, version.AppName, auctiontypes.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			bidPrice, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			salt, err := hex.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid salt: %w", err)
+			}
+
+			msg := auctiontypes.MsgRevealBid{
+				Bidder:    clientCtx.GetFromAddress().String(),
+				AuctionId: id,
+				Amount:    bidPrice,
+				Salt:      salt,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func ExecuteAuctionCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "exec [auction-id] --from [sender]",
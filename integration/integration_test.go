@@ -17,12 +17,24 @@ import (
 	_ "github.com/fatal-fruit/auction/module"
 
 	cosmosapp "cosmossdk.io/api/cosmos/app/v1alpha1"
+	authmodulev1 "cosmossdk.io/api/cosmos/auth/module/v1"
 	"cosmossdk.io/core/appconfig"
+	sdkmath "cosmossdk.io/math"
+	"fmt"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	auctionmodule "github.com/fatal-fruit/auction/api/module/v1"
+	at "github.com/fatal-fruit/auction/auctiontypes"
 	"github.com/fatal-fruit/auction/keeper"
+	auctiontestutil "github.com/fatal-fruit/auction/testutil"
 	auctiontypes "github.com/fatal-fruit/auction/types"
 	"testing"
+	"time"
 )
 
 var AuctionModule = func() configurator.ModuleOption {
@@ -34,12 +46,29 @@ var AuctionModule = func() configurator.ModuleOption {
 	}
 }
 
-func TestIntegration(t *testing.T) {
-	t.Parallel()
-	logger := log.NewTestLogger(t)
-	appConfig := depinject.Configs(
+// AuthModule extends the configurator auth module with the auction module account
+var AuthModule = func() configurator.ModuleOption {
+	return func(config *configurator.Config) {
+		config.ModuleConfigs["auth"] = &cosmosapp.ModuleConfig{
+			Name: "auth",
+			Config: appconfig.WrapAny(&authmodulev1.Module{
+				Bech32Prefix: "cosmos",
+				ModuleAccountPermissions: []*authmodulev1.ModuleAccountPermission{
+					{Account: "fee_collector"},
+					{Account: "mint", Permissions: []string{"minter"}},
+					{Account: "bonded_tokens_pool", Permissions: []string{"burner", "staking"}},
+					{Account: "not_bonded_tokens_pool", Permissions: []string{"burner", "staking"}},
+					{Account: auctiontypes.ModuleName},
+				},
+			}),
+		}
+	}
+}
+
+func appConfig(logger log.Logger) depinject.Config {
+	return depinject.Configs(
 		configurator.NewAppConfig(
-			configurator.AuthModule(),
+			AuthModule(),
 			configurator.BankModule(),
 			configurator.StakingModule(),
 			configurator.TxModule(),
@@ -63,11 +92,565 @@ func TestIntegration(t *testing.T) {
 		),
 		depinject.Supply(logger),
 	)
+}
+
+func TestIntegration(t *testing.T) {
+	t.Parallel()
+	logger := log.NewTestLogger(t)
 	var kp keeper.Keeper
-	app, err := simtestutil.Setup(appConfig, &kp)
+	app, err := simtestutil.Setup(appConfig(logger), &kp)
 	require.NoError(t, err)
 	require.NotNil(t, app)
 	ctx := app.BaseApp.NewContext(false)
 	_, err = app.EndBlocker(ctx)
 	require.NoError(t, err)
 }
+
+func TestSettleRefundsLosingBidders(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	logger := log.NewTestLogger(t)
+
+	var (
+		kp keeper.Keeper
+		ak authkeeper.AccountKeeper
+		bk bankkeeper.BaseKeeper
+	)
+	app, err := simtestutil.Setup(appConfig(logger), &kp, &ak, &bk)
+	require.NoError(err)
+	ctx := app.BaseApp.NewContext(false).WithBlockTime(time.Now())
+
+	escrow := auctiontestutil.NewTestEscrowModule(ak, bk)
+	strategyResolver := auctiontypes.NewStrategyResolver()
+	strategyResolver.AddType(sdk.MsgTypeURL(&at.SettleStrategy{}), at.NewSettleStrategyHandler(escrow, bk))
+	strategyResolver.Seal()
+
+	resolver := auctiontypes.NewResolver()
+	reserveType := sdk.MsgTypeURL(&at.ReserveAuction{})
+	resolver.AddType(reserveType, at.NewReserveAuctionHandler(escrow, bk, strategyResolver))
+	resolver.Seal()
+	kp.SetAuctionTypesResolver(resolver)
+	msgServer := keeper.NewMsgServerImpl(kp)
+
+	addrs := simtestutil.CreateIncrementalAccounts(3)
+	owner, loser, winner := addrs[0], addrs[1], addrs[2]
+	bidDenom := sdk.DefaultBondDenom
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("uasset", 1))
+	initial := sdk.NewCoins(sdk.NewInt64Coin(bidDenom, 10000))
+	require.NoError(banktestutil.FundAccount(ctx, bk, owner, initial.Add(deposit...)))
+	require.NoError(banktestutil.FundAccount(ctx, bk, loser, initial))
+	require.NoError(banktestutil.FundAccount(ctx, bk, winner, initial))
+
+	anyMd, err := codectypes.NewAnyWithValue(&at.ReserveAuctionMetadata{
+		ReservePrice: sdk.NewInt64Coin(bidDenom, 500),
+		Duration:     30 * time.Second,
+	})
+	require.NoError(err)
+	res, err := msgServer.NewAuction(ctx, &auctiontypes.MsgNewAuction{
+		Owner:           owner.String(),
+		Deposit:         deposit,
+		AuctionType:     reserveType,
+		AuctionMetadata: anyMd,
+	})
+	require.NoError(err)
+	_, err = msgServer.StartAuction(ctx, &auctiontypes.MsgStartAuction{Owner: owner.String(), Id: res.Id})
+	require.NoError(err)
+
+	bids := []struct {
+		bidder sdk.AccAddress
+		amount int64
+	}{
+		{loser, 600},
+		{winner, 700},
+		{loser, 800},
+		{winner, 900},
+	}
+	for _, b := range bids {
+		_, err = msgServer.NewBid(ctx, &auctiontypes.MsgNewBid{
+			Owner:     b.bidder.String(),
+			AuctionId: res.Id,
+			BidAmount: sdk.NewInt64Coin(bidDenom, b.amount),
+		})
+		require.NoError(err)
+	}
+
+	auction, err := kp.Auctions.Get(ctx, res.Id)
+	require.NoError(err)
+	escrowAddr := sdk.MustAccAddressFromBech32(at.GetStrategy(auction.(*at.ReserveAuction).Metadata.SettlementStrategy).GetEscrowContractAddress())
+	require.Equal(int64(3000), bk.GetBalance(ctx, escrowAddr, bidDenom).Amount.Int64())
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(31 * time.Second))
+	require.NoError(kp.ProcessActiveAuctions(ctx, nil))
+	require.NoError(kp.ProcessExpiredAuctions(ctx, nil))
+
+	_, err = msgServer.Exec(ctx, &auctiontypes.MsgExecAuction{Sender: owner.String(), AuctionId: res.Id})
+	require.NoError(err)
+
+	// Owner receives only the winning bid, every other bid is returned in full
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin(bidDenom, 10900)), bk.GetAllBalances(ctx, owner))
+	require.Equal(initial, bk.GetAllBalances(ctx, loser))
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin(bidDenom, 9100)).Add(deposit...), bk.GetAllBalances(ctx, winner))
+	require.True(bk.GetAllBalances(ctx, escrowAddr).IsZero())
+	require.True(bk.GetAllBalances(ctx, authtypes.NewModuleAddress(auctiontypes.ModuleName)).IsZero())
+}
+
+func TestBatchAuctionSettlement(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	logger := log.NewTestLogger(t)
+
+	var (
+		kp keeper.Keeper
+		ak authkeeper.AccountKeeper
+		bk bankkeeper.BaseKeeper
+	)
+	app, err := simtestutil.Setup(appConfig(logger), &kp, &ak, &bk)
+	require.NoError(err)
+	ctx := app.BaseApp.NewContext(false).WithBlockTime(time.Now())
+
+	escrow := auctiontestutil.NewTestEscrowModule(ak, bk)
+	strategyResolver := auctiontypes.NewStrategyResolver()
+	strategyResolver.AddType(sdk.MsgTypeURL(&at.UniformPriceStrategy{}), at.NewUniformPriceStrategyHandler(escrow, bk))
+	strategyResolver.Seal()
+
+	resolver := auctiontypes.NewResolver()
+	batchType := sdk.MsgTypeURL(&at.BatchAuction{})
+	resolver.AddType(batchType, at.NewBatchAuctionHandler(escrow, bk, strategyResolver))
+	resolver.Seal()
+	kp.SetAuctionTypesResolver(resolver)
+	msgServer := keeper.NewMsgServerImpl(kp)
+
+	addrs := simtestutil.CreateIncrementalAccounts(5)
+	owner, bidders := addrs[0], addrs[1:]
+	bidDenom := sdk.DefaultBondDenom
+	units := sdk.NewCoins(sdk.NewInt64Coin("uasset", 100))
+	initial := sdk.NewCoins(sdk.NewInt64Coin(bidDenom, 10000))
+	require.NoError(banktestutil.FundAccount(ctx, bk, owner, units))
+	for _, b := range bidders {
+		require.NoError(banktestutil.FundAccount(ctx, bk, b, initial))
+	}
+
+	anyMd, err := codectypes.NewAnyWithValue(&at.BatchAuctionMetadata{
+		ReservePrice: sdk.NewInt64Coin(bidDenom, 10),
+		Duration:     30 * time.Second,
+	})
+	require.NoError(err)
+	res, err := msgServer.NewAuction(ctx, &auctiontypes.MsgNewAuction{
+		Owner:           owner.String(),
+		Deposit:         units,
+		AuctionType:     batchType,
+		AuctionMetadata: anyMd,
+	})
+	require.NoError(err)
+	_, err = msgServer.StartAuction(ctx, &auctiontypes.MsgStartAuction{Owner: owner.String(), Id: res.Id})
+	require.NoError(err)
+
+	bids := []struct {
+		quantity int64
+		price    int64
+	}{
+		{50, 20},
+		{40, 15},
+		// Marginal bid, partially filled with the remaining 10 units
+		{30, 12},
+		// Losing bid
+		{10, 11},
+	}
+	for i, b := range bids {
+		data, err := codectypes.NewAnyWithValue(&at.BatchBid{Quantity: sdkmath.NewInt(b.quantity)})
+		require.NoError(err)
+		_, err = msgServer.NewBid(ctx, &auctiontypes.MsgNewBid{
+			Owner:     bidders[i].String(),
+			AuctionId: res.Id,
+			BidAmount: sdk.NewInt64Coin(bidDenom, b.price),
+			Data:      data,
+		})
+		require.NoError(err)
+	}
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(31 * time.Second))
+	require.NoError(kp.ProcessActiveAuctions(ctx, nil))
+	require.NoError(kp.ProcessExpiredAuctions(ctx, nil))
+
+	_, err = msgServer.Exec(ctx, &auctiontypes.MsgExecAuction{Sender: owner.String(), AuctionId: res.Id})
+	require.NoError(err)
+
+	// Every filled unit is paid at the clearing price of 12
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin(bidDenom, 1200)), bk.GetAllBalances(ctx, owner))
+	expBalances := []sdk.Coins{
+		sdk.NewCoins(sdk.NewInt64Coin(bidDenom, 9400), sdk.NewInt64Coin("uasset", 50)),
+		sdk.NewCoins(sdk.NewInt64Coin(bidDenom, 9520), sdk.NewInt64Coin("uasset", 40)),
+		sdk.NewCoins(sdk.NewInt64Coin(bidDenom, 9880), sdk.NewInt64Coin("uasset", 10)),
+		initial,
+	}
+	for i, b := range bidders {
+		require.Equal(expBalances[i], bk.GetAllBalances(ctx, b))
+	}
+
+	auction, err := kp.Auctions.Get(ctx, res.Id)
+	require.NoError(err)
+	escrowAddr := sdk.MustAccAddressFromBech32(at.GetStrategy(auction.(*at.BatchAuction).Metadata.SettlementStrategy).GetEscrowContractAddress())
+	require.True(bk.GetAllBalances(ctx, escrowAddr).IsZero())
+	require.True(bk.GetAllBalances(ctx, authtypes.NewModuleAddress(auctiontypes.ModuleName)).IsZero())
+}
+
+func TestReverseAuctionSettlement(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	logger := log.NewTestLogger(t)
+
+	var (
+		kp keeper.Keeper
+		ak authkeeper.AccountKeeper
+		bk bankkeeper.BaseKeeper
+	)
+	app, err := simtestutil.Setup(appConfig(logger), &kp, &ak, &bk)
+	require.NoError(err)
+	ctx := app.BaseApp.NewContext(false).WithBlockTime(time.Now())
+
+	strategyResolver := auctiontypes.NewStrategyResolver()
+	strategyResolver.AddType(sdk.MsgTypeURL(&at.ProcurementStrategy{}), at.NewProcurementStrategyHandler(bk))
+	strategyResolver.Seal()
+
+	resolver := auctiontypes.NewResolver()
+	reverseType := sdk.MsgTypeURL(&at.ReverseAuction{})
+	resolver.AddType(reverseType, at.NewReverseAuctionHandler(bk, strategyResolver))
+	resolver.Seal()
+	kp.SetAuctionTypesResolver(resolver)
+	msgServer := keeper.NewMsgServerImpl(kp)
+
+	addrs := simtestutil.CreateIncrementalAccounts(3)
+	owner, providers := addrs[0], addrs[1:]
+	denom := sdk.DefaultBondDenom
+	budget := sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))
+	require.NoError(banktestutil.FundAccount(ctx, bk, owner, budget))
+
+	anyMd, err := codectypes.NewAnyWithValue(&at.ReverseAuctionMetadata{
+		CeilingPrice: sdk.NewInt64Coin(denom, 1000),
+		Duration:     30 * time.Second,
+	})
+	require.NoError(err)
+	res, err := msgServer.NewAuction(ctx, &auctiontypes.MsgNewAuction{
+		Owner:           owner.String(),
+		Deposit:         budget,
+		AuctionType:     reverseType,
+		AuctionMetadata: anyMd,
+	})
+	require.NoError(err)
+
+	// The budget is held by the module account, so no escrow contract is created
+	auction, err := kp.Auctions.Get(ctx, res.Id)
+	require.NoError(err)
+	require.Empty(at.GetStrategy(auction.(*at.ReverseAuction).Metadata.SettlementStrategy).GetEscrowContractAddress())
+	_, err = msgServer.StartAuction(ctx, &auctiontypes.MsgStartAuction{Owner: owner.String(), Id: res.Id})
+	require.NoError(err)
+
+	for i, price := range []int64{900, 750} {
+		_, err = msgServer.NewBid(ctx, &auctiontypes.MsgNewBid{
+			Owner:     providers[i].String(),
+			AuctionId: res.Id,
+			BidAmount: sdk.NewInt64Coin(denom, price),
+		})
+		require.NoError(err)
+	}
+	// Bids must undercut the best price
+	_, err = msgServer.NewBid(ctx, &auctiontypes.MsgNewBid{
+		Owner:     providers[0].String(),
+		AuctionId: res.Id,
+		BidAmount: sdk.NewInt64Coin(denom, 800),
+	})
+	require.Error(err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(31 * time.Second))
+	require.NoError(kp.ProcessActiveAuctions(ctx, nil))
+	require.NoError(kp.ProcessExpiredAuctions(ctx, nil))
+
+	_, err = msgServer.Exec(ctx, &auctiontypes.MsgExecAuction{Sender: owner.String(), AuctionId: res.Id})
+	require.NoError(err)
+
+	// The lowest bidder is paid their bid and the rest of the budget is returned
+	require.True(bk.GetAllBalances(ctx, providers[0]).IsZero())
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 750)), bk.GetAllBalances(ctx, providers[1]))
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 250)), bk.GetAllBalances(ctx, owner))
+	require.True(bk.GetAllBalances(ctx, authtypes.NewModuleAddress(auctiontypes.ModuleName)).IsZero())
+}
+
+func TestPendingTimeoutRefundsBids(t *testing.T) {
+	t.Parallel()
+
+	for _, refundOnOutbid := range []bool{false, true} {
+		refundOnOutbid := refundOnOutbid
+		t.Run(fmt.Sprintf("refund on outbid %t", refundOnOutbid), func(t *testing.T) {
+			require := require.New(t)
+			logger := log.NewTestLogger(t)
+
+			var (
+				kp keeper.Keeper
+				ak authkeeper.AccountKeeper
+				bk bankkeeper.BaseKeeper
+			)
+			app, err := simtestutil.Setup(appConfig(logger), &kp, &ak, &bk)
+			require.NoError(err)
+			ctx := app.BaseApp.NewContext(false).WithBlockTime(time.Now())
+
+			escrow := auctiontestutil.NewTestEscrowModule(ak, bk)
+			strategyResolver := auctiontypes.NewStrategyResolver()
+			strategyResolver.AddType(sdk.MsgTypeURL(&at.SettleStrategy{}), at.NewSettleStrategyHandler(escrow, bk))
+			strategyResolver.Seal()
+
+			resolver := auctiontypes.NewResolver()
+			reserveType := sdk.MsgTypeURL(&at.ReserveAuction{})
+			resolver.AddType(reserveType, at.NewReserveAuctionHandler(escrow, bk, strategyResolver))
+			resolver.Seal()
+			kp.SetAuctionTypesResolver(resolver)
+			msgServer := keeper.NewMsgServerImpl(kp)
+
+			addrs := simtestutil.CreateIncrementalAccounts(3)
+			owner, bidders := addrs[0], addrs[1:]
+			bidDenom := sdk.DefaultBondDenom
+			deposit := sdk.NewCoins(sdk.NewInt64Coin("uasset", 1))
+			initial := sdk.NewCoins(sdk.NewInt64Coin(bidDenom, 10000))
+			require.NoError(banktestutil.FundAccount(ctx, bk, owner, deposit))
+			for _, b := range bidders {
+				require.NoError(banktestutil.FundAccount(ctx, bk, b, initial))
+			}
+
+			anyMd, err := codectypes.NewAnyWithValue(&at.ReserveAuctionMetadata{
+				ReservePrice:   sdk.NewInt64Coin(bidDenom, 500),
+				Duration:       30 * time.Second,
+				RefundOnOutbid: refundOnOutbid,
+			})
+			require.NoError(err)
+			res, err := msgServer.NewAuction(ctx, &auctiontypes.MsgNewAuction{
+				Owner:           owner.String(),
+				Deposit:         deposit,
+				AuctionType:     reserveType,
+				AuctionMetadata: anyMd,
+			})
+			require.NoError(err)
+			_, err = msgServer.StartAuction(ctx, &auctiontypes.MsgStartAuction{Owner: owner.String(), Id: res.Id})
+			require.NoError(err)
+
+			for i, price := range []int64{600, 700, 800} {
+				_, err = msgServer.NewBid(ctx, &auctiontypes.MsgNewBid{
+					Owner:     bidders[i%2].String(),
+					AuctionId: res.Id,
+					BidAmount: sdk.NewInt64Coin(bidDenom, price),
+				})
+				require.NoError(err)
+			}
+
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(31 * time.Second))
+			require.NoError(kp.ProcessActiveAuctions(ctx, nil))
+			require.NoError(kp.ProcessExpiredAuctions(ctx, nil))
+
+			deadline, err := kp.ExecutionDeadlines.Get(ctx, res.Id)
+			require.NoError(err)
+
+			// The auction is not executed within its execution window
+			ctx = ctx.WithBlockTime(deadline.Add(time.Second))
+			require.NoError(kp.ProcessPendingAuctions(ctx, nil))
+			require.NoError(kp.ProcessCancelledAuctions(ctx, nil))
+
+			auction, err := kp.Auctions.Get(ctx, res.Id)
+			require.NoError(err)
+			require.Equal(auctiontypes.CANCELLED, auction.GetStatus())
+
+			// Every bid and the deposit are returned
+			require.Equal(deposit, bk.GetAllBalances(ctx, owner))
+			for _, b := range bidders {
+				require.Equal(initial, bk.GetAllBalances(ctx, b))
+			}
+			escrowAddr := sdk.MustAccAddressFromBech32(at.GetStrategy(auction.(*at.ReserveAuction).Metadata.SettlementStrategy).GetEscrowContractAddress())
+			require.True(bk.GetAllBalances(ctx, escrowAddr).IsZero())
+			require.True(bk.GetAllBalances(ctx, authtypes.NewModuleAddress(auctiontypes.ModuleName)).IsZero())
+		})
+	}
+}
+
+func TestCancelledAuctionRefundsDeposit(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	logger := log.NewTestLogger(t)
+
+	var (
+		kp keeper.Keeper
+		ak authkeeper.AccountKeeper
+		bk bankkeeper.BaseKeeper
+	)
+	app, err := simtestutil.Setup(appConfig(logger), &kp, &ak, &bk)
+	require.NoError(err)
+	ctx := app.BaseApp.NewContext(false).WithBlockTime(time.Now())
+
+	escrow := auctiontestutil.NewTestEscrowModule(ak, bk)
+	strategyResolver := auctiontypes.NewStrategyResolver()
+	strategyResolver.AddType(sdk.MsgTypeURL(&at.SettleStrategy{}), at.NewSettleStrategyHandler(escrow, bk))
+	strategyResolver.Seal()
+
+	resolver := auctiontypes.NewResolver()
+	reserveType := sdk.MsgTypeURL(&at.ReserveAuction{})
+	resolver.AddType(reserveType, at.NewReserveAuctionHandler(escrow, bk, strategyResolver))
+	resolver.Seal()
+	kp.SetAuctionTypesResolver(resolver)
+	msgServer := keeper.NewMsgServerImpl(kp)
+
+	owner := simtestutil.CreateIncrementalAccounts(1)[0]
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("uasset", 1))
+	require.NoError(banktestutil.FundAccount(ctx, bk, owner, deposit))
+
+	anyMd, err := codectypes.NewAnyWithValue(&at.ReserveAuctionMetadata{
+		ReservePrice: sdk.NewInt64Coin(sdk.DefaultBondDenom, 500),
+		Duration:     30 * time.Second,
+	})
+	require.NoError(err)
+	res, err := msgServer.NewAuction(ctx, &auctiontypes.MsgNewAuction{
+		Owner:           owner.String(),
+		Deposit:         deposit,
+		AuctionType:     reserveType,
+		AuctionMetadata: anyMd,
+	})
+	require.NoError(err)
+	_, err = msgServer.StartAuction(ctx, &auctiontypes.MsgStartAuction{Owner: owner.String(), Id: res.Id})
+	require.NoError(err)
+	require.True(bk.GetAllBalances(ctx, owner).IsZero())
+
+	// The auction expires without bids and is cancelled
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(31 * time.Second))
+	require.NoError(kp.ProcessActiveAuctions(ctx, nil))
+	require.NoError(kp.ProcessExpiredAuctions(ctx, nil))
+
+	isCancelled, err := kp.CancelledAuctions.Has(ctx, res.Id)
+	require.NoError(err)
+	require.True(isCancelled)
+
+	require.NoError(kp.ProcessCancelledAuctions(ctx, nil))
+
+	isCancelled, err = kp.CancelledAuctions.Has(ctx, res.Id)
+	require.NoError(err)
+	require.False(isCancelled)
+
+	auction, err := kp.Auctions.Get(ctx, res.Id)
+	require.NoError(err)
+	require.Equal(auctiontypes.CANCELLED, auction.GetStatus())
+	require.Equal(deposit, bk.GetAllBalances(ctx, owner))
+	require.True(bk.GetAllBalances(ctx, authtypes.NewModuleAddress(auctiontypes.ModuleName)).IsZero())
+}
+
+func TestAutoExecute(t *testing.T) {
+	testCases := []struct {
+		name string
+		// drained is taken from escrow before the auction is executed
+		drained  int64
+		expState auctiontypes.AuctionStatus
+	}{
+		{name: "executed in the end blocker", expState: auctiontypes.SETTLED},
+		// The winning bid is paid to the owner before the losing bid fails to be refunded
+		{name: "failed execution is rolled back", drained: 200, expState: auctiontypes.PENDING},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require := require.New(t)
+			logger := log.NewTestLogger(t)
+
+			var (
+				kp keeper.Keeper
+				ak authkeeper.AccountKeeper
+				bk bankkeeper.BaseKeeper
+			)
+			app, err := simtestutil.Setup(appConfig(logger), &kp, &ak, &bk)
+			require.NoError(err)
+			ctx := app.BaseApp.NewContext(false).WithBlockTime(time.Now())
+
+			escrow := auctiontestutil.NewTestEscrowModule(ak, bk)
+			strategyResolver := auctiontypes.NewStrategyResolver()
+			strategyResolver.AddType(sdk.MsgTypeURL(&at.SettleStrategy{}), at.NewSettleStrategyHandler(escrow, bk))
+			strategyResolver.Seal()
+
+			resolver := auctiontypes.NewResolver()
+			reserveType := sdk.MsgTypeURL(&at.ReserveAuction{})
+			resolver.AddType(reserveType, at.NewReserveAuctionHandler(escrow, bk, strategyResolver))
+			resolver.Seal()
+			kp.SetAuctionTypesResolver(resolver)
+			msgServer := keeper.NewMsgServerImpl(kp)
+
+			addrs := simtestutil.CreateIncrementalAccounts(3)
+			owner, loser, winner := addrs[0], addrs[1], addrs[2]
+			bidDenom := sdk.DefaultBondDenom
+			deposit := sdk.NewCoins(sdk.NewInt64Coin("uasset", 1))
+			initial := sdk.NewCoins(sdk.NewInt64Coin(bidDenom, 10000))
+			require.NoError(banktestutil.FundAccount(ctx, bk, owner, deposit))
+			require.NoError(banktestutil.FundAccount(ctx, bk, loser, initial))
+			require.NoError(banktestutil.FundAccount(ctx, bk, winner, initial))
+
+			anyMd, err := codectypes.NewAnyWithValue(&at.ReserveAuctionMetadata{
+				ReservePrice: sdk.NewInt64Coin(bidDenom, 500),
+				Duration:     30 * time.Second,
+				AutoExecute:  true,
+			})
+			require.NoError(err)
+			res, err := msgServer.NewAuction(ctx, &auctiontypes.MsgNewAuction{
+				Owner:           owner.String(),
+				Deposit:         deposit,
+				AuctionType:     reserveType,
+				AuctionMetadata: anyMd,
+			})
+			require.NoError(err)
+			_, err = msgServer.StartAuction(ctx, &auctiontypes.MsgStartAuction{Owner: owner.String(), Id: res.Id})
+			require.NoError(err)
+
+			for _, b := range []struct {
+				bidder sdk.AccAddress
+				amount int64
+			}{
+				{loser, 600},
+				{winner, 900},
+			} {
+				_, err = msgServer.NewBid(ctx, &auctiontypes.MsgNewBid{
+					Owner:     b.bidder.String(),
+					AuctionId: res.Id,
+					BidAmount: sdk.NewInt64Coin(bidDenom, b.amount),
+				})
+				require.NoError(err)
+			}
+
+			auction, err := kp.Auctions.Get(ctx, res.Id)
+			require.NoError(err)
+			escrowAddr := sdk.MustAccAddressFromBech32(at.GetStrategy(auction.(*at.ReserveAuction).Metadata.SettlementStrategy).GetEscrowContractAddress())
+			if tc.drained > 0 {
+				require.NoError(bk.SendCoins(ctx, escrowAddr, owner, sdk.NewCoins(sdk.NewInt64Coin(bidDenom, tc.drained))))
+			}
+			ownerBalance := bk.GetAllBalances(ctx, owner)
+
+			// The auction is executed in the block it becomes pending, without MsgExecAuction
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(31 * time.Second))
+			require.NoError(kp.ProcessActiveAuctions(ctx, nil))
+			require.NoError(kp.ProcessExpiredAuctions(ctx, nil))
+			require.NoError(kp.ProcessPendingAuctions(ctx, nil))
+
+			auction, err = kp.Auctions.Get(ctx, res.Id)
+			require.NoError(err)
+			require.Equal(tc.expState, auction.GetStatus())
+
+			isPending, err := kp.PendingAuctions.Has(ctx, res.Id)
+			require.NoError(err)
+			if tc.expState == auctiontypes.SETTLED {
+				require.False(isPending)
+				require.Equal(ownerBalance.Add(sdk.NewInt64Coin(bidDenom, 900)), bk.GetAllBalances(ctx, owner))
+				require.Equal(initial, bk.GetAllBalances(ctx, loser))
+				require.Equal(sdk.NewCoins(sdk.NewInt64Coin(bidDenom, 9100)).Add(deposit...), bk.GetAllBalances(ctx, winner))
+				return
+			}
+
+			// Nothing was paid out and the auction waits for MsgExecAuction
+			require.True(isPending)
+			require.False(auction.GetAutoExecute())
+			require.Equal(ownerBalance, bk.GetAllBalances(ctx, owner))
+			require.Equal(sdk.NewCoins(sdk.NewInt64Coin(bidDenom, 9400)), bk.GetAllBalances(ctx, loser))
+			require.Equal(sdk.NewCoins(sdk.NewInt64Coin(bidDenom, 1500-tc.drained)), bk.GetAllBalances(ctx, escrowAddr))
+			require.Equal(deposit, bk.GetAllBalances(ctx, authtypes.NewModuleAddress(auctiontypes.ModuleName)))
+		})
+	}
+}
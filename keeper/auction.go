@@ -3,11 +3,14 @@ package keeper
 import (
 	"context"
 	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	auctiontypes "github.com/fatal-fruit/auction/types"
 )
 
-func (k *Keeper) CreateAuction(ctx context.Context, auctionType string, owner sdk.AccAddress, md auctiontypes.AuctionMetadata) (auctiontypes.Auction, error) {
+func (k *Keeper) CreateAuction(ctx context.Context, auctionType string, owner sdk.AccAddress, deposit sdk.Coins, md auctiontypes.AuctionMetadata) (auctiontypes.Auction, error) {
 	// Check if keeper has registered auction type
 	if !k.resolver.HasType(auctionType) {
 		return nil, fmt.Errorf("auction type %s is not registered", auctionType)
@@ -15,6 +18,17 @@ func (k *Keeper) CreateAuction(ctx context.Context, auctionType string, owner sd
 
 	handler := k.resolver.GetHandler(auctionType)
 
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range deposit {
+		if !params.IsAllowedDepositDenom(c.Denom) {
+			return nil, errorsmod.Wrapf(auctiontypes.ErrDenomNotAllowed, "deposit denom %s", c.Denom)
+		}
+	}
+
 	// Get Next Id
 	id, err := k.IDs.Next(ctx)
 	if err != nil {
@@ -23,9 +37,20 @@ func (k *Keeper) CreateAuction(ctx context.Context, auctionType string, owner sd
 
 	auction, err := handler.CreateAuction(ctx, id, md)
 	if err != nil {
-		return nil, fmt.Errorf("error creating auction")
+		return nil, fmt.Errorf("error creating auction: %w", err)
 	}
 	auction.SetOwner(owner)
+	auction.SetDeposit(deposit)
+
+	if da, ok := auction.(auctiontypes.DepositAuction); ok {
+		if err = da.ValidateDeposit(deposit); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = params.ValidateDuration(auction.GetDuration()); err != nil {
+		return nil, err
+	}
 
 	return auction, nil
 }
@@ -38,7 +63,66 @@ func (k *Keeper) SubmitBid(ctx context.Context, auctionType string, auction auct
 
 	handler := k.resolver.GetHandler(auctionType)
 
-	return handler.SubmitBid(ctx, auction, bidMessage)
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var md auctiontypes.BidMetadata
+	if bidMessage.Data != nil {
+		err = k.cdc.UnpackAny(bidMessage.Data, &md)
+		if err != nil {
+			return nil, fmt.Errorf("error serializing bid metadata")
+		}
+	}
+
+	// Bids that lock collateral in place of a bid amount are checked against its denom
+	bidDenom := bidMessage.BidAmount.Denom
+	if cb, ok := md.(auctiontypes.CollateralizedBid); ok {
+		bidDenom = cb.GetCollateral().Denom
+	}
+	if !params.IsAllowedBidDenom(bidDenom) {
+		return nil, errorsmod.Wrapf(auctiontypes.ErrDenomNotAllowed, "bid denom %s", bidDenom)
+	}
+
+	// Sealed-bid auctions accept a single bid per bidder
+	if _, ok := auction.(auctiontypes.RevealingAuction); ok {
+		bidder, err := sdk.AccAddressFromBech32(bidMessage.Owner)
+		if err != nil {
+			return nil, err
+		}
+		hasBid, err := k.BidderAuctions.Has(ctx, collections.Join(bidder, auction.GetId()))
+		if err != nil {
+			return nil, err
+		}
+		if hasBid {
+			return nil, errorsmod.Wrapf(auctiontypes.ErrSealedBidExists, "auction %d", auction.GetId())
+		}
+	}
+
+	if params.MaxBidsPerAuction > 0 && auction.NumBids() >= params.MaxBidsPerAuction {
+		return nil, errorsmod.Wrapf(auctiontypes.ErrMaxBidsReached, "auction %d has %d bids", auction.GetId(), auction.NumBids())
+	}
+
+	// Bids that extend or close an auction move its end time, which is indexed while active
+	end := auction.GetEndTime()
+	auction, err = handler.SubmitBid(ctx, auction, bidMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	if auction.GetStatus() == auctiontypes.ACTIVE && !auction.GetEndTime().Equal(end) {
+		err = k.ActiveAuctionsByEndTime.Remove(ctx, collections.Join(end, auction.GetId()))
+		if err != nil {
+			return nil, err
+		}
+		err = k.ActiveAuctionsByEndTime.Set(ctx, collections.Join(auction.GetEndTime(), auction.GetId()))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return auction, nil
 }
 
 func (k *Keeper) ExecuteAuction(ctx context.Context, auction auctiontypes.Auction) error {
@@ -48,5 +132,83 @@ func (k *Keeper) ExecuteAuction(ctx context.Context, auction auctiontypes.Auctio
 
 	handler := k.resolver.GetHandler(auction.GetType())
 
-	return handler.ExecAuction(ctx, auction)
+	bids, err := k.GetAuctionBids(ctx, auction.GetId())
+	if err != nil {
+		return err
+	}
+
+	return handler.ExecAuction(ctx, auction, bids)
+}
+
+// RevealBid opens the sealed bid a bidder placed on an auction in its reveal phase.
+func (k *Keeper) RevealBid(ctx context.Context, msg *auctiontypes.MsgRevealBid) error {
+	isRevealing, err := k.RevealAuctions.Has(ctx, msg.GetAuctionId())
+	if err != nil {
+		return err
+	}
+	if !isRevealing {
+		return errorsmod.Wrapf(auctiontypes.ErrNotRevealPhase, "auction %d", msg.GetAuctionId())
+	}
+
+	auction, err := k.Auctions.Get(ctx, msg.GetAuctionId())
+	if err != nil {
+		return err
+	}
+
+	if !k.resolver.HasType(auction.GetType()) {
+		return fmt.Errorf("auction type %s is not registered", auction.GetType())
+	}
+	handler, ok := k.resolver.GetHandler(auction.GetType()).(auctiontypes.RevealHandler)
+	if !ok {
+		return fmt.Errorf("auction type %s does not accept sealed bids", auction.GetType())
+	}
+
+	bidder, err := sdk.AccAddressFromBech32(msg.GetBidder())
+	if err != nil {
+		return fmt.Errorf("invalid bidder address :: %s", msg.GetBidder())
+	}
+
+	// Find the bid placed by the bidder, who may place a single sealed bid
+	var (
+		seq   uint64
+		found bool
+	)
+	rng := collections.NewSuperPrefixedTripleRange[sdk.AccAddress, uint64, uint64](bidder, auction.GetId())
+	err = k.BidderBids.Walk(ctx, rng, func(key collections.Triple[sdk.AccAddress, uint64, uint64]) (stop bool, err error) {
+		seq, found = key.K3(), true
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errorsmod.Wrapf(auctiontypes.ErrBidNotFound, "bidder %s on auction %d", msg.GetBidder(), auction.GetId())
+	}
+	sealed, err := k.Bids.Get(ctx, collections.Join(auction.GetId(), seq))
+	if err != nil {
+		return err
+	}
+
+	auction, bid, err := handler.RevealBid(ctx, auction, &sealed, msg)
+	if err != nil {
+		return fmt.Errorf("error revealing bid: %w", err)
+	}
+
+	err = k.Auctions.Set(ctx, auction.GetId(), auction)
+	if err != nil {
+		return err
+	}
+
+	err = k.Bids.Set(ctx, collections.Join(auction.GetId(), seq), *bid)
+	if err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&auctiontypes.EventBidRevealed{
+		AuctionId:   auction.GetId(),
+		AuctionType: auction.GetType(),
+		Owner:       auction.GetOwner(),
+		Bidder:      bid.Bidder,
+		Amount:      bid.BidPrice,
+	})
 }
@@ -0,0 +1,57 @@
+package keeper
+
+// BlockBudget caps the number of auctions the EndBlocker transitions in a block. Auctions
+// over the cap stay in their queue and are processed in queue order in later blocks. A nil
+// budget is unlimited.
+//
+// Part of the limit is reserved for execution timeouts, auto executions and refunds, so a
+// backlog of ending auctions cannot hold back the funds of auctions that were already
+// settled or cancelled.
+type BlockBudget struct {
+	limit    uint64
+	reserved uint64
+	used     uint64
+	deferred uint64
+}
+
+// NewBlockBudget returns a budget allowing limit transitions, of which reserved are kept for
+// timeouts and refunds. A limit of 0 is unlimited.
+func NewBlockBudget(limit, reserved uint64) *BlockBudget {
+	return &BlockBudget{limit: limit, reserved: min(reserved, limit)}
+}
+
+// take reserves a transition from the whole budget. Once the budget is spent the auction is
+// counted as deferred and take returns false.
+func (b *BlockBudget) take() bool {
+	if b == nil {
+		return true
+	}
+	return b.spend(b.limit)
+}
+
+// takeUnreserved reserves a transition from the part of the budget that is not reserved for
+// timeouts and refunds.
+func (b *BlockBudget) takeUnreserved() bool {
+	if b == nil {
+		return true
+	}
+	return b.spend(b.limit - b.reserved)
+}
+
+func (b *BlockBudget) spend(limit uint64) bool {
+	if b.limit > 0 && b.used >= limit {
+		b.deferred++
+		return false
+	}
+	b.used++
+	return true
+}
+
+// Deferred returns the number of auctions due for a transition that were left for a later
+// block.
+func (b *BlockBudget) Deferred() uint64 {
+	if b == nil {
+		return 0
+	}
+	return b.deferred
+}
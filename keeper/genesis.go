@@ -2,15 +2,188 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	auctiontypes "github.com/fatal-fruit/auction/types"
 )
 
 func (k *Keeper) InitGenesis(ctx context.Context, data *auctiontypes.GenesisState) error {
-	// TODO: Implement
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		return err
+	}
+
+	for _, a := range data.Auctions {
+		auction, ok := a.GetCachedValue().(auctiontypes.Auction)
+		if !ok {
+			return fmt.Errorf("unable to unpack auction %s", a.GetTypeUrl())
+		}
+
+		if err := k.Auctions.Set(ctx, auction.GetId(), auction); err != nil {
+			return err
+		}
+	}
+
+	for _, b := range data.Bids {
+		if err := k.Bids.Set(ctx, collections.Join(b.Bid.AuctionId, b.Sequence), b.Bid); err != nil {
+			return err
+		}
+
+		// Rebuild the bidder index from the stored bids
+		bidder, err := sdk.AccAddressFromBech32(b.Bid.Bidder)
+		if err != nil {
+			return err
+		}
+		if err := k.BidderAuctions.Set(ctx, collections.Join(bidder, b.Bid.AuctionId)); err != nil {
+			return err
+		}
+		if err := k.BidderBids.Set(ctx, collections.Join3(bidder, b.Bid.AuctionId, b.Sequence)); err != nil {
+			return err
+		}
+	}
+
+	if err := k.IDs.Set(ctx, data.AuctionSequence); err != nil {
+		return err
+	}
+
+	for _, oa := range data.OwnerAuctions {
+		owner, err := sdk.AccAddressFromBech32(oa.Owner)
+		if err != nil {
+			return err
+		}
+
+		if err := k.OwnerAuctions.Set(ctx, owner, auctiontypes.OwnerAuctions{Ids: oa.Ids}); err != nil {
+			return err
+		}
+	}
+
+	queues := []struct {
+		queue collections.KeySet[uint64]
+		ids   []uint64
+	}{
+		{k.ActiveAuctions, data.ActiveAuctions},
+		{k.RevealAuctions, data.RevealAuctions},
+		{k.ExpiredAuctions, data.ExpiredAuctions},
+		{k.PendingAuctions, data.PendingAuctions},
+		{k.CancelledAuctions, data.CancelledAuctions},
+	}
+	for _, q := range queues {
+		for _, id := range q.ids {
+			if err := q.queue.Set(ctx, id); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Rebuild the end time index from the active queue
+	for _, id := range data.ActiveAuctions {
+		auction, err := k.Auctions.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := k.ActiveAuctionsByEndTime.Set(ctx, collections.Join(auction.GetEndTime(), id)); err != nil {
+			return err
+		}
+	}
+
+	for _, d := range data.ExecutionDeadlines {
+		if err := k.ExecutionDeadlines.Set(ctx, d.AuctionId, d.Deadline); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 func (k *Keeper) ExportGenesis(ctx context.Context) (*auctiontypes.GenesisState, error) {
-	// TODO: Implement
-	return &auctiontypes.GenesisState{}, nil
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gs := &auctiontypes.GenesisState{Params: params}
+	err = k.Auctions.Walk(ctx, nil, func(id uint64, auction auctiontypes.Auction) (stop bool, err error) {
+		aa, err := codectypes.NewAnyWithValue(auction)
+		if err != nil {
+			return true, err
+		}
+		gs.Auctions = append(gs.Auctions, aa)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Bids.Walk(ctx, nil, func(key collections.Pair[uint64, uint64], bid auctiontypes.Bid) (stop bool, err error) {
+		gs.Bids = append(gs.Bids, auctiontypes.BidEntry{
+			Sequence: key.K2(),
+			Bid:      bid,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	gs.AuctionSequence, err = k.IDs.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.OwnerAuctions.Walk(ctx, nil, func(owner sdk.AccAddress, oa auctiontypes.OwnerAuctions) (stop bool, err error) {
+		gs.OwnerAuctions = append(gs.OwnerAuctions, auctiontypes.OwnerAuctionsEntry{
+			Owner: owner.String(),
+			Ids:   oa.Ids,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	gs.ActiveAuctions, err = collectIds(ctx, k.ActiveAuctions)
+	if err != nil {
+		return nil, err
+	}
+	gs.RevealAuctions, err = collectIds(ctx, k.RevealAuctions)
+	if err != nil {
+		return nil, err
+	}
+	gs.ExpiredAuctions, err = collectIds(ctx, k.ExpiredAuctions)
+	if err != nil {
+		return nil, err
+	}
+	gs.PendingAuctions, err = collectIds(ctx, k.PendingAuctions)
+	if err != nil {
+		return nil, err
+	}
+	gs.CancelledAuctions, err = collectIds(ctx, k.CancelledAuctions)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.ExecutionDeadlines.Walk(ctx, nil, func(id uint64, deadline time.Time) (stop bool, err error) {
+		gs.ExecutionDeadlines = append(gs.ExecutionDeadlines, auctiontypes.ExecutionDeadlineEntry{
+			AuctionId: id,
+			Deadline:  deadline,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return gs, nil
+}
+
+func collectIds(ctx context.Context, queue collections.KeySet[uint64]) ([]uint64, error) {
+	var ids []uint64
+	err := queue.Walk(ctx, nil, func(id uint64) (stop bool, err error) {
+		ids = append(ids, id)
+		return false, nil
+	})
+	return ids, err
 }
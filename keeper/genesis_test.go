@@ -2,12 +2,193 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	at "github.com/fatal-fruit/auction/auctiontypes"
+	auctiontestutil "github.com/fatal-fruit/auction/testutil"
+	auctiontypes "github.com/fatal-fruit/auction/types"
+	"github.com/stretchr/testify/require"
 )
 
+// genesisAuctions returns an active auction 0 and an auction 1 with a winning bid in the given
+// status.
+func genesisAuctions(t *testing.T, f *auctiontestutil.TestFixture, status auctiontypes.AuctionStatus) []*codectypes.Any {
+	auctions := []*at.ReserveAuction{
+		{
+			Id:          0,
+			Status:      auctiontypes.ACTIVE,
+			Owner:       f.Addrs[0].String(),
+			AuctionType: f.ReserveAuctionType,
+			Metadata: &at.ReserveAuctionMetadata{
+				ReservePrice: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000),
+				StartTime:    time.Now().UTC(),
+				EndTime:      time.Now().UTC().Add(30 * time.Second),
+			},
+		},
+		{
+			Id:          1,
+			Status:      status,
+			Owner:       f.Addrs[1].String(),
+			AuctionType: f.ReserveAuctionType,
+			Metadata: &at.ReserveAuctionMetadata{
+				ReservePrice: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000),
+				StartTime:    time.Now().UTC().Add(-30 * time.Second),
+				EndTime:      time.Now().UTC().Add(-1 * time.Second),
+				LastPrice:    sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100),
+				HighestBid: &auctiontypes.Bid{
+					AuctionId: 1,
+					Bidder:    f.Addrs[2].String(),
+					BidPrice:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100),
+					Timestamp: time.Now().UTC(),
+				},
+				NumBids: 1,
+			},
+		},
+	}
+
+	var anys []*codectypes.Any
+	for _, a := range auctions {
+		aa, err := codectypes.NewAnyWithValue(a)
+		require.NoError(t, err)
+		anys = append(anys, aa)
+	}
+	return anys
+}
+
+func genesisBid(f *auctiontestutil.TestFixture) auctiontypes.Bid {
+	return auctiontypes.Bid{
+		AuctionId: 1,
+		Bidder:    f.Addrs[2].String(),
+		BidPrice:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100),
+		Timestamp: time.Now().UTC(),
+	}
+}
+
 func TestInitGenesis(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	require := require.New(t)
+
+	gs := &auctiontypes.GenesisState{
+		Params:          auctiontypes.DefaultParams(),
+		Auctions:        genesisAuctions(t, f, auctiontypes.PENDING),
+		AuctionSequence: 2,
+		OwnerAuctions: []auctiontypes.OwnerAuctionsEntry{
+			{Owner: f.Addrs[0].String(), Ids: []uint64{0}},
+			{Owner: f.Addrs[1].String(), Ids: []uint64{1}},
+		},
+		Bids: []auctiontypes.BidEntry{
+			{Sequence: 0, Bid: genesisBid(f)},
+		},
+		ActiveAuctions:  []uint64{0},
+		PendingAuctions: []uint64{1},
+		ExecutionDeadlines: []auctiontypes.ExecutionDeadlineEntry{
+			{AuctionId: 1, Deadline: time.Unix(1700000000, 0).UTC()},
+		},
+	}
+	require.NoError(gs.Validate())
+
+	err := f.K.InitGenesis(f.Ctx, gs)
+	require.NoError(err)
+
+	for _, id := range []uint64{0, 1} {
+		has, err := f.K.Auctions.Has(f.Ctx, id)
+		require.NoError(err)
+		require.True(has)
+	}
+
+	next, err := f.K.IDs.Peek(f.Ctx)
+	require.NoError(err)
+	require.Equal(uint64(2), next)
+
+	oa, err := f.K.OwnerAuctions.Get(f.Ctx, f.Addrs[1])
+	require.NoError(err)
+	require.Equal([]uint64{1}, oa.Ids)
+
+	isActive, err := f.K.ActiveAuctions.Has(f.Ctx, 0)
+	require.NoError(err)
+	require.True(isActive)
 
+	active, err := f.K.Auctions.Get(f.Ctx, 0)
+	require.NoError(err)
+	indexed, err := f.K.ActiveAuctionsByEndTime.Has(f.Ctx, collections.Join(active.GetEndTime(), uint64(0)))
+	require.NoError(err)
+	require.True(indexed)
+
+	isPending, err := f.K.PendingAuctions.Has(f.Ctx, 1)
+	require.NoError(err)
+	require.True(isPending)
+
+	deadline, err := f.K.ExecutionDeadlines.Get(f.Ctx, 1)
+	require.NoError(err)
+	require.Equal(gs.ExecutionDeadlines[0].Deadline, deadline)
+
+	bid, err := f.K.Bids.Get(f.Ctx, collections.Join(uint64(1), uint64(0)))
+	require.NoError(err)
+	require.Equal(gs.Bids[0].Bid.Bidder, bid.Bidder)
+
+	hasBid, err := f.K.BidderAuctions.Has(f.Ctx, collections.Join(f.Addrs[2], uint64(1)))
+	require.NoError(err)
+	require.True(hasBid)
+
+	hasBid, err = f.K.BidderBids.Has(f.Ctx, collections.Join3(f.Addrs[2], uint64(1), uint64(0)))
+	require.NoError(err)
+	require.True(hasBid)
 }
 
 func TestExportGenesis(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	require := require.New(t)
+
+	gs := &auctiontypes.GenesisState{
+		Params:          auctiontypes.DefaultParams(),
+		Auctions:        genesisAuctions(t, f, auctiontypes.CANCELLED),
+		AuctionSequence: 2,
+		OwnerAuctions: []auctiontypes.OwnerAuctionsEntry{
+			{Owner: f.Addrs[0].String(), Ids: []uint64{0}},
+			{Owner: f.Addrs[1].String(), Ids: []uint64{1}},
+		},
+		Bids: []auctiontypes.BidEntry{
+			{Sequence: 0, Bid: genesisBid(f)},
+		},
+		ActiveAuctions:    []uint64{0},
+		CancelledAuctions: []uint64{1},
+	}
+	err := f.K.InitGenesis(f.Ctx, gs)
+	require.NoError(err)
+
+	exported, err := f.K.ExportGenesis(f.Ctx)
+	require.NoError(err)
+	require.NoError(exported.Validate())
+
+	require.Equal(gs.Params, exported.Params)
+	require.Equal(gs.AuctionSequence, exported.AuctionSequence)
+	require.ElementsMatch(gs.OwnerAuctions, exported.OwnerAuctions)
+	require.Equal(gs.ActiveAuctions, exported.ActiveAuctions)
+	require.Equal(gs.CancelledAuctions, exported.CancelledAuctions)
+	require.Empty(exported.RevealAuctions)
+	require.Empty(exported.ExpiredAuctions)
+	require.Empty(exported.PendingAuctions)
+	require.Len(exported.Auctions, len(gs.Auctions))
+	require.Equal(gs.Bids, exported.Bids)
+
+	// Round trip the exported state through JSON into a fresh store
+	bz, err := f.EnCfg.Codec.MarshalJSON(exported)
+	require.NoError(err)
+
+	var imported auctiontypes.GenesisState
+	require.NoError(f.EnCfg.Codec.UnmarshalJSON(bz, &imported))
+	require.NoError(imported.Validate())
+
+	f2 := auctiontestutil.InitFixture(t)
+	require.NoError(f2.K.InitGenesis(f2.Ctx, &imported))
 
+	for _, a := range gs.Auctions {
+		expected := a.GetCachedValue().(auctiontypes.Auction)
+		actual, err := f2.K.Auctions.Get(f2.Ctx, expected.GetId())
+		require.NoError(err)
+		require.Equal(expected.String(), actual.String())
+	}
 }
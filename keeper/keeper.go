@@ -2,14 +2,20 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
+	"time"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/core/address"
 	storetypes "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	auctiontypes "github.com/fatal-fruit/auction/types"
 )
 
@@ -25,17 +31,33 @@ type Keeper struct {
 	defaultDenom string
 
 	// state management
-	Schema        collections.Schema
-	IDs           collections.Sequence
-	Auctions      collections.Map[uint64, auctiontypes.Auction]
+	Schema   collections.Schema
+	Params   collections.Item[auctiontypes.Params]
+	IDs      collections.Sequence
+	Auctions collections.Map[uint64, auctiontypes.Auction]
+	// Bids holds every accepted bid keyed by auction ID and bid sequence
+	Bids          collections.Map[collections.Pair[uint64, uint64], auctiontypes.Bid]
 	OwnerAuctions collections.Map[sdk.AccAddress, auctiontypes.OwnerAuctions]
 
+	// BidderAuctions indexes the auctions each address has bid on
+	BidderAuctions collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	// BidderBids indexes the sequence of every bid by bidder and auction
+	BidderBids collections.KeySet[collections.Triple[sdk.AccAddress, uint64, uint64]]
+
 	// Queues
 	ActiveAuctions    collections.KeySet[uint64]
+	RevealAuctions    collections.KeySet[uint64]
 	ExpiredAuctions   collections.KeySet[uint64]
 	PendingAuctions   collections.KeySet[uint64]
 	CancelledAuctions collections.KeySet[uint64]
 
+	// ActiveAuctionsByEndTime indexes the active queue by end time, so only auctions that
+	// may have ended are loaded at the end of each block
+	ActiveAuctionsByEndTime collections.KeySet[collections.Pair[time.Time, uint64]]
+
+	// ExecutionDeadlines holds the time by which each pending auction must be executed
+	ExecutionDeadlines collections.Map[uint64, time.Time]
+
 	// Auction Type Registry
 	resolver auctiontypes.AuctionResolver
 }
@@ -47,13 +69,20 @@ func NewKeeper(cdc codec.BinaryCodec, addressCodec address.Codec, storeService s
 	}
 
 	sb := collections.NewSchemaBuilder(storeService)
+	params := collections.NewItem(sb, auctiontypes.ParamsKey, "params", codec.CollValue[auctiontypes.Params](cdc))
 	ids := collections.NewSequence(sb, auctiontypes.IDKey, "auctionIds")
 	auctions := collections.NewMap(sb, auctiontypes.AuctionsKey, "auctions", collections.Uint64Key, codec.CollInterfaceValue[auctiontypes.Auction](cdc))
+	bids := collections.NewMap(sb, auctiontypes.BidsKey, "bids", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[auctiontypes.Bid](cdc))
 	ownerAuctions := collections.NewMap(sb, auctiontypes.OwnerAuctionsKey, "ownerAuctions", sdk.AccAddressKey, codec.CollValue[auctiontypes.OwnerAuctions](cdc))
+	bidderAuctions := collections.NewKeySet(sb, auctiontypes.BidderAuctionsKey, "bidderAuctions", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key))
+	bidderBids := collections.NewKeySet(sb, auctiontypes.BidderBidsKey, "bidderBids", collections.TripleKeyCodec(sdk.AccAddressKey, collections.Uint64Key, collections.Uint64Key))
 	activeAuctions := collections.NewKeySet(sb, auctiontypes.ActiveAuctionsKey, "activeAuctions", collections.Uint64Key)
+	activeAuctionsByEndTime := collections.NewKeySet(sb, auctiontypes.EndTimeIndexKey, "activeAuctionsByEndTime", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key))
+	revealAuctions := collections.NewKeySet(sb, auctiontypes.RevealAuctionsKey, "revealAuctions", collections.Uint64Key)
 	expiredAuctions := collections.NewKeySet(sb, auctiontypes.ExpiredAuctionsKey, "expiredAuctions", collections.Uint64Key)
 	cancelledAuctions := collections.NewKeySet(sb, auctiontypes.CancelledAuctionsKey, "cancelledAuctions", collections.Uint64Key)
 	pendingAuctions := collections.NewKeySet(sb, auctiontypes.PendingAuctionsKey, "pendingAuctions", collections.Uint64Key)
+	executionDeadlines := collections.NewMap(sb, auctiontypes.ExecutionDeadlinesKey, "executionDeadlines", collections.Uint64Key, collcodec.KeyToValueCodec(sdk.TimeKey))
 
 	k := Keeper{
 		cdc:          cdc,
@@ -71,13 +100,20 @@ func NewKeeper(cdc codec.BinaryCodec, addressCodec address.Codec, storeService s
 	}
 
 	k.Schema = schema
+	k.Params = params
 	k.IDs = ids
 	k.Auctions = auctions
+	k.Bids = bids
 	k.OwnerAuctions = ownerAuctions
+	k.BidderAuctions = bidderAuctions
+	k.BidderBids = bidderBids
 	k.ActiveAuctions = activeAuctions
+	k.ActiveAuctionsByEndTime = activeAuctionsByEndTime
+	k.RevealAuctions = revealAuctions
 	k.ExpiredAuctions = expiredAuctions
 	k.CancelledAuctions = cancelledAuctions
 	k.PendingAuctions = pendingAuctions
+	k.ExecutionDeadlines = executionDeadlines
 
 	return k
 }
@@ -108,67 +144,190 @@ func (keeper *Keeper) Logger() log.Logger {
 	return keeper.logger.With("module", "x/"+auctiontypes.ModuleName)
 }
 
-func (k *Keeper) ProcessActiveAuctions(goCtx context.Context) error {
+// ProcessActiveAuctions moves active auctions whose bidding has ended to the expired queue,
+// or to the reveal queue if they have sealed bids to reveal. Only auctions whose end time is
+// at or before the block time are loaded.
+func (k *Keeper) ProcessActiveAuctions(goCtx context.Context, budget *BlockBudget) error {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	logger := ctx.Logger()
-	var expired []uint64
+	var expired []auctiontypes.Auction
+	var revealing []auctiontypes.Auction
+
+	var numEnded int
+	logger.Info("Processing-Active :: Checking for ended active auctions")
+	rng := new(collections.Range[collections.Pair[time.Time, uint64]]).
+		EndInclusive(collections.Join(ctx.BlockTime(), uint64(math.MaxUint64)))
+	err := k.ActiveAuctionsByEndTime.Walk(goCtx, rng, func(key collections.Pair[time.Time, uint64]) (stop bool, err error) {
+		auction, err := k.Auctions.Get(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+		// Auctions ending exactly at the block time have not ended yet and stay indexed.
+		// Sealed-bid auctions without bids have nothing to reveal and expire with bidding.
+		var reveal bool
+		if ra, ok := auction.(auctiontypes.RevealingAuction); ok {
+			if !ra.IsBiddingClosed(ctx.BlockTime()) {
+				return false, nil
+			}
+			reveal = ra.NumBids() > 0
+		} else if !auction.IsExpired(ctx.BlockTime()) {
+			return false, nil
+		}
+		numEnded++
+
+		if !budget.takeUnreserved() {
+			return false, nil
+		}
+		if reveal {
+			revealing = append(revealing, auction)
+		} else {
+			expired = append(expired, auction)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	logger.Info(fmt.Sprintf("Processing-Active :: Number of ended active auctions: %d", numEnded))
+	for _, exp := range expired {
+		err = k.SetAuctionStatus(goCtx, exp, auctiontypes.EXPIRED)
+		if err != nil {
+			return err
+		}
+		logger.Info(fmt.Sprintf("Processing-Active :: Pushed to Expired: %d", exp.GetId()))
+
+		err = ctx.EventManager().EmitTypedEvent(&auctiontypes.EventAuctionExpired{
+			AuctionId:   exp.GetId(),
+			AuctionType: exp.GetType(),
+			Owner:       exp.GetOwner(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Sealed-bid auctions with bids enter their reveal phase before expiring. They remain
+	// active while bids are revealed.
+	for _, r := range revealing {
+		err = k.ActiveAuctions.Remove(goCtx, r.GetId())
+		if err != nil {
+			return err
+		}
 
-	var numActive int
-	logger.Info("Processing-Active :: Checking for active auctions")
-	err := k.ActiveAuctions.Walk(goCtx, nil, func(auctionId uint64) (stop bool, err error) {
+		err = k.ActiveAuctionsByEndTime.Remove(goCtx, collections.Join(r.GetEndTime(), r.GetId()))
+		if err != nil {
+			return err
+		}
+
+		err = k.RevealAuctions.Set(goCtx, r.GetId())
+		if err != nil {
+			return err
+		}
+		logger.Info(fmt.Sprintf("Processing-Active :: Pushed to Reveal: %d", r.GetId()))
+
+		err = ctx.EventManager().EmitTypedEvent(&auctiontypes.EventAuctionRevealing{
+			AuctionId:   r.GetId(),
+			AuctionType: r.GetType(),
+			Owner:       r.GetOwner(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ProcessRevealAuctions settles the unrevealed bids of sealed-bid auctions whose reveal
+// phase has ended and pushes them to the expired queue.
+func (k *Keeper) ProcessRevealAuctions(goCtx context.Context, budget *BlockBudget) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	logger := ctx.Logger()
+	logger.Info("Processing-Reveal :: Checking for auctions in their reveal phase")
+
+	var numRevealing int
+	var expired []auctiontypes.Auction
+	err := k.RevealAuctions.Walk(goCtx, nil, func(auctionId uint64) (stop bool, err error) {
 		auction, err := k.Auctions.Get(ctx, auctionId)
 		if err != nil {
 			return true, err
 		}
-		// TODO: Auction checks itself for expiration
-		if auction.IsExpired(ctx.BlockTime()) {
-			expired = append(expired, auctionId)
-		} else {
-			numActive++
+
+		if !auction.IsExpired(ctx.BlockTime()) {
+			numRevealing++
+		} else if budget.takeUnreserved() {
+			expired = append(expired, auction)
 		}
 		return false, nil
 	})
 	if err != nil {
 		return err
 	}
-	logger.Info(fmt.Sprintf("Processing-Active :: Number of active auctions: %d", numActive))
+	logger.Info(fmt.Sprintf("Processing-Reveal :: Number of auctions in their reveal phase: %d", numRevealing))
+
 	for _, exp := range expired {
-		err = k.ActiveAuctions.Remove(goCtx, exp)
+		if !k.resolver.HasType(exp.GetType()) {
+			return fmt.Errorf("auction type %s is not registered", exp.GetType())
+		}
+		rh, ok := k.resolver.GetHandler(exp.GetType()).(auctiontypes.RevealHandler)
+		if !ok {
+			return fmt.Errorf("auction type %s does not accept sealed bids", exp.GetType())
+		}
+
+		bids, err := k.GetAuctionBids(goCtx, exp.GetId())
 		if err != nil {
 			return err
 		}
-		logger.Info(fmt.Sprintf("Processing-Active :: Removed Auction ID: %d", exp))
 
-		err = k.ExpiredAuctions.Set(goCtx, exp)
+		// Refund or forfeit bids that were never revealed
+		err = rh.FinalizeReveal(goCtx, exp, bids)
+		if err != nil {
+			return err
+		}
+
+		err = k.SetAuctionStatus(goCtx, exp, auctiontypes.EXPIRED)
+		if err != nil {
+			return err
+		}
+		logger.Info(fmt.Sprintf("Processing-Reveal :: Pushed to Expired: %d", exp.GetId()))
+
+		err = ctx.EventManager().EmitTypedEvent(&auctiontypes.EventAuctionExpired{
+			AuctionId:   exp.GetId(),
+			AuctionType: exp.GetType(),
+			Owner:       exp.GetOwner(),
+		})
 		if err != nil {
 			return err
 		}
-		logger.Info(fmt.Sprintf("Processing-Active :: Pushed to Expired: %d", exp))
 	}
 	return nil
 }
 
-func (k *Keeper) ProcessExpiredAuctions(goCtx context.Context) error {
+func (k *Keeper) ProcessExpiredAuctions(goCtx context.Context, budget *BlockBudget) error {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	logger := ctx.Logger()
 	logger.Info("Processing-Expired :: Checking for expired auctions")
 	var numExpired int
 
-	var pending []uint64
-	var cancelled []uint64
+	var pending []auctiontypes.Auction
+	var cancelled []auctiontypes.Auction
 	err := k.ExpiredAuctions.Walk(goCtx, nil, func(auctionId uint64) (stop bool, err error) {
 		auction, err := k.Auctions.Get(ctx, auctionId)
 		if err != nil {
 			return true, err
 		}
 
+		numExpired++
+		if !budget.takeUnreserved() {
+			return false, nil
+		}
+
 		// TODO: Auction executes own logic for this
-		if auction.HasBids() {
-			pending = append(pending, auctionId)
+		// Auctions with a leading bid can be settled
+		if auction.GetLeadingBid() != nil {
+			pending = append(pending, auction)
 		} else {
-			cancelled = append(cancelled, auctionId)
+			cancelled = append(cancelled, auction)
 		}
-		numExpired++
 		return false, nil
 	})
 	if err != nil {
@@ -179,34 +338,30 @@ func (k *Keeper) ProcessExpiredAuctions(goCtx context.Context) error {
 	// If no bids -> cancelled
 	logger.Info("Processing-Expired :: Checking for cancelled auctions")
 	for _, c := range cancelled {
-		err = k.ExpiredAuctions.Remove(goCtx, c)
-		if err != nil {
-			return err
-		}
-		logger.Info(fmt.Sprintf("Processing-Expired :: Removed Auction ID without bids from expired: %d", c))
-
-		err = k.CancelledAuctions.Set(goCtx, c)
+		err = k.cancelAuction(goCtx, c, auctiontypes.CancelReasonNoBids)
 		if err != nil {
 			return err
 		}
-		logger.Info(fmt.Sprintf("Processing-Expired :: Pushed Auction ID without bids to cancelled: %d", c))
-
+		logger.Info(fmt.Sprintf("Processing-Expired :: Pushed Auction ID without bids to cancelled: %d", c.GetId()))
 	}
 	// If at least 1 bid -> pending
 	logger.Info("Processing-Expired :: Checking for pending auctions")
 	for _, p := range pending {
-		err = k.ExpiredAuctions.Remove(goCtx, p)
+		err = k.SetAuctionStatus(goCtx, p, auctiontypes.PENDING)
 		if err != nil {
 			return err
 		}
-		logger.Info(fmt.Sprintf("Processing-Expired :: Removed Auction ID with bids from expired: %d", p))
-
-		err = k.PendingAuctions.Set(goCtx, p)
+		logger.Info(fmt.Sprintf("Processing-Expired :: Pushed Auction ID with bids to pending: %d", p.GetId()))
+
+		err = ctx.EventManager().EmitTypedEvent(&auctiontypes.EventAuctionPending{
+			AuctionId:   p.GetId(),
+			AuctionType: p.GetType(),
+			Owner:       p.GetOwner(),
+			NumBids:     p.NumBids(),
+		})
 		if err != nil {
 			return err
 		}
-		logger.Info(fmt.Sprintf("Processing-Expired :: Pushed Auction ID with bids to pending: %d", p))
-
 	}
 	return nil
 }
@@ -234,23 +389,108 @@ func (k *Keeper) GetPending(goCtx context.Context) error {
 	return nil
 }
 
-func (k *Keeper) PurgeCancelledAuctions(ctx context.Context) error {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
+// ProcessPendingAuctions cancels pending auctions that were not executed by their execution
+// deadline. Their funds are returned by ProcessCancelledAuctions. Pending auctions with auto
+// execution enabled are executed.
+func (k *Keeper) ProcessPendingAuctions(goCtx context.Context, budget *BlockBudget) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	logger := ctx.Logger()
+	logger.Info("Processing-Pending :: Checking for pending auctions")
 
-	err := k.CancelledAuctions.Walk(sdkCtx, nil, func(auctionId uint64) (stop bool, err error) {
-		err = k.CancelledAuctions.Remove(ctx, auctionId)
+	params, err := k.Params.Get(goCtx)
+	if err != nil {
+		return err
+	}
+
+	var numPending int
+	var timedOut []auctiontypes.Auction
+	var autoExecute []auctiontypes.Auction
+	err = k.PendingAuctions.Walk(goCtx, nil, func(auctionId uint64) (stop bool, err error) {
+		auction, err := k.Auctions.Get(ctx, auctionId)
 		if err != nil {
 			return true, err
 		}
+
+		var pastDeadline bool
+		deadline, err := k.ExecutionDeadlines.Get(ctx, auctionId)
+		switch {
+		case errors.Is(err, collections.ErrNotFound):
+			// Auctions pending before deadlines were recorded must be executed within the
+			// execution duration of their end time
+			pastDeadline = auction.IsExpired(ctx.BlockTime().Add(-params.ExecutionDuration))
+		case err != nil:
+			return true, err
+		default:
+			pastDeadline = ctx.BlockTime().After(deadline)
+		}
+
+		switch {
+		case pastDeadline:
+			if budget.take() {
+				timedOut = append(timedOut, auction)
+			}
+		case auction.GetAutoExecute():
+			if budget.take() {
+				autoExecute = append(autoExecute, auction)
+			}
+		default:
+			numPending++
+		}
 		return false, nil
 	})
+	if err != nil {
+		return err
+	}
+	logger.Info(fmt.Sprintf("Processing-Pending :: Number of pending auctions: %d", numPending))
+
+	for _, p := range timedOut {
+		err = k.cancelAuction(goCtx, p, auctiontypes.CancelReasonExecutionTimeout)
+		if err != nil {
+			return err
+		}
+		logger.Info(fmt.Sprintf("Processing-Pending :: Pushed Auction ID past execution deadline to cancelled: %d", p.GetId()))
+	}
+
+	for _, p := range autoExecute {
+		err = k.autoExecuteAuction(ctx, p)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ProcessCancelledAuctions returns the funds of every auction in the cancelled queue. Escrowed
+// bids are refunded to their bidders, the deposit is refunded to the owner and the auction is
+// removed from the queue.
+func (k *Keeper) ProcessCancelledAuctions(goCtx context.Context, budget *BlockBudget) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	logger := ctx.Logger()
+	logger.Info("Processing-Cancelled :: Checking for cancelled auctions")
 
+	var cancelled []auctiontypes.Auction
+	err := k.CancelledAuctions.Walk(goCtx, nil, func(auctionId uint64) (stop bool, err error) {
+		auction, err := k.Auctions.Get(ctx, auctionId)
+		if err != nil {
+			return true, err
+		}
+		if budget.take() {
+			cancelled = append(cancelled, auction)
+		}
+		return false, nil
+	})
 	if err != nil {
-		k.Logger().Error("Failed to purge cancelled auctions", "error", err)
 		return err
 	}
+	logger.Info(fmt.Sprintf("Processing-Cancelled :: Number of cancelled auctions to refund: %d", len(cancelled)))
 
-	k.Logger().Info("All cancelled auctions have been purged")
+	for _, c := range cancelled {
+		err = k.refundCancelledAuction(goCtx, c)
+		if err != nil {
+			return err
+		}
+		logger.Info(fmt.Sprintf("Processing-Cancelled :: Refunded and removed Auction ID from cancelled: %d", c.GetId()))
+	}
 	return nil
 }
 
@@ -277,35 +517,299 @@ func (k *Keeper) GetCancelledAuctions(goCtx context.Context) error {
 	return nil
 }
 
-// CancelAuction marks an auction as cancelled by its ID.
-func (k *Keeper) CancelAuction(ctx context.Context, auctionId uint64) error {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-
-	_, err := k.Auctions.Get(sdkCtx, auctionId)
+// CancelAuction cancels a created or active auction on behalf of its owner. The auction must
+// not have received any bids. The auction is moved to the cancelled queue and its funds are
+// returned at the end of the block.
+func (k *Keeper) CancelAuction(ctx context.Context, sender sdk.AccAddress, auctionId uint64) error {
+	auction, err := k.Auctions.Get(ctx, auctionId)
 	if err != nil {
-		return fmt.Errorf("auction with ID %d not found: %v", auctionId, err)
+		return errorsmod.Wrapf(auctiontypes.ErrAuctionNotFound, "auction with ID %d not found: %v", auctionId, err)
+	}
+
+	if auction.GetOwner() != sender.String() {
+		return errorsmod.Wrapf(auctiontypes.ErrNotAuctionOwner, "%s cannot cancel auction %d", sender, auctionId)
+	}
+
+	if status := auction.GetStatus(); status != auctiontypes.CREATED && status != auctiontypes.ACTIVE {
+		return errorsmod.Wrapf(auctiontypes.ErrAuctionNotActive, "auction %d is %s and cannot be cancelled", auctionId, status)
+	}
+
+	if auction.HasBids() {
+		return errorsmod.Wrapf(auctiontypes.ErrAuctionHasBids, "auction %d cannot be cancelled", auctionId)
 	}
 
-	err = k.CancelledAuctions.Set(sdkCtx, auctionId)
+	err = k.cancelAuction(ctx, auction, auctiontypes.CancelReasonOwner)
 	if err != nil {
-		return fmt.Errorf("failed to cancel auction with ID %d: %v", auctionId, err)
+		return err
 	}
 
 	k.Logger().Info("Auction cancelled", "auctionId", auctionId)
 	return nil
 }
 
-func (k *Keeper) GetAllAuctions(ctx sdk.Context) ([]auctiontypes.Auction, error) {
-	//var auctions []auctiontypes.ReserveAuction
-	//
-	//err := k.Auctions.Walk(ctx, nil, func(id uint64, auction auctiontypes.ReserveAuction) (stop bool, err error) {
-	//	auctions = append(auctions, auction)
-	//	return false, nil
-	//})
-	//if err != nil {
-	//	panic(err)
-	//}
-	//
-	//return auctions
-	return nil, nil
+// cancelAuction moves an auction to the cancelled queue, where its funds are returned by
+// ProcessCancelledAuctions.
+func (k *Keeper) cancelAuction(ctx context.Context, auction auctiontypes.Auction, reason string) error {
+	err := k.SetAuctionStatus(ctx, auction, auctiontypes.CANCELLED)
+	if err != nil {
+		return fmt.Errorf("failed to cancel auction with ID %d: %w", auction.GetId(), err)
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&auctiontypes.EventAuctionCancelled{
+		AuctionId:   auction.GetId(),
+		AuctionType: auction.GetType(),
+		Owner:       auction.GetOwner(),
+		Reason:      reason,
+	})
+}
+
+// refundCancelledAuction returns the escrowed bids of a cancelled auction through its auction
+// type's handler, refunds the owner's deposit and removes the auction from the cancelled queue.
+func (k *Keeper) refundCancelledAuction(ctx context.Context, auction auctiontypes.Auction) error {
+	auctionId := auction.GetId()
+	if !k.resolver.HasType(auction.GetType()) {
+		return fmt.Errorf("auction type %s is not registered", auction.GetType())
+	}
+
+	bids, err := k.GetAuctionBids(ctx, auctionId)
+	if err != nil {
+		return err
+	}
+
+	// Return escrowed funds
+	err = k.resolver.GetHandler(auction.GetType()).CancelAuction(ctx, auction, bids)
+	if err != nil {
+		return fmt.Errorf("failed to return escrowed funds for auction with ID %d: %w", auctionId, err)
+	}
+
+	// Refund auction deposit
+	if !auction.GetDeposit().IsZero() {
+		owner := sdk.MustAccAddressFromBech32(auction.GetOwner())
+		err = k.bk.SendCoinsFromModuleToAccount(ctx, auctiontypes.ModuleName, owner, auction.GetDeposit())
+		if err != nil {
+			return fmt.Errorf("failed to refund deposit for auction with ID %d: %w", auctionId, err)
+		}
+
+		err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&auctiontypes.EventFundsRefunded{
+			AuctionId:   auctionId,
+			AuctionType: auction.GetType(),
+			Owner:       auction.GetOwner(),
+			Recipient:   auction.GetOwner(),
+			Amount:      auction.GetDeposit(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return k.CancelledAuctions.Remove(ctx, auctionId)
+}
+
+// autoExecuteAuction executes a pending auction from the EndBlocker. Execution runs in a
+// cached context and is only committed if it succeeds, so a failing auction cannot halt the
+// EndBlocker. An auction that fails to execute has auto execution turned off and stays
+// pending, where it can be executed with MsgExecAuction until its execution deadline.
+func (k *Keeper) autoExecuteAuction(ctx sdk.Context, auction auctiontypes.Auction) error {
+	cacheCtx, write := ctx.CacheContext()
+	execErr := k.safeExecuteAuction(cacheCtx, auction)
+	if execErr == nil {
+		write()
+		ctx.Logger().Info(fmt.Sprintf("Processing-Pending :: Auto executed Auction ID: %d", auction.GetId()))
+		return nil
+	}
+	ctx.Logger().Error(fmt.Sprintf("Processing-Pending :: Failed to auto execute Auction ID: %d", auction.GetId()), "err", execErr)
+
+	// Execution may have modified the auction, so reload it from the uncached store
+	auctionId := auction.GetId()
+	auction, err := k.Auctions.Get(ctx, auctionId)
+	if err != nil {
+		return err
+	}
+	auction.SetAutoExecute(false)
+	err = k.Auctions.Set(ctx, auctionId, auction)
+	if err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&auctiontypes.EventAutoExecuteFailed{
+		AuctionId:   auctionId,
+		AuctionType: auction.GetType(),
+		Owner:       auction.GetOwner(),
+		Error:       execErr.Error(),
+	})
+}
+
+// safeExecuteAuction executes and settles a pending auction, recovering from any panic raised
+// while executing it.
+func (k *Keeper) safeExecuteAuction(ctx sdk.Context, auction auctiontypes.Auction) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("auction %d panicked during execution: %v", auction.GetId(), r)
+		}
+	}()
+
+	err = k.ExecuteAuction(ctx, auction)
+	if err != nil {
+		return err
+	}
+
+	err = k.SetAuctionStatus(ctx, auction, auctiontypes.SETTLED)
+	if err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&auctiontypes.EventAuctionExecuted{
+		AuctionId:   auction.GetId(),
+		AuctionType: auction.GetType(),
+		Owner:       auction.GetOwner(),
+		Executor:    authtypes.NewModuleAddress(auctiontypes.ModuleName).String(),
+		Deposit:     auction.GetDeposit(),
+	})
+}
+
+// SetAuctionStatus moves an auction to a new status. The move must be allowed by the status
+// transition table. The auction is moved from the queue of its old status to the queue of
+// its new status and saved, so its status always matches the queue holding it. An auction
+// entering the pending queue must be executed within the execution duration set in the
+// module params.
+func (k *Keeper) SetAuctionStatus(ctx context.Context, auction auctiontypes.Auction, status auctiontypes.AuctionStatus) error {
+	err := auctiontypes.ValidateStatusTransition(auction.GetStatus(), status)
+	if err != nil {
+		return errorsmod.Wrapf(err, "auction %d", auction.GetId())
+	}
+
+	switch auction.GetStatus() {
+	case auctiontypes.ACTIVE:
+		err = k.ActiveAuctionsByEndTime.Remove(ctx, collections.Join(auction.GetEndTime(), auction.GetId()))
+		if err != nil {
+			return err
+		}
+	case auctiontypes.PENDING:
+		err = k.ExecutionDeadlines.Remove(ctx, auction.GetId())
+		if err != nil {
+			return err
+		}
+	}
+	if status == auctiontypes.ACTIVE {
+		err = k.ActiveAuctionsByEndTime.Set(ctx, collections.Join(auction.GetEndTime(), auction.GetId()))
+		if err != nil {
+			return err
+		}
+	}
+	if status == auctiontypes.PENDING {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return err
+		}
+
+		deadline := sdk.UnwrapSDKContext(ctx).BlockTime().Add(params.ExecutionDuration)
+		err = k.ExecutionDeadlines.Set(ctx, auction.GetId(), deadline)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, q := range k.statusQueues(auction.GetStatus()) {
+		err = q.Remove(ctx, auction.GetId())
+		if err != nil {
+			return err
+		}
+	}
+
+	// An auction entering a status is pushed to the first queue holding it
+	if queues := k.statusQueues(status); len(queues) > 0 {
+		err = queues[0].Set(ctx, auction.GetId())
+		if err != nil {
+			return err
+		}
+	}
+
+	auction.UpdateStatus(status)
+	return k.Auctions.Set(ctx, auction.GetId(), auction)
+}
+
+// statusQueues returns the queues that may hold an auction with the given status. Active
+// auctions are held in the active queue, or in the reveal queue during their reveal phase.
+// Cancelled auctions are held in the cancelled queue until their funds are returned. Created
+// and settled auctions are not held in any queue.
+func (k *Keeper) statusQueues(status auctiontypes.AuctionStatus) []collections.KeySet[uint64] {
+	switch status {
+	case auctiontypes.ACTIVE:
+		return []collections.KeySet[uint64]{k.ActiveAuctions, k.RevealAuctions}
+	case auctiontypes.EXPIRED:
+		return []collections.KeySet[uint64]{k.ExpiredAuctions}
+	case auctiontypes.PENDING:
+		return []collections.KeySet[uint64]{k.PendingAuctions}
+	case auctiontypes.CANCELLED:
+		return []collections.KeySet[uint64]{k.CancelledAuctions}
+	default:
+		return nil
+	}
+}
+
+// CloseAuction moves an active auction that closed on an accepted bid straight to the
+// pending queue, where it is ready to be executed.
+func (k *Keeper) CloseAuction(ctx context.Context, auction auctiontypes.Auction) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	err := k.SetAuctionStatus(ctx, auction, auctiontypes.PENDING)
+	if err != nil {
+		return err
+	}
+
+	return sdkCtx.EventManager().EmitTypedEvent(&auctiontypes.EventAuctionPending{
+		AuctionId:   auction.GetId(),
+		AuctionType: auction.GetType(),
+		Owner:       auction.GetOwner(),
+		NumBids:     auction.NumBids(),
+	})
+}
+
+// GetAllAuctions returns every auction in the store ordered by ID.
+func (k *Keeper) GetAllAuctions(ctx context.Context) ([]auctiontypes.Auction, error) {
+	var auctions []auctiontypes.Auction
+	err := k.Auctions.Walk(ctx, nil, func(id uint64, auction auctiontypes.Auction) (stop bool, err error) {
+		auctions = append(auctions, auction)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return auctions, nil
+}
+
+// GetAuctionBids returns the bids placed on an auction in the order they were accepted.
+func (k *Keeper) GetAuctionBids(ctx context.Context, auctionId uint64) ([]*auctiontypes.Bid, error) {
+	var bids []*auctiontypes.Bid
+	rng := collections.NewPrefixedPairRange[uint64, uint64](auctionId)
+	err := k.Bids.Walk(ctx, rng, func(_ collections.Pair[uint64, uint64], bid auctiontypes.Bid) (stop bool, err error) {
+		bids = append(bids, &bid)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return bids, nil
+}
+
+// GetBidderBids returns the bids an address placed on an auction in the order they were
+// accepted.
+func (k *Keeper) GetBidderBids(ctx context.Context, bidder sdk.AccAddress, auctionId uint64) ([]*auctiontypes.Bid, error) {
+	var bids []*auctiontypes.Bid
+	rng := collections.NewSuperPrefixedTripleRange[sdk.AccAddress, uint64, uint64](bidder, auctionId)
+	err := k.BidderBids.Walk(ctx, rng, func(key collections.Triple[sdk.AccAddress, uint64, uint64]) (stop bool, err error) {
+		bid, err := k.Bids.Get(ctx, collections.Join(auctionId, key.K3()))
+		if err != nil {
+			return true, err
+		}
+		bids = append(bids, &bid)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return bids, nil
 }
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	at "github.com/fatal-fruit/auction/auctiontypes"
+	auctiontestutil "github.com/fatal-fruit/auction/testutil"
+	auctiontypes "github.com/fatal-fruit/auction/types"
+)
+
+// BenchmarkProcessActiveAuctions compares the per-block cost of finding ended auctions by
+// walking the active queue against ranging over the end time index. No auction has ended,
+// which is the common case for a block.
+func BenchmarkProcessActiveAuctions(b *testing.B) {
+	for _, n := range []int{10_000, 100_000} {
+		f := auctiontestutil.InitFixture(b)
+		blockTime := time.Unix(1700000000, 0).UTC()
+		f.Ctx = f.Ctx.WithBlockTime(blockTime)
+		for i := 0; i < n; i++ {
+			id := uint64(i)
+			end := blockTime.Add(time.Duration(i+1) * time.Second)
+			auction := &at.ReserveAuction{
+				Id:       id,
+				Status:   auctiontypes.ACTIVE,
+				Owner:    f.Addrs[0].String(),
+				Metadata: &at.ReserveAuctionMetadata{StartTime: blockTime, EndTime: end},
+			}
+			if err := f.K.Auctions.Set(f.Ctx, id, auction); err != nil {
+				b.Fatal(err)
+			}
+			if err := f.K.ActiveAuctions.Set(f.Ctx, id); err != nil {
+				b.Fatal(err)
+			}
+			if err := f.K.ActiveAuctionsByEndTime.Set(f.Ctx, collections.Join(end, id)); err != nil {
+				b.Fatal(err)
+			}
+		}
+
+		b.Run(fmt.Sprintf("queue walk/n=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				var expired []auctiontypes.Auction
+				err := f.K.ActiveAuctions.Walk(f.Ctx, nil, func(id uint64) (stop bool, err error) {
+					auction, err := f.K.Auctions.Get(f.Ctx, id)
+					if err != nil {
+						return true, err
+					}
+					if auction.IsExpired(f.Ctx.BlockTime()) {
+						expired = append(expired, auction)
+					}
+					return false, nil
+				})
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("end time index/n=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if err := f.K.ProcessActiveAuctions(f.Ctx, nil); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
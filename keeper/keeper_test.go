@@ -1,11 +1,15 @@
 package keeper_test
 
 import (
+	"slices"
 	"testing"
 	"time"
 
+	"cosmossdk.io/collections"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	at "github.com/fatal-fruit/auction/auctiontypes"
+	"github.com/fatal-fruit/auction/keeper"
 	auctiontestutil "github.com/fatal-fruit/auction/testutil"
 	auctiontypes "github.com/fatal-fruit/auction/types"
 	"github.com/stretchr/testify/require"
@@ -26,7 +30,6 @@ func TestProcessActiveAuctions(t *testing.T) {
 			ReservePrice: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000),
 			StartTime:    time.Now().Add(-30 * time.Second),
 			EndTime:      time.Now().Add(-1 * time.Second),
-			Bids:         []*auctiontypes.Bid{},
 		},
 	}
 
@@ -34,8 +37,10 @@ func TestProcessActiveAuctions(t *testing.T) {
 	require.NoError(err)
 	err = f.K.ActiveAuctions.Set(f.Ctx, id)
 	require.NoError(err)
+	err = f.K.ActiveAuctionsByEndTime.Set(f.Ctx, collections.Join(auction.GetEndTime(), id))
+	require.NoError(err)
 
-	err = f.K.ProcessActiveAuctions(f.Ctx)
+	err = f.K.ProcessActiveAuctions(f.Ctx, nil)
 	require.NoError(err)
 	isActive, err := f.K.ActiveAuctions.Has(f.Ctx, id)
 	require.False(isActive)
@@ -57,7 +62,7 @@ func TestProcessExpiredAuctions(t *testing.T) {
 	auctions := []at.ReserveAuction{
 		{
 			Id:          id1,
-			Status:      auctiontypes.ACTIVE,
+			Status:      auctiontypes.EXPIRED,
 			Owner:       f.Addrs[0].String(),
 			AuctionType: f.ReserveAuctionType,
 			Metadata: &at.ReserveAuctionMetadata{
@@ -65,26 +70,24 @@ func TestProcessExpiredAuctions(t *testing.T) {
 				StartTime:    time.Now().Add(-30 * time.Second),
 				EndTime:      time.Now().Add(-1 * time.Second),
 				LastPrice:    sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100),
-				Bids: []*auctiontypes.Bid{
-					{
-						AuctionId: id1,
-						Bidder:    f.Addrs[1].String(),
-						BidPrice:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100),
-						Timestamp: time.Now(),
-					},
+				HighestBid: &auctiontypes.Bid{
+					AuctionId: id1,
+					Bidder:    f.Addrs[1].String(),
+					BidPrice:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100),
+					Timestamp: time.Now(),
 				},
+				NumBids: 1,
 			},
 		},
 		{
 			Id:          id2,
-			Status:      auctiontypes.ACTIVE,
+			Status:      auctiontypes.EXPIRED,
 			Owner:       f.Addrs[0].String(),
 			AuctionType: f.ReserveAuctionType,
 			Metadata: &at.ReserveAuctionMetadata{
 				ReservePrice: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000),
 				StartTime:    time.Now().Add(-30 * time.Second),
 				EndTime:      time.Now().Add(-1 * time.Second),
-				Bids:         []*auctiontypes.Bid{},
 			},
 		},
 	}
@@ -102,7 +105,7 @@ func TestProcessExpiredAuctions(t *testing.T) {
 		require.NoError(err)
 	}
 
-	err = f.K.ProcessExpiredAuctions(f.Ctx)
+	err = f.K.ProcessExpiredAuctions(f.Ctx, nil)
 	require.NoError(err)
 
 	for _, a := range auctions {
@@ -121,8 +124,6 @@ func TestProcessExpiredAuctions(t *testing.T) {
 }
 
 func TestGetAllAuctions(t *testing.T) {
-	// TODO: Fix
-	t.Skip()
 	f := auctiontestutil.InitFixture(t)
 	require := require.New(t)
 
@@ -134,7 +135,7 @@ func TestGetAllAuctions(t *testing.T) {
 	auctions := []at.ReserveAuction{
 		{
 			Id:          id1,
-			Status:      auctiontypes.ACTIVE,
+			Status:      auctiontypes.EXPIRED,
 			Owner:       f.Addrs[0].String(),
 			AuctionType: f.ReserveAuctionType,
 			Metadata: &at.ReserveAuctionMetadata{
@@ -142,26 +143,24 @@ func TestGetAllAuctions(t *testing.T) {
 				StartTime:    time.Now().Add(-30 * time.Second),
 				EndTime:      time.Now().Add(-1 * time.Second),
 				LastPrice:    sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100),
-				Bids: []*auctiontypes.Bid{
-					{
-						AuctionId: id1,
-						Bidder:    f.Addrs[1].String(),
-						BidPrice:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100),
-						Timestamp: time.Now(),
-					},
+				HighestBid: &auctiontypes.Bid{
+					AuctionId: id1,
+					Bidder:    f.Addrs[1].String(),
+					BidPrice:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100),
+					Timestamp: time.Now(),
 				},
+				NumBids: 1,
 			},
 		},
 		{
 			Id:          id2,
-			Status:      auctiontypes.ACTIVE,
+			Status:      auctiontypes.EXPIRED,
 			Owner:       f.Addrs[0].String(),
 			AuctionType: f.ReserveAuctionType,
 			Metadata: &at.ReserveAuctionMetadata{
 				ReservePrice: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000),
 				StartTime:    time.Now().Add(-30 * time.Second),
 				EndTime:      time.Now().Add(-1 * time.Second),
-				Bids:         []*auctiontypes.Bid{},
 			},
 		},
 	}
@@ -174,27 +173,255 @@ func TestGetAllAuctions(t *testing.T) {
 	}
 
 	res, err := f.K.GetAllAuctions(f.Ctx)
+	require.NoError(err)
 	require.Equal(len(res), len(auctions))
 }
 
-func TestPurgeCancelledAuctions(t *testing.T) {
-	// TODO: Fix
-	t.Skip()
+func TestSetAuctionStatus(t *testing.T) {
 	f := auctiontestutil.InitFixture(t)
 	require := require.New(t)
 
-	for i := 0; i < 3; i++ {
-		id, err := f.K.IDs.Next(f.Ctx)
-		require.NoError(err)
-		err = f.K.CancelledAuctions.Set(f.Ctx, id)
+	id, err := f.K.IDs.Next(f.Ctx)
+	require.NoError(err)
+	auction := &at.ReserveAuction{
+		Id:          id,
+		Status:      auctiontypes.CREATED,
+		Owner:       f.Addrs[0].String(),
+		AuctionType: f.ReserveAuctionType,
+		Metadata:    &at.ReserveAuctionMetadata{},
+	}
+	require.NoError(f.K.Auctions.Set(f.Ctx, id, auction))
+
+	// Sealed-bid auctions in their reveal phase are active
+	require.NoError(f.K.SetAuctionStatus(f.Ctx, auction, auctiontypes.ACTIVE))
+	require.NoError(f.K.ActiveAuctions.Remove(f.Ctx, id))
+	require.NoError(f.K.RevealAuctions.Set(f.Ctx, id))
+
+	steps := []struct {
+		status auctiontypes.AuctionStatus
+		queue  *collections.KeySet[uint64]
+	}{
+		{auctiontypes.EXPIRED, &f.K.ExpiredAuctions},
+		{auctiontypes.PENDING, &f.K.PendingAuctions},
+		{auctiontypes.SETTLED, nil},
+	}
+	queues := []*collections.KeySet[uint64]{&f.K.ActiveAuctions, &f.K.RevealAuctions, &f.K.ExpiredAuctions, &f.K.PendingAuctions, &f.K.CancelledAuctions}
+	for _, step := range steps {
+		require.NoError(f.K.SetAuctionStatus(f.Ctx, auction, step.status))
+
+		stored, err := f.K.Auctions.Get(f.Ctx, id)
 		require.NoError(err)
+		require.Equal(step.status, stored.GetStatus())
+
+		// The auction is held by the queue of its status only
+		for _, q := range queues {
+			has, err := q.Has(f.Ctx, id)
+			require.NoError(err)
+			require.Equal(q == step.queue, has)
+		}
 	}
 
-	err := f.K.PurgeCancelledAuctions(f.Ctx)
+	// Settled auctions cannot be moved
+	err = f.K.SetAuctionStatus(f.Ctx, auction, auctiontypes.CANCELLED)
+	require.ErrorIs(err, auctiontypes.ErrInvalidTransition)
+	stored, err := f.K.Auctions.Get(f.Ctx, id)
+	require.NoError(err)
+	require.Equal(auctiontypes.SETTLED, stored.GetStatus())
+	isCancelled, err := f.K.CancelledAuctions.Has(f.Ctx, id)
+	require.NoError(err)
+	require.False(isCancelled)
+}
+
+func TestActiveAuctionsByEndTime(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	require := require.New(t)
+
+	id, err := f.K.IDs.Next(f.Ctx)
+	require.NoError(err)
+	auction := &at.ReserveAuction{
+		Id:          id,
+		Status:      auctiontypes.CREATED,
+		Owner:       f.Addrs[0].String(),
+		AuctionType: f.ReserveAuctionType,
+		Metadata: &at.ReserveAuctionMetadata{
+			ReservePrice:      sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000),
+			Duration:          5 * time.Minute,
+			ExtensionWindow:   time.Minute,
+			ExtensionDuration: 2 * time.Minute,
+			SettlementStrategy: codectypes.UnsafePackAny(&at.SettleStrategy{
+				StrategyType:          auctiontypes.SETTLE,
+				EscrowContractId:      id,
+				EscrowContractAddress: f.Addrs[2].String(),
+			}),
+		},
+	}
+	auction.StartAuction(f.Ctx.BlockTime())
+	end := auction.GetEndTime()
+	require.NoError(f.K.SetAuctionStatus(f.Ctx, auction, auctiontypes.ACTIVE))
+
+	indexed, err := f.K.ActiveAuctionsByEndTime.Has(f.Ctx, collections.Join(end, id))
+	require.NoError(err)
+	require.True(indexed)
+
+	// A bid inside the extension window moves the indexed end time
+	ctx := f.Ctx.WithBlockTime(end.Add(-30 * time.Second))
+	bid := sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100)
+	f.MockBankKeeper.EXPECT().SendCoins(ctx, f.Addrs[1], f.Addrs[2], sdk.Coins{bid}).Return(nil).Times(1)
+	_, err = f.MsgServer.NewBid(ctx, &auctiontypes.MsgNewBid{AuctionId: id, Owner: f.Addrs[1].String(), BidAmount: bid})
+	require.NoError(err)
+
+	extended := end.Add(2 * time.Minute)
+	indexed, err = f.K.ActiveAuctionsByEndTime.Has(ctx, collections.Join(end, id))
+	require.NoError(err)
+	require.False(indexed)
+	indexed, err = f.K.ActiveAuctionsByEndTime.Has(ctx, collections.Join(extended, id))
 	require.NoError(err)
+	require.True(indexed)
+
+	// The auction is only expired once the extended end time has passed
+	ctx = f.Ctx.WithBlockTime(extended)
+	require.NoError(f.K.ProcessActiveAuctions(ctx, nil))
+	isActive, err := f.K.ActiveAuctions.Has(ctx, id)
+	require.NoError(err)
+	require.True(isActive)
+
+	ctx = f.Ctx.WithBlockTime(extended.Add(time.Second))
+	require.NoError(f.K.ProcessActiveAuctions(ctx, nil))
+	isExpired, err := f.K.ExpiredAuctions.Has(ctx, id)
+	require.NoError(err)
+	require.True(isExpired)
+
+	// Auctions leave the index with the active queue
+	indexed, err = f.K.ActiveAuctionsByEndTime.Has(ctx, collections.Join(extended, id))
+	require.NoError(err)
+	require.False(indexed)
+}
+
+func TestProcessExpiredAuctionsBudget(t *testing.T) {
+	testCases := []struct {
+		name        string
+		limit       uint64
+		reserved    uint64
+		expPending  []uint64
+		expDeferred uint64
+	}{
+		{name: "unlimited", limit: 0, expPending: []uint64{0, 1, 2}},
+		{name: "under limit", limit: 8, reserved: 4, expPending: []uint64{0, 1, 2}},
+		{name: "at limit", limit: 6, reserved: 3, expPending: []uint64{0, 1, 2}},
+		// The reserved share is kept for timeouts and refunds
+		{name: "over limit", limit: 4, reserved: 2, expPending: []uint64{0, 1}, expDeferred: 1},
+		{name: "reserved share", limit: 2, reserved: 1, expPending: []uint64{0}, expDeferred: 2},
+		{name: "one reserved", limit: 4, reserved: 1, expPending: []uint64{0, 1, 2}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := auctiontestutil.InitFixture(t)
+			require := require.New(t)
+
+			for i := 0; i < 3; i++ {
+				id, err := f.K.IDs.Next(f.Ctx)
+				require.NoError(err)
+				auction := &at.ReserveAuction{
+					Id:          id,
+					Status:      auctiontypes.EXPIRED,
+					Owner:       f.Addrs[0].String(),
+					AuctionType: f.ReserveAuctionType,
+					Metadata: &at.ReserveAuctionMetadata{
+						ReservePrice: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000),
+						StartTime:    time.Now().Add(-30 * time.Second),
+						EndTime:      time.Now().Add(-1 * time.Second),
+						HighestBid: &auctiontypes.Bid{
+							AuctionId: id,
+							Bidder:    f.Addrs[1].String(),
+							BidPrice:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100),
+						},
+						NumBids: 1,
+					},
+				}
+				require.NoError(f.K.Auctions.Set(f.Ctx, id, auction))
+				require.NoError(f.K.ExpiredAuctions.Set(f.Ctx, id))
+			}
+
+			budget := keeper.NewBlockBudget(tc.limit, tc.reserved)
+			require.NoError(f.K.ProcessExpiredAuctions(f.Ctx, budget))
+			require.Equal(tc.expDeferred, budget.Deferred())
+
+			// Deferred auctions stay expired until a later block
+			for id := uint64(0); id < 3; id++ {
+				isPending, err := f.K.PendingAuctions.Has(f.Ctx, id)
+				require.NoError(err)
+				isExpired, err := f.K.ExpiredAuctions.Has(f.Ctx, id)
+				require.NoError(err)
+				require.Equal(slices.Contains(tc.expPending, id), isPending)
+				require.Equal(!isPending, isExpired)
+			}
+		})
+	}
+}
+
+func TestProcessCancelledAuctions(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	require := require.New(t)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("uasset", 1))
+	bidPrice := sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100)
+	newAuction := func(id uint64, leading *auctiontypes.Bid) *at.ReserveAuction {
+		var numBids uint64
+		if leading != nil {
+			numBids = 1
+		}
+		return &at.ReserveAuction{
+			Id:          id,
+			Status:      auctiontypes.CANCELLED,
+			Owner:       f.Addrs[0].String(),
+			AuctionType: f.ReserveAuctionType,
+			Deposit:     deposit,
+			Metadata: &at.ReserveAuctionMetadata{
+				ReservePrice: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000),
+				HighestBid:   leading,
+				NumBids:      numBids,
+				SettlementStrategy: codectypes.UnsafePackAny(&at.SettleStrategy{
+					StrategyType:          auctiontypes.SETTLE,
+					EscrowContractId:      id,
+					EscrowContractAddress: f.Addrs[2].String(),
+				}),
+			},
+		}
+	}
+
+	// An auction cancelled without bids and one that timed out with a bid in escrow
+	bidless, err := f.K.IDs.Next(f.Ctx)
+	require.NoError(err)
+	require.NoError(f.K.Auctions.Set(f.Ctx, bidless, newAuction(bidless, nil)))
+	require.NoError(f.K.CancelledAuctions.Set(f.Ctx, bidless))
+
+	timedOut, err := f.K.IDs.Next(f.Ctx)
+	require.NoError(err)
+	bid := auctiontypes.Bid{AuctionId: timedOut, Bidder: f.Addrs[1].String(), BidPrice: bidPrice}
+	require.NoError(f.K.Auctions.Set(f.Ctx, timedOut, newAuction(timedOut, &bid)))
+	require.NoError(f.K.Bids.Set(f.Ctx, collections.Join(timedOut, uint64(0)), bid))
+	require.NoError(f.K.CancelledAuctions.Set(f.Ctx, timedOut))
+
+	f.MockBankKeeper.EXPECT().SendCoins(f.Ctx, f.Addrs[2], f.Addrs[1], sdk.Coins{bidPrice}).Return(nil).Times(1)
+	f.MockEscrowService.EXPECT().Release(f.Ctx, bidless, f.Addrs[2], f.Addrs[0]).Return(nil).Times(1)
+	f.MockEscrowService.EXPECT().Release(f.Ctx, timedOut, f.Addrs[2], f.Addrs[0]).Return(nil).Times(1)
+	f.MockBankKeeper.EXPECT().SendCoinsFromModuleToAccount(f.Ctx, auctiontypes.ModuleName, f.Addrs[0], deposit).Return(nil).Times(2)
+
+	require.NoError(f.K.ProcessCancelledAuctions(f.Ctx, nil))
+
+	for _, id := range []uint64{bidless, timedOut} {
+		isCancelled, err := f.K.CancelledAuctions.Has(f.Ctx, id)
+		require.NoError(err)
+		require.False(isCancelled)
+
+		auction, err := f.K.Auctions.Get(f.Ctx, id)
+		require.NoError(err)
+		require.Equal(auctiontypes.CANCELLED, auction.GetStatus())
+	}
 
-	cancelledAuctions := f.K.GetCancelledAuctions(f.Ctx)
-	require.Empty(cancelledAuctions)
+	// Refunded auctions are not processed again
+	require.NoError(f.K.ProcessCancelledAuctions(f.Ctx, nil))
 }
 
 func TestGetCancelledAuctions(t *testing.T) {
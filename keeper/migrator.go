@@ -1,5 +1,14 @@
 package keeper
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v2 "github.com/fatal-fruit/auction/migrations/v2"
+	v3 "github.com/fatal-fruit/auction/migrations/v3"
+	v4 "github.com/fatal-fruit/auction/migrations/v4"
+	v5 "github.com/fatal-fruit/auction/migrations/v5"
+	v6 "github.com/fatal-fruit/auction/migrations/v6"
+)
+
 type Migrator struct {
 	keeper Keeper
 }
@@ -11,6 +20,32 @@ func NewMigrator(k Keeper) Migrator {
 }
 
 // Migrate1to2 migrates the module state from version 1 to version 2.
-// func (m Migrator) Migrate1to2(ctx context.Context) error {
-// 	return v2.Migrate(/* migrations arguments */)
-// }
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.keeper.Params, m.keeper.Auctions, m.keeper.Bids, m.keeper.BidderAuctions)
+}
+
+// Migrate2to3 migrates the module state from version 2 to version 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.Auctions)
+}
+
+// Migrate3to4 migrates the module state from version 3 to version 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.Auctions, v4.Queues{
+		Active:    m.keeper.ActiveAuctions,
+		Reveal:    m.keeper.RevealAuctions,
+		Expired:   m.keeper.ExpiredAuctions,
+		Pending:   m.keeper.PendingAuctions,
+		Cancelled: m.keeper.CancelledAuctions,
+	})
+}
+
+// Migrate4to5 migrates the module state from version 4 to version 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.keeper.Auctions, m.keeper.ActiveAuctions, m.keeper.ActiveAuctionsByEndTime)
+}
+
+// Migrate5to6 migrates the module state from version 5 to version 6.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.keeper.Bids, m.keeper.BidderBids)
+}
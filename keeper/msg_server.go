@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	at "github.com/fatal-fruit/auction/types"
 )
@@ -19,6 +21,7 @@ func NewMsgServerImpl(keeper Keeper) at.MsgServer {
 }
 
 func (ms msgServer) NewAuction(goCtx context.Context, msg *at.MsgNewAuction) (*at.MsgNewAuctionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
 	owner := sdk.MustAccAddressFromBech32(msg.Owner)
 
 	var md at.AuctionMetadata
@@ -27,9 +30,9 @@ func (ms msgServer) NewAuction(goCtx context.Context, msg *at.MsgNewAuction) (*a
 		return &at.MsgNewAuctionResponse{}, fmt.Errorf("error serializing auction metadata")
 	}
 
-	auction, err := ms.k.CreateAuction(goCtx, msg.AuctionType, owner, md)
+	auction, err := ms.k.CreateAuction(goCtx, msg.AuctionType, owner, msg.Deposit, md)
 	if err != nil {
-		return &at.MsgNewAuctionResponse{}, fmt.Errorf("error creating auction")
+		return &at.MsgNewAuctionResponse{}, fmt.Errorf("error creating auction: %w", err)
 	}
 
 	err = ms.k.bk.SendCoinsFromAccountToModule(goCtx, owner, at.ModuleName, msg.Deposit)
@@ -64,8 +67,12 @@ func (ms msgServer) NewAuction(goCtx context.Context, msg *at.MsgNewAuction) (*a
 		return &at.MsgNewAuctionResponse{}, err
 	}
 
-	// Push auction to ActiveAuction Queue
-	err = ms.k.ActiveAuctions.Set(goCtx, auction.GetId())
+	err = ctx.EventManager().EmitTypedEvent(&at.EventAuctionCreated{
+		AuctionId:   auction.GetId(),
+		AuctionType: auction.GetType(),
+		Owner:       auction.GetOwner(),
+		Deposit:     auction.GetDeposit(),
+	})
 	if err != nil {
 		return &at.MsgNewAuctionResponse{}, err
 	}
@@ -83,9 +90,10 @@ func (ms msgServer) StartAuction(goCtx context.Context, msg *at.MsgStartAuction)
 	if err != nil {
 		return &at.MsgStartAuctionResponse{}, err
 	}
-	if hasAuctions {
-		auction, err = ms.k.Auctions.Get(goCtx, msg.GetId())
+	if !hasAuctions {
+		return &at.MsgStartAuctionResponse{}, errorsmod.Wrapf(at.ErrAuctionNotFound, "auction %d", msg.GetId())
 	}
+	auction, err = ms.k.Auctions.Get(goCtx, msg.GetId())
 	if err != nil {
 		return &at.MsgStartAuctionResponse{}, err
 	}
@@ -94,14 +102,17 @@ func (ms msgServer) StartAuction(goCtx context.Context, msg *at.MsgStartAuction)
 	// TODO: Pass context instead
 	auction.StartAuction(ctx.BlockTime())
 
-	// Save updated auction
-	err = ms.k.Auctions.Set(goCtx, auction.GetId(), auction)
+	// Save updated auction and push it to the ActiveAuction Queue
+	err = ms.k.SetAuctionStatus(goCtx, auction, at.ACTIVE)
 	if err != nil {
 		return &at.MsgStartAuctionResponse{}, err
 	}
 
-	// Push auction to ActiveAuction Queue
-	err = ms.k.ActiveAuctions.Set(goCtx, msg.Id)
+	err = ctx.EventManager().EmitTypedEvent(&at.EventAuctionStarted{
+		AuctionId:   auction.GetId(),
+		AuctionType: auction.GetType(),
+		Owner:       auction.GetOwner(),
+	})
 	if err != nil {
 		return &at.MsgStartAuctionResponse{}, err
 	}
@@ -109,8 +120,27 @@ func (ms msgServer) StartAuction(goCtx context.Context, msg *at.MsgStartAuction)
 	return &at.MsgStartAuctionResponse{}, nil
 }
 
+func (ms msgServer) CancelAuction(goCtx context.Context, msg *at.MsgCancelAuction) (*at.MsgCancelAuctionResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.GetSender())
+	if err != nil {
+		return &at.MsgCancelAuctionResponse{}, fmt.Errorf("invalid sender address :: %s", msg.GetSender())
+	}
+
+	err = ms.k.CancelAuction(goCtx, sender, msg.GetAuctionId())
+	if err != nil {
+		return &at.MsgCancelAuctionResponse{}, err
+	}
+
+	return &at.MsgCancelAuctionResponse{}, nil
+}
+
 func (ms msgServer) NewBid(goCtx context.Context, msg *at.MsgNewBid) (*at.MsgNewBidResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	bidder, err := sdk.AccAddressFromBech32(msg.GetOwner())
+	if err != nil {
+		return &at.MsgNewBidResponse{}, fmt.Errorf("invalid bidder address :: %s", msg.GetOwner())
+	}
+
 	// get auction from active auctions
 	hasAuction, err := ms.k.ActiveAuctions.Has(goCtx, msg.GetAuctionId())
 	if err != nil {
@@ -122,15 +152,57 @@ func (ms msgServer) NewBid(goCtx context.Context, msg *at.MsgNewBid) (*at.MsgNew
 			return &at.MsgNewBidResponse{}, err
 		}
 
+		seq := auction.NumBids()
 		auction, err = ms.k.SubmitBid(ctx, auction.GetType(), auction, msg)
 		if err != nil {
-			return &at.MsgNewBidResponse{}, fmt.Errorf("error creating auction")
+			return &at.MsgNewBidResponse{}, fmt.Errorf("error submitting bid: %w", err)
 		}
 
 		err = ms.k.Auctions.Set(goCtx, auction.GetId(), auction)
 		if err != nil {
 			return &at.MsgNewBidResponse{}, err
 		}
+
+		// Store the accepted bid under the auction's bid sequence
+		err = ms.k.Bids.Set(goCtx, collections.Join(auction.GetId(), seq), at.Bid{
+			AuctionId: auction.GetId(),
+			Bidder:    msg.GetOwner(),
+			BidPrice:  msg.GetBidAmount(),
+			Timestamp: ctx.BlockTime(),
+			Data:      msg.GetData(),
+		})
+		if err != nil {
+			return &at.MsgNewBidResponse{}, err
+		}
+
+		// Index auction by bidder
+		err = ms.k.BidderAuctions.Set(goCtx, collections.Join(bidder, auction.GetId()))
+		if err != nil {
+			return &at.MsgNewBidResponse{}, err
+		}
+		err = ms.k.BidderBids.Set(goCtx, collections.Join3(bidder, auction.GetId(), seq))
+		if err != nil {
+			return &at.MsgNewBidResponse{}, err
+		}
+
+		err = ctx.EventManager().EmitTypedEvent(&at.EventBidPlaced{
+			AuctionId:   auction.GetId(),
+			AuctionType: auction.GetType(),
+			Owner:       auction.GetOwner(),
+			Bidder:      msg.GetOwner(),
+			Amount:      msg.GetBidAmount(),
+		})
+		if err != nil {
+			return &at.MsgNewBidResponse{}, err
+		}
+
+		// Auctions that close on an accepted bid are ready to be executed right away
+		if ca, ok := auction.(at.ClosingAuction); ok && ca.IsClosed() {
+			err = ms.k.CloseAuction(goCtx, auction)
+			if err != nil {
+				return &at.MsgNewBidResponse{}, err
+			}
+		}
 	} else {
 		return &at.MsgNewBidResponse{}, fmt.Errorf("invalid auction id")
 	}
@@ -138,7 +210,22 @@ func (ms msgServer) NewBid(goCtx context.Context, msg *at.MsgNewBid) (*at.MsgNew
 	return &at.MsgNewBidResponse{}, nil
 }
 
+func (ms msgServer) RevealBid(goCtx context.Context, msg *at.MsgRevealBid) (*at.MsgRevealBidResponse, error) {
+	_, err := sdk.AccAddressFromBech32(msg.GetBidder())
+	if err != nil {
+		return &at.MsgRevealBidResponse{}, fmt.Errorf("invalid bidder address :: %s", msg.GetBidder())
+	}
+
+	err = ms.k.RevealBid(goCtx, msg)
+	if err != nil {
+		return &at.MsgRevealBidResponse{}, err
+	}
+
+	return &at.MsgRevealBidResponse{}, nil
+}
+
 func (ms msgServer) Exec(goCtx context.Context, msg *at.MsgExecAuction) (*at.MsgExecAuctionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
 	// Check auction is in pending
 	isPending, err := ms.k.PendingAuctions.Has(goCtx, msg.GetAuctionId())
 	if err != nil {
@@ -158,17 +245,38 @@ func (ms msgServer) Exec(goCtx context.Context, msg *at.MsgExecAuction) (*at.Msg
 		return &at.MsgExecAuctionResponse{}, err
 	}
 
-	// remove from pending
-	err = ms.k.PendingAuctions.Remove(goCtx, msg.GetAuctionId())
+	// remove from pending and update status
+	err = ms.k.SetAuctionStatus(goCtx, auction, at.SETTLED)
 	if err != nil {
 		return &at.MsgExecAuctionResponse{}, err
 	}
-	//update status
-	auction.UpdateStatus(at.CLOSED)
-	err = ms.k.Auctions.Set(goCtx, auction.GetId(), auction)
+
+	err = ctx.EventManager().EmitTypedEvent(&at.EventAuctionExecuted{
+		AuctionId:   auction.GetId(),
+		AuctionType: auction.GetType(),
+		Owner:       auction.GetOwner(),
+		Executor:    msg.GetSender(),
+		Deposit:     auction.GetDeposit(),
+	})
 	if err != nil {
 		return &at.MsgExecAuctionResponse{}, err
 	}
 
 	return &at.MsgExecAuctionResponse{}, nil
 }
+
+func (ms msgServer) UpdateParams(goCtx context.Context, msg *at.MsgUpdateParams) (*at.MsgUpdateParamsResponse, error) {
+	if ms.k.GetAuthority() != msg.GetAuthority() {
+		return &at.MsgUpdateParamsResponse{}, errorsmod.Wrapf(at.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.k.GetAuthority(), msg.GetAuthority())
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return &at.MsgUpdateParamsResponse{}, err
+	}
+
+	if err := ms.k.Params.Set(goCtx, msg.Params); err != nil {
+		return &at.MsgUpdateParamsResponse{}, err
+	}
+
+	return &at.MsgUpdateParamsResponse{}, nil
+}
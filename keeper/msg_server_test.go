@@ -1,8 +1,11 @@
 package keeper_test
 
 import (
+	"cosmossdk.io/collections"
+	abci "github.com/cometbft/cometbft/abci/types"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 	at "github.com/fatal-fruit/auction/auctiontypes"
 	auctiontestutil "github.com/fatal-fruit/auction/testutil"
 	auctiontypes "github.com/fatal-fruit/auction/types"
@@ -21,12 +24,20 @@ func TestNewAuction(t *testing.T) {
 	}
 	anyMd, err := codectypes.NewAnyWithValue(&metadata)
 	require.NoError(err)
+	invalidMd, err := codectypes.NewAnyWithValue(&at.ReserveAuctionMetadata{
+		ReservePrice:    sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000),
+		Duration:        time.Duration(30) * time.Second,
+		MinIncrement:    sdk.NewInt64Coin(f.K.GetDefaultDenom(), 50),
+		MinIncrementBps: 500,
+	})
+	require.NoError(err)
 
 	testCases := []struct {
 		name      string
 		req       auctiontypes.MsgNewAuction
 		metadata  at.ReserveAuctionMetadata
-		expErr    bool
+		expErr    error
+		expErrMsg string
 		setupTest func(fixture *auctiontestutil.TestFixture) struct {
 			contractId uint64
 		}
@@ -64,6 +75,78 @@ func TestNewAuction(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "deposit denom not allowed",
+			req: auctiontypes.MsgNewAuction{
+				Owner:           f.Addrs[0].String(),
+				Deposit:         sdk.NewCoins(sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000)),
+				AuctionType:     f.ReserveAuctionType,
+				AuctionMetadata: anyMd,
+			},
+			metadata: metadata,
+			expErr:   auctiontypes.ErrDenomNotAllowed,
+			setupTest: func(tf *auctiontestutil.TestFixture) struct {
+				contractId uint64
+			} {
+				params := auctiontypes.DefaultParams()
+				params.AllowedDepositDenoms = []string{"uatom"}
+				require.NoError(tf.K.Params.Set(tf.Ctx, params))
+				tf.MockBankKeeper.EXPECT().GetBalance(tf.Ctx, tf.ModAddr, tf.K.GetDefaultDenom()).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000))
+
+				return struct {
+					contractId uint64
+				}{}
+			},
+		},
+		{
+			name: "duration above maximum",
+			req: auctiontypes.MsgNewAuction{
+				Owner:           f.Addrs[0].String(),
+				Deposit:         sdk.NewCoins(sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000)),
+				AuctionType:     f.ReserveAuctionType,
+				AuctionMetadata: anyMd,
+			},
+			metadata: metadata,
+			expErr:   auctiontypes.ErrInvalidDuration,
+			setupTest: func(tf *auctiontestutil.TestFixture) struct {
+				contractId uint64
+			} {
+				params := auctiontypes.DefaultParams()
+				params.MaxAuctionDuration = 20 * time.Second
+				require.NoError(tf.K.Params.Set(tf.Ctx, params))
+				tf.MockBankKeeper.EXPECT().GetBalance(tf.Ctx, tf.ModAddr, tf.K.GetDefaultDenom()).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000))
+
+				contract := &auctiontestutil.EscrowModContract{
+					Id:      uint64(1),
+					Address: f.Addrs[2],
+				}
+				tf.MockEscrowService.EXPECT().NewContract(tf.Ctx, contract.Id).Return(contract, nil).AnyTimes()
+
+				return struct {
+					contractId uint64
+				}{}
+			},
+		},
+		{
+			name: "invalid metadata",
+			req: auctiontypes.MsgNewAuction{
+				Owner:           f.Addrs[0].String(),
+				Deposit:         sdk.NewCoins(sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000)),
+				AuctionType:     f.ReserveAuctionType,
+				AuctionMetadata: invalidMd,
+			},
+			expErrMsg: "min increment and min increment bps cannot both be set",
+			setupTest: func(tf *auctiontestutil.TestFixture) struct {
+				contractId uint64
+			} {
+				require.NoError(tf.K.Params.Set(tf.Ctx, auctiontypes.DefaultParams()))
+				tf.MockBankKeeper.EXPECT().GetBalance(tf.Ctx, tf.ModAddr, tf.K.GetDefaultDenom()).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000))
+
+				return struct {
+					contractId uint64
+				}{}
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -74,15 +157,20 @@ func TestNewAuction(t *testing.T) {
 			found, deposit := tc.req.Deposit.Find(f.K.GetDefaultDenom())
 			require.True(found)
 			res, err := f.MsgServer.NewAuction(f.Ctx, &tc.req)
-			if tc.expErr {
-				require.Error(err)
-			} else {
+			switch {
+			case tc.expErr != nil:
+				require.ErrorIs(err, tc.expErr)
+			case tc.expErrMsg != "":
+				require.ErrorContains(err, tc.expErrMsg)
+			default:
 				require.NoError(err)
 				auction, err := f.K.Auctions.Get(f.Ctx, res.GetId())
 				require.NoError(err)
+				// Auctions are not queued until they are started
+				require.Equal(auctiontypes.CREATED, auction.GetStatus())
 				isActive, err := f.K.ActiveAuctions.Has(f.Ctx, res.GetId())
 				require.NoError(err)
-				require.True(isActive)
+				require.False(isActive)
 
 				a := auction.GetAuctionMetadata()
 
@@ -96,9 +184,9 @@ func TestNewAuction(t *testing.T) {
 
 				switch act := auction.(type) {
 				case *at.ReserveAuction:
-					require.Equal(expValues.contractId, act.Metadata.Strategy.EscrowContractId)
+					require.Equal(expValues.contractId, at.GetStrategy(act.Metadata.SettlementStrategy).GetEscrowContractId())
 					require.Equal(tc.req.Owner, act.Owner)
-					require.Equal(len(act.Metadata.Bids), 0)
+					require.Zero(act.NumBids())
 				default:
 					t.Errorf("invalid auction type")
 				}
@@ -111,6 +199,42 @@ func TestNewAuction(t *testing.T) {
 	}
 }
 
+func TestStartAuction(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	require := require.New(t)
+
+	id, err := f.K.IDs.Next(f.Ctx)
+	require.NoError(err)
+	auction := at.ReserveAuction{
+		Id:          id,
+		Status:      auctiontypes.CREATED,
+		Owner:       f.Addrs[0].String(),
+		AuctionType: f.ReserveAuctionType,
+		Metadata: &at.ReserveAuctionMetadata{
+			ReservePrice: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000),
+			Duration:     30 * time.Second,
+		},
+	}
+	require.NoError(f.K.Auctions.Set(f.Ctx, id, &auction))
+
+	_, err = f.MsgServer.StartAuction(f.Ctx, &auctiontypes.MsgStartAuction{Owner: f.Addrs[0].String(), Id: id})
+	require.NoError(err)
+
+	stored, err := f.K.Auctions.Get(f.Ctx, id)
+	require.NoError(err)
+	require.Equal(auctiontypes.ACTIVE, stored.GetStatus())
+	isActive, err := f.K.ActiveAuctions.Has(f.Ctx, id)
+	require.NoError(err)
+	require.True(isActive)
+
+	// Started auctions cannot be restarted
+	_, err = f.MsgServer.StartAuction(f.Ctx, &auctiontypes.MsgStartAuction{Owner: f.Addrs[0].String(), Id: id})
+	require.ErrorIs(err, auctiontypes.ErrInvalidTransition)
+
+	_, err = f.MsgServer.StartAuction(f.Ctx, &auctiontypes.MsgStartAuction{Owner: f.Addrs[0].String(), Id: id + 1})
+	require.ErrorIs(err, auctiontypes.ErrAuctionNotFound)
+}
+
 func TestNewBid(t *testing.T) {
 	f := auctiontestutil.InitFixture(t)
 	require := require.New(t)
@@ -235,12 +359,11 @@ func TestNewBid(t *testing.T) {
 						ReservePrice: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000),
 						StartTime:    time.Now().Add(-30 * time.Second),
 						EndTime:      time.Now().Add(-1 * time.Second),
-						Bids:         []*auctiontypes.Bid{},
-						Strategy: &at.SettleStrategy{
+						SettlementStrategy: codectypes.UnsafePackAny(&at.SettleStrategy{
 							StrategyType:          auctiontypes.SETTLE,
 							EscrowContractId:      contractId,
 							EscrowContractAddress: f.Addrs[2].String(),
-						},
+						}),
 					},
 				}
 
@@ -277,19 +400,18 @@ func TestNewBid(t *testing.T) {
 						StartTime:    time.Now(),
 						EndTime:      time.Now().Add(30 * time.Second),
 						LastPrice:    sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100),
-						Bids: []*auctiontypes.Bid{
-							{
-								AuctionId: id,
-								Bidder:    f.Addrs[1].String(),
-								BidPrice:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100),
-								Timestamp: time.Now(),
-							},
+						HighestBid: &auctiontypes.Bid{
+							AuctionId: id,
+							Bidder:    f.Addrs[1].String(),
+							BidPrice:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100),
+							Timestamp: time.Now(),
 						},
-						Strategy: &at.SettleStrategy{
+						NumBids: 1,
+						SettlementStrategy: codectypes.UnsafePackAny(&at.SettleStrategy{
 							StrategyType:          auctiontypes.SETTLE,
 							EscrowContractId:      contractId,
 							EscrowContractAddress: f.Addrs[2].String(),
-						},
+						}),
 					},
 				}
 				err = f.K.Auctions.Set(f.Ctx, id, &auction)
@@ -297,6 +419,84 @@ func TestNewBid(t *testing.T) {
 				err = f.K.ActiveAuctions.Set(f.Ctx, id)
 				require.NoError(err)
 
+				return struct {
+					contractId uint64
+				}{
+					id,
+				}
+			},
+		},
+		{
+			name:   "bid denom not allowed",
+			owner:  f.Addrs[1],
+			bid:    sdk.NewInt64Coin("uatom", 1100),
+			expErr: true,
+			setupTest: func(tf *auctiontestutil.TestFixture) struct {
+				contractId uint64
+			} {
+				id, err := f.K.IDs.Next(f.Ctx)
+				require.NoError(err)
+				auction := at.ReserveAuction{
+					Id:          id,
+					Status:      auctiontypes.ACTIVE,
+					Owner:       f.Addrs[0].String(),
+					AuctionType: f.ReserveAuctionType,
+					Metadata: &at.ReserveAuctionMetadata{
+						ReservePrice: sdk.NewInt64Coin("uatom", 1000),
+						StartTime:    time.Now(),
+						EndTime:      time.Now().Add(30 * time.Second),
+					},
+				}
+				require.NoError(f.K.Auctions.Set(f.Ctx, id, &auction))
+				require.NoError(f.K.ActiveAuctions.Set(f.Ctx, id))
+
+				params := auctiontypes.DefaultParams()
+				params.AllowedBidDenoms = []string{f.K.GetDefaultDenom()}
+				require.NoError(tf.K.Params.Set(tf.Ctx, params))
+
+				return struct {
+					contractId uint64
+				}{
+					id,
+				}
+			},
+		},
+		{
+			name:   "max bids reached",
+			owner:  f.Addrs[2],
+			bid:    sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1200),
+			expErr: true,
+			setupTest: func(tf *auctiontestutil.TestFixture) struct {
+				contractId uint64
+			} {
+				id, err := f.K.IDs.Next(f.Ctx)
+				require.NoError(err)
+				auction := at.ReserveAuction{
+					Id:          id,
+					Status:      auctiontypes.ACTIVE,
+					Owner:       f.Addrs[0].String(),
+					AuctionType: f.ReserveAuctionType,
+					Metadata: &at.ReserveAuctionMetadata{
+						ReservePrice: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000),
+						StartTime:    time.Now(),
+						EndTime:      time.Now().Add(30 * time.Second),
+						LastPrice:    sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100),
+						HighestBid: &auctiontypes.Bid{
+							AuctionId: id,
+							Bidder:    f.Addrs[1].String(),
+							BidPrice:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100),
+							Timestamp: time.Now(),
+						},
+						NumBids: 1,
+					},
+				}
+				require.NoError(f.K.Auctions.Set(f.Ctx, id, &auction))
+				require.NoError(f.K.ActiveAuctions.Set(f.Ctx, id))
+
+				params := auctiontypes.DefaultParams()
+				params.MaxBidsPerAuction = 1
+				require.NoError(tf.K.Params.Set(tf.Ctx, params))
+
 				return struct {
 					contractId uint64
 				}{
@@ -324,15 +524,220 @@ func TestNewBid(t *testing.T) {
 
 				switch act := auction.(type) {
 				case *at.ReserveAuction:
-					bd := act.Metadata.GetBids()[0]
+					bd := act.GetLeadingBid()
 					require.Equal(bd.BidPrice, tc.bid)
 					require.Equal(bd.AuctionId, msgRes.contractId)
 					require.Equal(bd.Bidder, tc.owner.String())
 					require.Equal(act.Metadata.LastPrice, bd.BidPrice)
+					require.Equal(uint64(1), act.NumBids())
 				default:
 					t.Errorf("invalid auction type")
 				}
+
+				bids, err := f.K.GetAuctionBids(f.Ctx, msgRes.contractId)
+				require.NoError(err)
+				require.Len(bids, 1)
+				require.Equal(tc.bid, bids[0].BidPrice)
+				require.Equal(tc.owner.String(), bids[0].Bidder)
+
+				hasBid, err := f.K.BidderAuctions.Has(f.Ctx, collections.Join(tc.owner, msgRes.contractId))
+				require.NoError(err)
+				require.True(hasBid)
+
+				bidderBids, err := f.K.GetBidderBids(f.Ctx, tc.owner, msgRes.contractId)
+				require.NoError(err)
+				require.Len(bidderBids, 1)
+			}
+		})
+	}
+}
+
+func TestNewBidRefundOnOutbid(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	require := require.New(t)
+
+	id, err := f.K.IDs.Next(f.Ctx)
+	require.NoError(err)
+	auction := at.ReserveAuction{
+		Id:          id,
+		Status:      auctiontypes.ACTIVE,
+		Owner:       f.Addrs[0].String(),
+		AuctionType: f.ReserveAuctionType,
+		Metadata: &at.ReserveAuctionMetadata{
+			ReservePrice: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000),
+			StartTime:    time.Now(),
+			EndTime:      time.Now().Add(30 * time.Second),
+			LastPrice:    sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100),
+			HighestBid: &auctiontypes.Bid{
+				AuctionId: id,
+				Bidder:    f.Addrs[1].String(),
+				BidPrice:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100),
+				Timestamp: time.Now(),
+			},
+			NumBids: 1,
+			SettlementStrategy: codectypes.UnsafePackAny(&at.SettleStrategy{
+				StrategyType:          auctiontypes.SETTLE,
+				EscrowContractId:      id,
+				EscrowContractAddress: f.Addrs[2].String(),
+			}),
+			RefundOnOutbid: true,
+		},
+	}
+	require.NoError(f.K.Auctions.Set(f.Ctx, id, &auction))
+	require.NoError(f.K.ActiveAuctions.Set(f.Ctx, id))
+
+	newBid := sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1200)
+	refund := sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100)
+	f.MockBankKeeper.EXPECT().SendCoins(f.Ctx, f.Addrs[3], f.Addrs[2], sdk.Coins{newBid}).Times(1)
+	f.MockBankKeeper.EXPECT().SendCoins(f.Ctx, f.Addrs[2], f.Addrs[1], sdk.Coins{refund}).Times(1)
+
+	_, err = f.MsgServer.NewBid(f.Ctx, &auctiontypes.MsgNewBid{
+		AuctionId: id,
+		Owner:     f.Addrs[3].String(),
+		BidAmount: newBid,
+	})
+	require.NoError(err)
+
+	var found bool
+	for _, e := range f.Ctx.EventManager().Events() {
+		if e.Type != proto.MessageName(&auctiontypes.EventOutbid{}) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event(e))
+		require.NoError(err)
+		require.Equal(&auctiontypes.EventOutbid{
+			AuctionId: id,
+			Bidder:    f.Addrs[1].String(),
+			Refund:    refund,
+			NewBid:    newBid,
+		}, msg)
+		found = true
+	}
+	require.True(found)
+
+	// Losing bids were already returned, settlement only pays the owner
+	stored, err := f.K.Auctions.Get(f.Ctx, id)
+	require.NoError(err)
+	require.NoError(f.K.SetAuctionStatus(f.Ctx, stored, auctiontypes.PENDING))
+	f.MockBankKeeper.EXPECT().SendCoins(f.Ctx, f.Addrs[2], f.Addrs[0], sdk.Coins{newBid}).Times(1)
+
+	_, err = f.MsgServer.Exec(f.Ctx, &auctiontypes.MsgExecAuction{Sender: f.Addrs[0].String(), AuctionId: id})
+	require.NoError(err)
+}
+
+func TestNewBidBuyNow(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	require := require.New(t)
+
+	now := time.Now().UTC()
+	ctx := f.Ctx.WithBlockTime(now)
+	newAuction := func(id uint64) *at.ReserveAuction {
+		return &at.ReserveAuction{
+			Id:          id,
+			Status:      auctiontypes.ACTIVE,
+			Owner:       f.Addrs[0].String(),
+			AuctionType: f.ReserveAuctionType,
+			Metadata: &at.ReserveAuctionMetadata{
+				ReservePrice: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000),
+				BuyNowPrice:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), 5000),
+				StartTime:    now.Add(-10 * time.Second),
+				EndTime:      now.Add(30 * time.Second),
+				SettlementStrategy: codectypes.UnsafePackAny(&at.SettleStrategy{
+					StrategyType:          auctiontypes.SETTLE,
+					EscrowContractId:      id,
+					EscrowContractAddress: f.Addrs[2].String(),
+				}),
+			},
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		bid       sdk.Coin
+		expClosed bool
+		expErr    bool
+	}{
+		{
+			name: "bid below buy now price",
+			bid:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), 4999),
+		},
+		{
+			name:      "bid at buy now price",
+			bid:       sdk.NewInt64Coin(f.K.GetDefaultDenom(), 5000),
+			expClosed: true,
+		},
+		{
+			name:   "bid above buy now price",
+			bid:    sdk.NewInt64Coin(f.K.GetDefaultDenom(), 6000),
+			expErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := ctx.WithEventManager(sdk.NewEventManager())
+			id, err := f.K.IDs.Next(ctx)
+			require.NoError(err)
+			require.NoError(f.K.Auctions.Set(ctx, id, newAuction(id)))
+			require.NoError(f.K.ActiveAuctions.Set(ctx, id))
+
+			if tc.expErr {
+				_, err = f.MsgServer.NewBid(ctx, &auctiontypes.MsgNewBid{
+					AuctionId: id,
+					Owner:     f.Addrs[1].String(),
+					BidAmount: tc.bid,
+				})
+				require.ErrorContains(err, "bid higher than buy now price")
+				return
+			}
+
+			f.MockBankKeeper.EXPECT().SendCoins(ctx, f.Addrs[1], f.Addrs[2], sdk.Coins{tc.bid}).Times(1)
+			_, err = f.MsgServer.NewBid(ctx, &auctiontypes.MsgNewBid{
+				AuctionId: id,
+				Owner:     f.Addrs[1].String(),
+				BidAmount: tc.bid,
+			})
+			require.NoError(err)
+
+			isActive, err := f.K.ActiveAuctions.Has(ctx, id)
+			require.NoError(err)
+			require.Equal(!tc.expClosed, isActive)
+			isPending, err := f.K.PendingAuctions.Has(ctx, id)
+			require.NoError(err)
+			require.Equal(tc.expClosed, isPending)
+
+			var boughtNow []proto.Message
+			for _, e := range ctx.EventManager().Events() {
+				if e.Type != proto.MessageName(&auctiontypes.EventAuctionBoughtNow{}) {
+					continue
+				}
+				msg, err := sdk.ParseTypedEvent(abci.Event(e))
+				require.NoError(err)
+				boughtNow = append(boughtNow, msg)
+			}
+			if !tc.expClosed {
+				require.Empty(boughtNow)
+				return
 			}
+			require.Equal([]proto.Message{&auctiontypes.EventAuctionBoughtNow{
+				AuctionId:   id,
+				AuctionType: f.ReserveAuctionType,
+				Owner:       f.Addrs[0].String(),
+				Buyer:       f.Addrs[1].String(),
+				Price:       tc.bid,
+			}}, boughtNow)
+
+			// The auction ends at the winning bid and no longer accepts bids
+			auction, err := f.K.Auctions.Get(ctx, id)
+			require.NoError(err)
+			require.Equal(now, auction.(*at.ReserveAuction).Metadata.EndTime)
+			_, err = f.MsgServer.NewBid(ctx, &auctiontypes.MsgNewBid{
+				AuctionId: id,
+				Owner:     f.Addrs[3].String(),
+				BidAmount: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 7000),
+			})
+			require.Error(err)
 		})
 	}
 }
@@ -361,7 +766,7 @@ func TestExecAuction(t *testing.T) {
 				require.NoError(err)
 				auction := at.ReserveAuction{
 					Id:          id,
-					Status:      auctiontypes.ACTIVE,
+					Status:      auctiontypes.PENDING,
 					Owner:       f.Addrs[0].String(),
 					AuctionType: f.ReserveAuctionType,
 					Metadata: &at.ReserveAuctionMetadata{
@@ -369,19 +774,18 @@ func TestExecAuction(t *testing.T) {
 						StartTime:    time.Now().Add(-30 * time.Second),
 						EndTime:      time.Now().Add(-1 * time.Second),
 						LastPrice:    sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100),
-						Bids: []*auctiontypes.Bid{
-							{
-								AuctionId: id,
-								Bidder:    f.Addrs[1].String(),
-								BidPrice:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100),
-								Timestamp: time.Now(),
-							},
+						HighestBid: &auctiontypes.Bid{
+							AuctionId: id,
+							Bidder:    f.Addrs[1].String(),
+							BidPrice:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100),
+							Timestamp: time.Now(),
 						},
-						Strategy: &at.SettleStrategy{
+						NumBids: 1,
+						SettlementStrategy: codectypes.UnsafePackAny(&at.SettleStrategy{
 							StrategyType:          auctiontypes.SETTLE,
 							EscrowContractId:      uint64(1),
 							EscrowContractAddress: f.Addrs[2].String(),
-						},
+						}),
 					},
 				}
 				err = f.K.Auctions.Set(f.Ctx, id, &auction)
@@ -389,8 +793,7 @@ func TestExecAuction(t *testing.T) {
 				require.NoError(err)
 				err = f.K.PendingAuctions.Set(f.Ctx, id)
 				require.NoError(err)
-				f.MockBankKeeper.EXPECT().SendCoins(f.Ctx, f.Addrs[1], f.Addrs[0], sdk.Coins{sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100)}).Times(1)
-				f.MockEscrowService.EXPECT().Release(f.Ctx, uint64(1), f.Addrs[2], f.Addrs[1]).Times(1)
+				f.MockBankKeeper.EXPECT().SendCoins(f.Ctx, f.Addrs[2], f.Addrs[0], sdk.Coins{sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100)}).Times(1)
 
 				return struct {
 					auctionId uint64
@@ -399,36 +802,721 @@ func TestExecAuction(t *testing.T) {
 				}
 			},
 		},
-	}
-
-	for _, tc := range testCases {
-		tc := tc
-		t.Run(tc.name, func(t *testing.T) {
-			msgRes := tc.setupTest(f)
-			tc.req.AuctionId = msgRes.auctionId
-
-			_, err := f.MsgServer.Exec(f.Ctx, &tc.req)
-
-			if tc.expErr {
-				require.Error(err)
-			} else {
-				// set status to executed
-				// remove from pending
-				// expect escrow service release to have been called
-				isPending, err := f.K.PendingAuctions.Has(f.Ctx, msgRes.auctionId)
+		{
+			name: "refund losing bidders",
+			req: auctiontypes.MsgExecAuction{
+				Sender: f.Addrs[0].String(),
+			},
+			setupTest: func(tf *auctiontestutil.TestFixture) struct {
+				auctionId uint64
+			} {
+				id, err := f.K.IDs.Next(f.Ctx)
 				require.NoError(err)
-				require.False(isPending)
-
-				auction, err := f.K.Auctions.Get(f.Ctx, msgRes.auctionId)
-				switch act := auction.(type) {
-				case *at.ReserveAuction:
-					require.Equal(act.Status, auctiontypes.CLOSED)
-				default:
-					t.Errorf("invalid auction type")
+				deposit := sdk.NewCoins(sdk.NewInt64Coin("uasset", 1))
+				bids := []auctiontypes.Bid{
+					{
+						AuctionId: id,
+						Bidder:    f.Addrs[1].String(),
+						BidPrice:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100),
+						Timestamp: time.Now(),
+					},
+					{
+						AuctionId: id,
+						Bidder:    f.Addrs[1].String(),
+						BidPrice:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1200),
+						Timestamp: time.Now(),
+					},
+					{
+						AuctionId: id,
+						Bidder:    f.Addrs[3].String(),
+						BidPrice:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1300),
+						Timestamp: time.Now(),
+					},
 				}
-
+				auction := at.ReserveAuction{
+					Id:          id,
+					Status:      auctiontypes.PENDING,
+					Owner:       f.Addrs[0].String(),
+					AuctionType: f.ReserveAuctionType,
+					Deposit:     deposit,
+					Metadata: &at.ReserveAuctionMetadata{
+						ReservePrice: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000),
+						StartTime:    time.Now().Add(-30 * time.Second),
+						EndTime:      time.Now().Add(-1 * time.Second),
+						LastPrice:    sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1300),
+						HighestBid:   &bids[2],
+						NumBids:      uint64(len(bids)),
+						SettlementStrategy: codectypes.UnsafePackAny(&at.SettleStrategy{
+							StrategyType:          auctiontypes.SETTLE,
+							EscrowContractId:      id,
+							EscrowContractAddress: f.Addrs[2].String(),
+						}),
+					},
+				}
+				err = f.K.Auctions.Set(f.Ctx, id, &auction)
 				require.NoError(err)
-			}
+				for i, b := range bids {
+					require.NoError(f.K.Bids.Set(f.Ctx, collections.Join(id, uint64(i)), b))
+				}
+				err = f.K.PendingAuctions.Set(f.Ctx, id)
+				require.NoError(err)
+
+				// Winning bid goes to the owner, every losing bid goes back to its bidder
+				f.MockBankKeeper.EXPECT().SendCoins(f.Ctx, f.Addrs[2], f.Addrs[0], sdk.Coins{sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1300)}).Times(1)
+				f.MockBankKeeper.EXPECT().SendCoins(f.Ctx, f.Addrs[2], f.Addrs[1], sdk.Coins{sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100)}).Times(1)
+				f.MockBankKeeper.EXPECT().SendCoins(f.Ctx, f.Addrs[2], f.Addrs[1], sdk.Coins{sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1200)}).Times(1)
+				f.MockBankKeeper.EXPECT().SendCoinsFromModuleToAccount(f.Ctx, auctiontypes.ModuleName, f.Addrs[3], deposit).Times(1)
+
+				return struct {
+					auctionId uint64
+				}{
+					auctionId: id,
+				}
+			},
+		},
+		{
+			name: "second price charges highest competing bid",
+			req: auctiontypes.MsgExecAuction{
+				Sender: f.Addrs[0].String(),
+			},
+			setupTest: func(tf *auctiontestutil.TestFixture) struct {
+				auctionId uint64
+			} {
+				id, err := f.K.IDs.Next(f.Ctx)
+				require.NoError(err)
+				bids := []auctiontypes.Bid{
+					{
+						AuctionId: id,
+						Bidder:    f.Addrs[1].String(),
+						BidPrice:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100),
+						Timestamp: time.Now(),
+					},
+					{
+						AuctionId: id,
+						Bidder:    f.Addrs[3].String(),
+						BidPrice:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1200),
+						Timestamp: time.Now(),
+					},
+					{
+						AuctionId: id,
+						Bidder:    f.Addrs[3].String(),
+						BidPrice:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1500),
+						Timestamp: time.Now(),
+					},
+				}
+				auction := at.ReserveAuction{
+					Id:          id,
+					Status:      auctiontypes.PENDING,
+					Owner:       f.Addrs[0].String(),
+					AuctionType: f.ReserveAuctionType,
+					Metadata: &at.ReserveAuctionMetadata{
+						ReservePrice: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000),
+						StartTime:    time.Now().Add(-30 * time.Second),
+						EndTime:      time.Now().Add(-1 * time.Second),
+						LastPrice:    sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1500),
+						HighestBid:   &bids[2],
+						NumBids:      uint64(len(bids)),
+						StrategyType: auctiontypes.SECOND_PRICE,
+						SettlementStrategy: codectypes.UnsafePackAny(&at.SettleStrategy{
+							StrategyType:          auctiontypes.SECOND_PRICE,
+							EscrowContractId:      id,
+							EscrowContractAddress: f.Addrs[2].String(),
+						}),
+					},
+				}
+				err = f.K.Auctions.Set(f.Ctx, id, &auction)
+				require.NoError(err)
+				for i, b := range bids {
+					require.NoError(f.K.Bids.Set(f.Ctx, collections.Join(id, uint64(i)), b))
+				}
+				err = f.K.PendingAuctions.Set(f.Ctx, id)
+				require.NoError(err)
+
+				// The winner's own lower bid does not set the price
+				f.MockBankKeeper.EXPECT().SendCoins(f.Ctx, f.Addrs[2], f.Addrs[0], sdk.Coins{sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100)}).Times(1)
+				f.MockBankKeeper.EXPECT().SendCoins(f.Ctx, f.Addrs[2], f.Addrs[3], sdk.Coins{sdk.NewInt64Coin(f.K.GetDefaultDenom(), 400)}).Times(1)
+				f.MockBankKeeper.EXPECT().SendCoins(f.Ctx, f.Addrs[2], f.Addrs[1], sdk.Coins{sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100)}).Times(1)
+				f.MockBankKeeper.EXPECT().SendCoins(f.Ctx, f.Addrs[2], f.Addrs[3], sdk.Coins{sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1200)}).Times(1)
+
+				return struct {
+					auctionId uint64
+				}{
+					auctionId: id,
+				}
+			},
+		},
+		{
+			name: "second price with single bid charges reserve price",
+			req: auctiontypes.MsgExecAuction{
+				Sender: f.Addrs[0].String(),
+			},
+			setupTest: func(tf *auctiontestutil.TestFixture) struct {
+				auctionId uint64
+			} {
+				id, err := f.K.IDs.Next(f.Ctx)
+				require.NoError(err)
+				bid := auctiontypes.Bid{
+					AuctionId: id,
+					Bidder:    f.Addrs[1].String(),
+					BidPrice:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100),
+					Timestamp: time.Now(),
+				}
+				auction := at.ReserveAuction{
+					Id:          id,
+					Status:      auctiontypes.PENDING,
+					Owner:       f.Addrs[0].String(),
+					AuctionType: f.ReserveAuctionType,
+					Metadata: &at.ReserveAuctionMetadata{
+						ReservePrice: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000),
+						StartTime:    time.Now().Add(-30 * time.Second),
+						EndTime:      time.Now().Add(-1 * time.Second),
+						LastPrice:    bid.BidPrice,
+						HighestBid:   &bid,
+						NumBids:      1,
+						StrategyType: auctiontypes.SECOND_PRICE,
+						SettlementStrategy: codectypes.UnsafePackAny(&at.SettleStrategy{
+							StrategyType:          auctiontypes.SECOND_PRICE,
+							EscrowContractId:      id,
+							EscrowContractAddress: f.Addrs[2].String(),
+						}),
+					},
+				}
+				err = f.K.Auctions.Set(f.Ctx, id, &auction)
+				require.NoError(err)
+				require.NoError(f.K.Bids.Set(f.Ctx, collections.Join(id, uint64(0)), bid))
+				err = f.K.PendingAuctions.Set(f.Ctx, id)
+				require.NoError(err)
+
+				f.MockBankKeeper.EXPECT().SendCoins(f.Ctx, f.Addrs[2], f.Addrs[0], sdk.Coins{sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000)}).Times(1)
+				f.MockBankKeeper.EXPECT().SendCoins(f.Ctx, f.Addrs[2], f.Addrs[1], sdk.Coins{sdk.NewInt64Coin(f.K.GetDefaultDenom(), 100)}).Times(1)
+
+				return struct {
+					auctionId uint64
+				}{
+					auctionId: id,
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			msgRes := tc.setupTest(f)
+			tc.req.AuctionId = msgRes.auctionId
+
+			_, err := f.MsgServer.Exec(f.Ctx, &tc.req)
+
+			if tc.expErr {
+				require.Error(err)
+			} else {
+				// set status to executed
+				// remove from pending
+				// expect escrow service release to have been called
+				isPending, err := f.K.PendingAuctions.Has(f.Ctx, msgRes.auctionId)
+				require.NoError(err)
+				require.False(isPending)
+
+				auction, err := f.K.Auctions.Get(f.Ctx, msgRes.auctionId)
+				switch act := auction.(type) {
+				case *at.ReserveAuction:
+					require.Equal(auctiontypes.SETTLED, act.Status)
+				default:
+					t.Errorf("invalid auction type")
+				}
+
+				require.NoError(err)
+			}
+		})
+	}
+}
+
+func TestCancelAuction(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	require := require.New(t)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000))
+	newAuction := func(id uint64, leading *auctiontypes.Bid) at.ReserveAuction {
+		var numBids uint64
+		if leading != nil {
+			numBids = 1
+		}
+		return at.ReserveAuction{
+			Id:          id,
+			Status:      auctiontypes.ACTIVE,
+			Owner:       f.Addrs[0].String(),
+			AuctionType: f.ReserveAuctionType,
+			Deposit:     deposit,
+			Metadata: &at.ReserveAuctionMetadata{
+				ReservePrice: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000),
+				StartTime:    time.Now(),
+				EndTime:      time.Now().Add(30 * time.Second),
+				HighestBid:   leading,
+				NumBids:      numBids,
+				SettlementStrategy: codectypes.UnsafePackAny(&at.SettleStrategy{
+					StrategyType:          auctiontypes.SETTLE,
+					EscrowContractId:      id,
+					EscrowContractAddress: f.Addrs[2].String(),
+				}),
+			},
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		sender    sdk.AccAddress
+		expErr    error
+		setupTest func(fixture *auctiontestutil.TestFixture) struct {
+			auctionId uint64
+		}
+	}{
+		{
+			name:   "cancel active auction without bids",
+			sender: f.Addrs[0],
+			setupTest: func(tf *auctiontestutil.TestFixture) struct {
+				auctionId uint64
+			} {
+				id, err := tf.K.IDs.Next(tf.Ctx)
+				require.NoError(err)
+				auction := newAuction(id, nil)
+				require.NoError(tf.K.Auctions.Set(tf.Ctx, id, &auction))
+				require.NoError(tf.K.ActiveAuctions.Set(tf.Ctx, id))
+
+				return struct {
+					auctionId uint64
+				}{
+					auctionId: id,
+				}
+			},
+		},
+		{
+			name:   "sender is not the owner",
+			sender: f.Addrs[1],
+			expErr: auctiontypes.ErrNotAuctionOwner,
+			setupTest: func(tf *auctiontestutil.TestFixture) struct {
+				auctionId uint64
+			} {
+				id, err := tf.K.IDs.Next(tf.Ctx)
+				require.NoError(err)
+				auction := newAuction(id, nil)
+				require.NoError(tf.K.Auctions.Set(tf.Ctx, id, &auction))
+				require.NoError(tf.K.ActiveAuctions.Set(tf.Ctx, id))
+
+				return struct {
+					auctionId uint64
+				}{
+					auctionId: id,
+				}
+			},
+		},
+		{
+			name:   "auction has bids",
+			sender: f.Addrs[0],
+			expErr: auctiontypes.ErrAuctionHasBids,
+			setupTest: func(tf *auctiontestutil.TestFixture) struct {
+				auctionId uint64
+			} {
+				id, err := tf.K.IDs.Next(tf.Ctx)
+				require.NoError(err)
+				auction := newAuction(id, &auctiontypes.Bid{
+					AuctionId: id,
+					Bidder:    f.Addrs[1].String(),
+					BidPrice:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1100),
+					Timestamp: time.Now(),
+				})
+				require.NoError(tf.K.Auctions.Set(tf.Ctx, id, &auction))
+				require.NoError(tf.K.ActiveAuctions.Set(tf.Ctx, id))
+
+				return struct {
+					auctionId uint64
+				}{
+					auctionId: id,
+				}
+			},
+		},
+		{
+			name:   "auction is not active",
+			sender: f.Addrs[0],
+			expErr: auctiontypes.ErrAuctionNotActive,
+			setupTest: func(tf *auctiontestutil.TestFixture) struct {
+				auctionId uint64
+			} {
+				id, err := tf.K.IDs.Next(tf.Ctx)
+				require.NoError(err)
+				auction := newAuction(id, nil)
+				auction.Status = auctiontypes.EXPIRED
+				require.NoError(tf.K.Auctions.Set(tf.Ctx, id, &auction))
+				require.NoError(tf.K.ExpiredAuctions.Set(tf.Ctx, id))
+
+				return struct {
+					auctionId uint64
+				}{
+					auctionId: id,
+				}
+			},
+		},
+		{
+			name:   "auction does not exist",
+			sender: f.Addrs[0],
+			expErr: auctiontypes.ErrAuctionNotFound,
+			setupTest: func(tf *auctiontestutil.TestFixture) struct {
+				auctionId uint64
+			} {
+				return struct {
+					auctionId uint64
+				}{
+					auctionId: 1000,
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			msgRes := tc.setupTest(f)
+
+			_, err := f.MsgServer.CancelAuction(f.Ctx, &auctiontypes.MsgCancelAuction{
+				Sender:    tc.sender.String(),
+				AuctionId: msgRes.auctionId,
+			})
+
+			if tc.expErr != nil {
+				require.ErrorIs(err, tc.expErr)
+			} else {
+				require.NoError(err)
+
+				isActive, err := f.K.ActiveAuctions.Has(f.Ctx, msgRes.auctionId)
+				require.NoError(err)
+				require.False(isActive)
+
+				isCancelled, err := f.K.CancelledAuctions.Has(f.Ctx, msgRes.auctionId)
+				require.NoError(err)
+				require.True(isCancelled)
+
+				auction, err := f.K.Auctions.Get(f.Ctx, msgRes.auctionId)
+				require.NoError(err)
+				switch act := auction.(type) {
+				case *at.ReserveAuction:
+					require.Equal(auctiontypes.CANCELLED, act.Status)
+				default:
+					t.Errorf("invalid auction type")
+				}
+
+				events := f.Ctx.EventManager().ABCIEvents()
+				msg, err := sdk.ParseTypedEvent(events[len(events)-1])
+				require.NoError(err)
+				require.Equal(&auctiontypes.EventAuctionCancelled{
+					AuctionId:   msgRes.auctionId,
+					AuctionType: f.ReserveAuctionType,
+					Owner:       tc.sender.String(),
+					Reason:      auctiontypes.CancelReasonOwner,
+				}, msg)
+			}
+		})
+	}
+}
+
+func TestUpdateParams(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	require := require.New(t)
+
+	validParams := auctiontypes.DefaultParams()
+	validParams.MaxBidsPerAuction = 100
+	validParams.AllowedBidDenoms = []string{f.K.GetDefaultDenom()}
+
+	testCases := []struct {
+		name   string
+		req    auctiontypes.MsgUpdateParams
+		expErr bool
+	}{
+		{
+			name: "valid params update",
+			req: auctiontypes.MsgUpdateParams{
+				Authority: f.K.GetAuthority(),
+				Params:    validParams,
+			},
+		},
+		{
+			name: "invalid authority",
+			req: auctiontypes.MsgUpdateParams{
+				Authority: f.Addrs[0].String(),
+				Params:    validParams,
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid params",
+			req: auctiontypes.MsgUpdateParams{
+				Authority: f.K.GetAuthority(),
+				Params:    auctiontypes.NewParams(time.Second, time.Minute, time.Hour, 0, nil, nil, 0, 0),
+			},
+			expErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := f.MsgServer.UpdateParams(f.Ctx, &tc.req)
+			if tc.expErr {
+				require.Error(err)
+			} else {
+				require.NoError(err)
+				params, err := f.K.Params.Get(f.Ctx)
+				require.NoError(err)
+				require.Equal(tc.req.Params, params)
+			}
+		})
+	}
+}
+
+func TestNewBidDutchAuction(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	require := require.New(t)
+
+	now := time.Now().UTC()
+	ctx := f.Ctx.WithBlockTime(now)
+	newAuction := func(id uint64) *at.DutchAuction {
+		return &at.DutchAuction{
+			Id:          id,
+			Status:      auctiontypes.ACTIVE,
+			Owner:       f.Addrs[0].String(),
+			AuctionType: f.DutchAuctionType,
+			Metadata: &at.DutchAuctionMetadata{
+				Duration:   100 * time.Second,
+				StartTime:  now.Add(-10 * time.Second),
+				EndTime:    now.Add(90 * time.Second),
+				StartPrice: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000),
+				FloorPrice: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 500),
+				Schedule:   at.DECREMENT_SCHEDULE_LINEAR,
+				SettlementStrategy: codectypes.UnsafePackAny(&at.SettleStrategy{
+					StrategyType:          auctiontypes.SETTLE,
+					EscrowContractId:      id,
+					EscrowContractAddress: f.Addrs[2].String(),
+				}),
+			},
+		}
+	}
+
+	price := sdk.NewInt64Coin(f.K.GetDefaultDenom(), 950)
+	testCases := []struct {
+		name   string
+		bid    sdk.Coin
+		expErr bool
+	}{
+		{
+			name:   "bid below current price",
+			bid:    sdk.NewInt64Coin(f.K.GetDefaultDenom(), 949),
+			expErr: true,
+		},
+		{
+			name: "bid at current price",
+			bid:  price,
+		},
+		{
+			name: "bid above current price",
+			bid:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1200),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			id, err := f.K.IDs.Next(ctx)
+			require.NoError(err)
+			require.NoError(f.K.Auctions.Set(ctx, id, newAuction(id)))
+			require.NoError(f.K.ActiveAuctions.Set(ctx, id))
+
+			if !tc.expErr {
+				f.MockBankKeeper.EXPECT().SendCoins(ctx, f.Addrs[1], f.Addrs[2], sdk.Coins{tc.bid}).Times(1)
+			}
+
+			_, err = f.MsgServer.NewBid(ctx, &auctiontypes.MsgNewBid{
+				AuctionId: id,
+				Owner:     f.Addrs[1].String(),
+				BidAmount: tc.bid,
+			})
+			if tc.expErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+
+			// The first accepted bid closes the auction
+			isActive, err := f.K.ActiveAuctions.Has(ctx, id)
+			require.NoError(err)
+			require.False(isActive)
+			isPending, err := f.K.PendingAuctions.Has(ctx, id)
+			require.NoError(err)
+			require.True(isPending)
+
+			auction, err := f.K.Auctions.Get(ctx, id)
+			require.NoError(err)
+			require.Equal(f.Addrs[1].String(), auction.GetLeadingBid().GetBidder())
+			require.Equal(tc.bid, auction.GetLeadingBid().GetBidPrice())
+
+			events := ctx.EventManager().ABCIEvents()
+			msg, err := sdk.ParseTypedEvent(events[len(events)-1])
+			require.NoError(err)
+			require.Equal(&auctiontypes.EventAuctionPending{
+				AuctionId:   id,
+				AuctionType: f.DutchAuctionType,
+				Owner:       f.Addrs[0].String(),
+				NumBids:     1,
+			}, msg)
+
+			// Closed auctions no longer accept bids
+			_, err = f.MsgServer.NewBid(ctx, &auctiontypes.MsgNewBid{
+				AuctionId: id,
+				Owner:     f.Addrs[3].String(),
+				BidAmount: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 2000),
+			})
+			require.Error(err)
+
+			// Execution pays the asking price to the owner and refunds the rest of the bid
+			f.MockBankKeeper.EXPECT().SendCoins(ctx, f.Addrs[2], f.Addrs[0], sdk.Coins{price}).Times(1)
+			if price.IsLT(tc.bid) {
+				f.MockBankKeeper.EXPECT().SendCoins(ctx, f.Addrs[2], f.Addrs[1], sdk.Coins{tc.bid.Sub(price)}).Times(1)
+			}
+			_, err = f.MsgServer.Exec(ctx, &auctiontypes.MsgExecAuction{Sender: f.Addrs[1].String(), AuctionId: id})
+			require.NoError(err)
+		})
+	}
+}
+
+func TestSealedBidAuction(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	require := require.New(t)
+
+	now := time.Now().UTC()
+	denom := f.K.GetDefaultDenom()
+	owner, bidder, escrow, lateBidder := f.Addrs[0], f.Addrs[1], f.Addrs[2], f.Addrs[3]
+	salt := []byte("secret")
+
+	newAuction := func(id uint64, policy at.UnrevealedBidPolicy) *at.SealedBidAuction {
+		return &at.SealedBidAuction{
+			Id:          id,
+			Status:      auctiontypes.ACTIVE,
+			Owner:       owner.String(),
+			AuctionType: f.SealedBidAuctionType,
+			Metadata: &at.SealedBidAuctionMetadata{
+				Duration:            100 * time.Second,
+				RevealDuration:      50 * time.Second,
+				StartTime:           now.Add(-10 * time.Second),
+				EndTime:             now.Add(90 * time.Second),
+				RevealEndTime:       now.Add(140 * time.Second),
+				ReservePrice:        sdk.NewInt64Coin(denom, 1000),
+				UnrevealedBidPolicy: policy,
+				SettlementStrategy: codectypes.UnsafePackAny(&at.SettleStrategy{
+					StrategyType:          auctiontypes.SETTLE,
+					EscrowContractId:      id,
+					EscrowContractAddress: escrow.String(),
+				}),
+			},
+		}
+	}
+	sealedBid := func(id uint64, addr sdk.AccAddress, amount, collateral sdk.Coin) *auctiontypes.MsgNewBid {
+		data, err := codectypes.NewAnyWithValue(&at.SealedBid{
+			Commitment: at.SealedBidCommitment(id, addr, amount, salt),
+			Collateral: collateral,
+		})
+		require.NoError(err)
+		return &auctiontypes.MsgNewBid{AuctionId: id, Owner: addr.String(), Data: data}
+	}
+
+	testCases := []struct {
+		name   string
+		policy at.UnrevealedBidPolicy
+		// recipient of the unrevealed bid's collateral
+		expRecipient sdk.AccAddress
+	}{
+		{
+			name:         "unrevealed bid refunded",
+			policy:       at.UNREVEALED_BID_POLICY_REFUND,
+			expRecipient: lateBidder,
+		},
+		{
+			name:         "unrevealed bid forfeited",
+			policy:       at.UNREVEALED_BID_POLICY_FORFEIT,
+			expRecipient: owner,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := f.Ctx.WithBlockTime(now)
+			id, err := f.K.IDs.Next(ctx)
+			require.NoError(err)
+			sealed := newAuction(id, tc.policy)
+			require.NoError(f.K.Auctions.Set(ctx, id, sealed))
+			require.NoError(f.K.ActiveAuctions.Set(ctx, id))
+			require.NoError(f.K.ActiveAuctionsByEndTime.Set(ctx, collections.Join(sealed.GetEndTime(), id)))
+
+			amount := sdk.NewInt64Coin(denom, 1200)
+			collateral := sdk.NewInt64Coin(denom, 1500)
+			unrevealed := sdk.NewInt64Coin(denom, 1100)
+
+			// Collateral is escrowed when the sealed bids are placed
+			f.MockBankKeeper.EXPECT().SendCoins(ctx, bidder, escrow, sdk.Coins{collateral}).Times(1)
+			_, err = f.MsgServer.NewBid(ctx, sealedBid(id, bidder, amount, collateral))
+			require.NoError(err)
+			f.MockBankKeeper.EXPECT().SendCoins(ctx, lateBidder, escrow, sdk.Coins{unrevealed}).Times(1)
+			_, err = f.MsgServer.NewBid(ctx, sealedBid(id, lateBidder, unrevealed, unrevealed))
+			require.NoError(err)
+
+			// Bidders place a single sealed bid
+			_, err = f.MsgServer.NewBid(ctx, sealedBid(id, bidder, amount, collateral))
+			require.ErrorIs(err, auctiontypes.ErrSealedBidExists)
+
+			reveal := &auctiontypes.MsgRevealBid{Bidder: bidder.String(), AuctionId: id, Amount: amount, Salt: salt}
+			_, err = f.MsgServer.RevealBid(ctx, reveal)
+			require.ErrorIs(err, auctiontypes.ErrNotRevealPhase)
+
+			// Bidding closes and the auction enters its reveal phase
+			ctx = f.Ctx.WithBlockTime(now.Add(100 * time.Second))
+			require.NoError(f.K.ProcessActiveAuctions(ctx, nil))
+			isRevealing, err := f.K.RevealAuctions.Has(ctx, id)
+			require.NoError(err)
+			require.True(isRevealing)
+
+			_, err = f.MsgServer.RevealBid(ctx, &auctiontypes.MsgRevealBid{Bidder: bidder.String(), AuctionId: id, Amount: amount, Salt: []byte("guess")})
+			require.Error(err)
+			_, err = f.MsgServer.RevealBid(ctx, &auctiontypes.MsgRevealBid{Bidder: owner.String(), AuctionId: id, Amount: amount, Salt: salt})
+			require.ErrorIs(err, auctiontypes.ErrBidNotFound)
+			_, err = f.MsgServer.RevealBid(ctx, reveal)
+			require.NoError(err)
+
+			events := ctx.EventManager().ABCIEvents()
+			msg, err := sdk.ParseTypedEvent(events[len(events)-1])
+			require.NoError(err)
+			require.Equal(&auctiontypes.EventBidRevealed{
+				AuctionId:   id,
+				AuctionType: f.SealedBidAuctionType,
+				Owner:       owner.String(),
+				Bidder:      bidder.String(),
+				Amount:      amount,
+			}, msg)
+
+			auction, err := f.K.Auctions.Get(ctx, id)
+			require.NoError(err)
+			require.Equal(amount, auction.GetLeadingBid().GetBidPrice())
+
+			// The reveal phase ends and the unrevealed collateral is settled
+			ctx = f.Ctx.WithBlockTime(now.Add(150 * time.Second))
+			f.MockBankKeeper.EXPECT().SendCoins(ctx, escrow, tc.expRecipient, sdk.Coins{unrevealed}).Times(1)
+			require.NoError(f.K.ProcessRevealAuctions(ctx, nil))
+			require.NoError(f.K.ProcessExpiredAuctions(ctx, nil))
+			isPending, err := f.K.PendingAuctions.Has(ctx, id)
+			require.NoError(err)
+			require.True(isPending)
+
+			// Execution pays the revealed amount and returns the unused collateral
+			f.MockBankKeeper.EXPECT().SendCoins(ctx, escrow, owner, sdk.Coins{amount}).Times(1)
+			f.MockBankKeeper.EXPECT().SendCoins(ctx, escrow, bidder, sdk.Coins{collateral.Sub(amount)}).Times(1)
+			_, err = f.MsgServer.Exec(ctx, &auctiontypes.MsgExecAuction{Sender: bidder.String(), AuctionId: id})
+			require.NoError(err)
 		})
 	}
 }
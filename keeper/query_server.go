@@ -3,8 +3,11 @@ package keeper
 import (
 	"context"
 	"fmt"
+
+	"cosmossdk.io/collections"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	auctiontypes "github.com/fatal-fruit/auction/types"
 )
 
@@ -65,22 +68,203 @@ func (qs queryServer) OwnerAuctions(goCtx context.Context, r *auctiontypes.Query
 	}, nil
 }
 
-func (qs queryServer) AllAuctions(ctx context.Context, _ *auctiontypes.QueryAllAuctionsRequest) (*auctiontypes.QueryAllAuctionsResponse, error) {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	auctions, err := qs.k.GetAllAuctions(sdkCtx)
+func (qs queryServer) AuctionBids(ctx context.Context, r *auctiontypes.QueryAuctionBidsRequest) (*auctiontypes.QueryAuctionBidsResponse, error) {
+	bids, pageRes, err := query.CollectionPaginate(ctx, qs.k.Bids, r.GetPagination(),
+		func(_ collections.Pair[uint64, uint64], bid auctiontypes.Bid) (auctiontypes.Bid, error) {
+			return bid, nil
+		},
+		query.WithCollectionPaginationPairPrefix[uint64, uint64](r.GetAuctionId()),
+	)
 	if err != nil {
-		return &auctiontypes.QueryAllAuctionsResponse{}, fmt.Errorf(fmt.Sprintf("error retrieving all auctions %v", err))
+		return &auctiontypes.QueryAuctionBidsResponse{}, fmt.Errorf("error retrieving bids for auction %d :: %w", r.GetAuctionId(), err)
 	}
 
-	auctionRes := make([]*codectypes.Any, len(auctions))
-	for _, a := range auctions {
+	return &auctiontypes.QueryAuctionBidsResponse{
+		Bids:       bids,
+		Pagination: pageRes,
+	}, nil
+}
 
-		aa, err := codectypes.NewAnyWithValue(a)
+func (qs queryServer) NextMinimumBid(ctx context.Context, r *auctiontypes.QueryNextMinimumBidRequest) (*auctiontypes.QueryNextMinimumBidResponse, error) {
+	auction, err := qs.k.Auctions.Get(ctx, r.GetAuctionId())
+	if err != nil {
+		return &auctiontypes.QueryNextMinimumBidResponse{}, fmt.Errorf("unable to retrieve auction with id :: %d", r.GetAuctionId())
+	}
+
+	ma, ok := auction.(auctiontypes.MinimumBidAuction)
+	if !ok {
+		return &auctiontypes.QueryNextMinimumBidResponse{}, fmt.Errorf("auction type %s has no minimum bid", auction.GetType())
+	}
+
+	return &auctiontypes.QueryNextMinimumBidResponse{
+		MinBid: ma.NextMinimumBid(),
+	}, nil
+}
+
+func (qs queryServer) BidderAuctions(ctx context.Context, r *auctiontypes.QueryBidderAuctionsRequest) (*auctiontypes.QueryBidderAuctionsResponse, error) {
+	bidder, err := sdk.AccAddressFromBech32(r.GetBidderAddress())
+	if err != nil {
+		return &auctiontypes.QueryBidderAuctionsResponse{}, fmt.Errorf("unable to retrieve bidder address :: %s", r.GetBidderAddress())
+	}
+
+	var filter func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (bool, error)
+	if r.GetWinningOnly() {
+		filter = func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (bool, error) {
+			auction, err := qs.k.Auctions.Get(ctx, key.K2())
+			if err != nil {
+				return false, err
+			}
+			leading := auction.GetLeadingBid()
+			return leading != nil && leading.GetBidder() == r.GetBidderAddress(), nil
+		}
+	}
+
+	auctions, pageRes, err := query.CollectionFilteredPaginate(ctx, qs.k.BidderAuctions, r.GetPagination(), filter,
+		func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (auctiontypes.BidderAuction, error) {
+			auction, err := qs.k.Auctions.Get(ctx, key.K2())
+			if err != nil {
+				return auctiontypes.BidderAuction{}, err
+			}
+
+			aa, err := codectypes.NewAnyWithValue(auction)
+			if err != nil {
+				return auctiontypes.BidderAuction{}, err
+			}
+
+			bids, err := qs.k.GetBidderBids(ctx, bidder, key.K2())
+			if err != nil {
+				return auctiontypes.BidderAuction{}, err
+			}
+			return auctiontypes.BidderAuction{Auction: aa, Bids: bids}, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](bidder),
+	)
+	if err != nil {
+		return &auctiontypes.QueryBidderAuctionsResponse{}, fmt.Errorf("error retrieving bidder auctions :: %w", err)
+	}
+
+	return &auctiontypes.QueryBidderAuctionsResponse{
+		Auctions:   auctions,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (qs queryServer) AllAuctions(ctx context.Context, r *auctiontypes.QueryAllAuctionsRequest) (*auctiontypes.QueryAllAuctionsResponse, error) {
+	var status auctiontypes.AuctionStatus
+	if r.GetStatus() != "" {
+		var err error
+		status, err = auctiontypes.ParseAuctionStatus(r.GetStatus())
 		if err != nil {
-			return &auctiontypes.QueryAllAuctionsResponse{}, fmt.Errorf(fmt.Sprintf("unable to retrieve auction with id :: %d", a.GetId()))
+			return &auctiontypes.QueryAllAuctionsResponse{}, err
 		}
-		auctionRes = append(auctionRes, aa)
 	}
 
-	return &auctiontypes.QueryAllAuctionsResponse{Auctions: auctionRes}, nil
+	var filter func(id uint64, auction auctiontypes.Auction) (bool, error)
+	if r.GetStatus() != "" || r.GetAuctionType() != "" {
+		filter = func(_ uint64, auction auctiontypes.Auction) (bool, error) {
+			if r.GetStatus() != "" && auction.GetStatus() != status {
+				return false, nil
+			}
+			if r.GetAuctionType() != "" && auction.GetType() != r.GetAuctionType() {
+				return false, nil
+			}
+			return true, nil
+		}
+	}
+
+	auctions, pageRes, err := query.CollectionFilteredPaginate(ctx, qs.k.Auctions, r.GetPagination(), filter,
+		func(_ uint64, auction auctiontypes.Auction) (*codectypes.Any, error) {
+			return codectypes.NewAnyWithValue(auction)
+		},
+	)
+	if err != nil {
+		return &auctiontypes.QueryAllAuctionsResponse{}, fmt.Errorf("error retrieving all auctions :: %w", err)
+	}
+
+	return &auctiontypes.QueryAllAuctionsResponse{
+		Auctions:   auctions,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (qs queryServer) ActiveAuctions(ctx context.Context, r *auctiontypes.QueryActiveAuctionsRequest) (*auctiontypes.QueryActiveAuctionsResponse, error) {
+	auctions, pageRes, err := qs.paginateQueue(ctx, qs.k.ActiveAuctions, r.GetPagination())
+	if err != nil {
+		return &auctiontypes.QueryActiveAuctionsResponse{}, fmt.Errorf("error retrieving active auctions :: %w", err)
+	}
+
+	return &auctiontypes.QueryActiveAuctionsResponse{
+		Auctions:   auctions,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (qs queryServer) RevealAuctions(ctx context.Context, r *auctiontypes.QueryRevealAuctionsRequest) (*auctiontypes.QueryRevealAuctionsResponse, error) {
+	auctions, pageRes, err := qs.paginateQueue(ctx, qs.k.RevealAuctions, r.GetPagination())
+	if err != nil {
+		return &auctiontypes.QueryRevealAuctionsResponse{}, fmt.Errorf("error retrieving reveal auctions :: %w", err)
+	}
+
+	return &auctiontypes.QueryRevealAuctionsResponse{
+		Auctions:   auctions,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (qs queryServer) ExpiredAuctions(ctx context.Context, r *auctiontypes.QueryExpiredAuctionsRequest) (*auctiontypes.QueryExpiredAuctionsResponse, error) {
+	auctions, pageRes, err := qs.paginateQueue(ctx, qs.k.ExpiredAuctions, r.GetPagination())
+	if err != nil {
+		return &auctiontypes.QueryExpiredAuctionsResponse{}, fmt.Errorf("error retrieving expired auctions :: %w", err)
+	}
+
+	return &auctiontypes.QueryExpiredAuctionsResponse{
+		Auctions:   auctions,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (qs queryServer) PendingAuctions(ctx context.Context, r *auctiontypes.QueryPendingAuctionsRequest) (*auctiontypes.QueryPendingAuctionsResponse, error) {
+	auctions, pageRes, err := qs.paginateQueue(ctx, qs.k.PendingAuctions, r.GetPagination())
+	if err != nil {
+		return &auctiontypes.QueryPendingAuctionsResponse{}, fmt.Errorf("error retrieving pending auctions :: %w", err)
+	}
+
+	return &auctiontypes.QueryPendingAuctionsResponse{
+		Auctions:   auctions,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (qs queryServer) CancelledAuctions(ctx context.Context, r *auctiontypes.QueryCancelledAuctionsRequest) (*auctiontypes.QueryCancelledAuctionsResponse, error) {
+	auctions, pageRes, err := qs.paginateQueue(ctx, qs.k.CancelledAuctions, r.GetPagination())
+	if err != nil {
+		return &auctiontypes.QueryCancelledAuctionsResponse{}, fmt.Errorf("error retrieving cancelled auctions :: %w", err)
+	}
+
+	return &auctiontypes.QueryCancelledAuctionsResponse{
+		Auctions:   auctions,
+		Pagination: pageRes,
+	}, nil
+}
+
+// paginateQueue returns a page of the auctions whose IDs are held in an auction queue.
+func (qs queryServer) paginateQueue(ctx context.Context, queue collections.KeySet[uint64], pageReq *query.PageRequest) ([]*codectypes.Any, *query.PageResponse, error) {
+	return query.CollectionPaginate(ctx, queue, pageReq,
+		func(id uint64, _ collections.NoValue) (*codectypes.Any, error) {
+			auction, err := qs.k.Auctions.Get(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return codectypes.NewAnyWithValue(auction)
+		},
+	)
+}
+
+func (qs queryServer) Params(ctx context.Context, _ *auctiontypes.QueryParamsRequest) (*auctiontypes.QueryParamsResponse, error) {
+	params, err := qs.k.Params.Get(ctx)
+	if err != nil {
+		return &auctiontypes.QueryParamsResponse{}, err
+	}
+
+	return &auctiontypes.QueryParamsResponse{Params: params}, nil
 }
@@ -1,16 +1,17 @@
 package keeper_test
 
 import (
+	"cosmossdk.io/collections"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"testing"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	at "github.com/fatal-fruit/auction/auctiontypes"
 	auctiontestutil "github.com/fatal-fruit/auction/testutil"
 	auctiontypes "github.com/fatal-fruit/auction/types"
 	"github.com/stretchr/testify/require"
-	"go.uber.org/mock/gomock"
 )
 
 func TestQueryAuction(t *testing.T) {
@@ -212,27 +213,447 @@ func TestQueryOwnerAuctions(t *testing.T) {
 
 }
 
-func TestQueryGetAllAuctions(t *testing.T) {
-	// TODO: Fix
-	t.Skip()
+func TestQueryBidderAuctions(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	require := require.New(t)
+
+	bid := func(id uint64, bidder sdk.AccAddress, amount int64) *auctiontypes.Bid {
+		return &auctiontypes.Bid{
+			AuctionId: id,
+			Bidder:    bidder.String(),
+			BidPrice:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), amount),
+			Timestamp: time.Now().UTC(),
+		}
+	}
+	auctionBids := []struct {
+		id   uint64
+		bids []*auctiontypes.Bid
+	}{
+		// Addrs[1] is winning
+		{1, []*auctiontypes.Bid{bid(1, f.Addrs[1], 1100), bid(1, f.Addrs[2], 1200), bid(1, f.Addrs[1], 1300)}},
+		// Addrs[1] was outbid
+		{2, []*auctiontypes.Bid{bid(2, f.Addrs[1], 1100), bid(2, f.Addrs[2], 1200)}},
+		// Addrs[1] did not bid
+		{3, []*auctiontypes.Bid{bid(3, f.Addrs[2], 1100)}},
+		// Addrs[1] is winning
+		{4, []*auctiontypes.Bid{bid(4, f.Addrs[1], 1100)}},
+	}
+	for _, ab := range auctionBids {
+		a := at.ReserveAuction{
+			Id:          ab.id,
+			Owner:       f.Addrs[0].String(),
+			AuctionType: f.ReserveAuctionType,
+			Metadata: &at.ReserveAuctionMetadata{
+				HighestBid: ab.bids[len(ab.bids)-1],
+				NumBids:    uint64(len(ab.bids)),
+			},
+		}
+		require.NoError(f.K.Auctions.Set(f.Ctx, a.Id, &a))
+		for i, b := range ab.bids {
+			require.NoError(f.K.Bids.Set(f.Ctx, collections.Join(a.Id, uint64(i)), *b))
+			bidder := sdk.MustAccAddressFromBech32(b.Bidder)
+			require.NoError(f.K.BidderAuctions.Set(f.Ctx, collections.Join(bidder, a.Id)))
+			require.NoError(f.K.BidderBids.Set(f.Ctx, collections.Join3(bidder, a.Id, uint64(i))))
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		req     auctiontypes.QueryBidderAuctionsRequest
+		expErr  bool
+		expIds  []uint64
+		expBids []int
+		expNext bool
+	}{
+		{
+			name:    "all auctions bid on",
+			req:     auctiontypes.QueryBidderAuctionsRequest{BidderAddress: f.Addrs[1].String()},
+			expIds:  []uint64{1, 2, 4},
+			expBids: []int{2, 1, 1},
+		},
+		{
+			name:    "winning only",
+			req:     auctiontypes.QueryBidderAuctionsRequest{BidderAddress: f.Addrs[1].String(), WinningOnly: true},
+			expIds:  []uint64{1, 4},
+			expBids: []int{2, 1},
+		},
+		{
+			name: "paginated",
+			req: auctiontypes.QueryBidderAuctionsRequest{
+				BidderAddress: f.Addrs[2].String(),
+				Pagination:    &query.PageRequest{Limit: 2},
+			},
+			expIds:  []uint64{1, 2},
+			expBids: []int{1, 1},
+			expNext: true,
+		},
+		{
+			name: "no bids",
+			req:  auctiontypes.QueryBidderAuctionsRequest{BidderAddress: f.Addrs[3].String()},
+		},
+		{
+			name:   "invalid bidder address",
+			req:    auctiontypes.QueryBidderAuctionsRequest{BidderAddress: "invalid"},
+			expErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			queryRes, err := f.QueryServer.BidderAuctions(f.Ctx, &tc.req)
+			if tc.expErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+
+			var ids []uint64
+			var numBids []int
+			for _, ba := range queryRes.Auctions {
+				var auction auctiontypes.Auction
+				require.NoError(f.EnCfg.InterfaceRegistry.UnpackAny(ba.Auction, &auction))
+				ids = append(ids, auction.GetId())
+				numBids = append(numBids, len(ba.Bids))
+				for _, b := range ba.Bids {
+					require.Equal(tc.req.BidderAddress, b.Bidder)
+				}
+			}
+			require.Equal(tc.expIds, ids)
+			require.Equal(tc.expBids, numBids)
+			require.Equal(tc.expNext, len(queryRes.Pagination.GetNextKey()) > 0)
+		})
+	}
+}
+
+func TestQueryAuctionBids(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	require := require.New(t)
+
+	for id, amounts := range map[uint64][]int64{1: {1100, 1200, 1300}, 2: {1500}} {
+		for i, amount := range amounts {
+			require.NoError(f.K.Bids.Set(f.Ctx, collections.Join(id, uint64(i)), auctiontypes.Bid{
+				AuctionId: id,
+				Bidder:    f.Addrs[i%2+1].String(),
+				BidPrice:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), amount),
+				Timestamp: time.Now().UTC(),
+			}))
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		req        auctiontypes.QueryAuctionBidsRequest
+		expAmounts []int64
+		expNext    bool
+	}{
+		{
+			name:       "bids in acceptance order",
+			req:        auctiontypes.QueryAuctionBidsRequest{AuctionId: 1},
+			expAmounts: []int64{1100, 1200, 1300},
+		},
+		{
+			name: "paginated",
+			req: auctiontypes.QueryAuctionBidsRequest{
+				AuctionId:  1,
+				Pagination: &query.PageRequest{Limit: 2},
+			},
+			expAmounts: []int64{1100, 1200},
+			expNext:    true,
+		},
+		{
+			name:       "other auction",
+			req:        auctiontypes.QueryAuctionBidsRequest{AuctionId: 2},
+			expAmounts: []int64{1500},
+		},
+		{
+			name: "no bids",
+			req:  auctiontypes.QueryAuctionBidsRequest{AuctionId: 3},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			queryRes, err := f.QueryServer.AuctionBids(f.Ctx, &tc.req)
+			require.NoError(err)
+
+			var amounts []int64
+			for _, b := range queryRes.Bids {
+				require.Equal(tc.req.AuctionId, b.AuctionId)
+				amounts = append(amounts, b.BidPrice.Amount.Int64())
+			}
+			require.Equal(tc.expAmounts, amounts)
+			require.Equal(tc.expNext, len(queryRes.Pagination.GetNextKey()) > 0)
+		})
+	}
+}
+
+func TestQueryNextMinimumBid(t *testing.T) {
 	f := auctiontestutil.InitFixture(t)
 	require := require.New(t)
 
-	f.MockBankKeeper.EXPECT().
-		SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Eq(auctiontypes.ModuleName), gomock.Any()).
-		Return(nil).
-		AnyTimes()
+	reserve := at.ReserveAuction{
+		Id:          1,
+		Status:      auctiontypes.ACTIVE,
+		Owner:       f.Addrs[0].String(),
+		AuctionType: f.ReserveAuctionType,
+		Metadata: &at.ReserveAuctionMetadata{
+			ReservePrice:    sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000),
+			LastPrice:       sdk.NewInt64Coin(f.K.GetDefaultDenom(), 2000),
+			NumBids:         1,
+			MinIncrementBps: 1000,
+		},
+	}
+	require.NoError(f.K.Auctions.Set(f.Ctx, reserve.Id, &reserve))
+
+	sealed := at.SealedBidAuction{
+		Id:          2,
+		Status:      auctiontypes.ACTIVE,
+		Owner:       f.Addrs[0].String(),
+		AuctionType: f.SealedBidAuctionType,
+		Metadata: &at.SealedBidAuctionMetadata{
+			ReservePrice: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000),
+		},
+	}
+	require.NoError(f.K.Auctions.Set(f.Ctx, sealed.Id, &sealed))
+
+	testCases := []struct {
+		name      string
+		auctionId uint64
+		expMinBid sdk.Coin
+		expErr    string
+	}{
+		{
+			name:      "last price plus increment",
+			auctionId: reserve.Id,
+			expMinBid: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 2200),
+		},
+		{
+			name:      "auction type without minimum bid",
+			auctionId: sealed.Id,
+			expErr:    "has no minimum bid",
+		},
+		{
+			name:      "auction not found",
+			auctionId: 3,
+			expErr:    "unable to retrieve auction with id :: 3",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			queryRes, err := f.QueryServer.NextMinimumBid(f.Ctx, &auctiontypes.QueryNextMinimumBidRequest{AuctionId: tc.auctionId})
+			if tc.expErr != "" {
+				require.ErrorContains(err, tc.expErr)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tc.expMinBid, queryRes.MinBid)
+		})
+	}
+}
 
+func TestQueryGetAllAuctions(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	require := require.New(t)
+
+	otherType := "/fatal_fruit.auction.v1.OtherAuction"
 	auctions := []at.ReserveAuction{
-		{Id: 1, Owner: f.Addrs[0].String()},
-		{Id: 2, Owner: f.Addrs[1].String()},
+		{Id: 1, Owner: f.Addrs[0].String(), Status: auctiontypes.ACTIVE, AuctionType: f.ReserveAuctionType},
+		{Id: 2, Owner: f.Addrs[1].String(), Status: auctiontypes.SETTLED, AuctionType: f.ReserveAuctionType},
+		{Id: 3, Owner: f.Addrs[0].String(), Status: auctiontypes.ACTIVE, AuctionType: otherType},
+		{Id: 4, Owner: f.Addrs[1].String(), Status: auctiontypes.CANCELLED, AuctionType: f.ReserveAuctionType},
+		{Id: 5, Owner: f.Addrs[0].String(), Status: auctiontypes.ACTIVE, AuctionType: f.ReserveAuctionType},
 	}
 	for _, auction := range auctions {
+		auction := auction
 		err := f.K.Auctions.Set(f.Ctx, auction.Id, &auction)
 		require.NoError(err)
 	}
 
-	queryRes, err := f.QueryServer.AllAuctions(f.Ctx, &auctiontypes.QueryAllAuctionsRequest{})
+	testCases := []struct {
+		name     string
+		req      auctiontypes.QueryAllAuctionsRequest
+		expIds   []uint64
+		expTotal uint64
+		expNext  bool
+	}{
+		{
+			name:     "all auctions",
+			req:      auctiontypes.QueryAllAuctionsRequest{},
+			expIds:   []uint64{1, 2, 3, 4, 5},
+			expTotal: 5,
+		},
+		{
+			name: "first page",
+			req: auctiontypes.QueryAllAuctionsRequest{
+				Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+			},
+			expIds:   []uint64{1, 2},
+			expTotal: 5,
+			expNext:  true,
+		},
+		{
+			name: "offset page",
+			req: auctiontypes.QueryAllAuctionsRequest{
+				Pagination: &query.PageRequest{Offset: 4, Limit: 2},
+			},
+			expIds: []uint64{5},
+		},
+		{
+			name: "filter by status",
+			req: auctiontypes.QueryAllAuctionsRequest{
+				Status: "ACTIVE",
+			},
+			expIds:   []uint64{1, 3, 5},
+			expTotal: 3,
+		},
+		{
+			name: "filter by type",
+			req: auctiontypes.QueryAllAuctionsRequest{
+				AuctionType: otherType,
+			},
+			expIds:   []uint64{3},
+			expTotal: 1,
+		},
+		{
+			name: "filter by status and type with pagination",
+			req: auctiontypes.QueryAllAuctionsRequest{
+				Status:      auctiontypes.ACTIVE.String(),
+				AuctionType: f.ReserveAuctionType,
+				Pagination:  &query.PageRequest{Limit: 1, CountTotal: true},
+			},
+			expIds:   []uint64{1},
+			expTotal: 2,
+			expNext:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			queryRes, err := f.QueryServer.AllAuctions(f.Ctx, &tc.req)
+			require.NoError(err)
+
+			var ids []uint64
+			for _, aa := range queryRes.Auctions {
+				var auction auctiontypes.Auction
+				require.NoError(f.EnCfg.InterfaceRegistry.UnpackAny(aa, &auction))
+				ids = append(ids, auction.GetId())
+			}
+			require.Equal(tc.expIds, ids)
+			require.Equal(tc.expTotal, queryRes.Pagination.GetTotal())
+			require.Equal(tc.expNext, len(queryRes.Pagination.GetNextKey()) > 0)
+		})
+	}
+}
+
+func TestQueryQueueAuctions(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	require := require.New(t)
+
+	queues := map[uint64]collections.KeySet[uint64]{
+		1: f.K.ActiveAuctions,
+		2: f.K.ActiveAuctions,
+		3: f.K.ExpiredAuctions,
+		4: f.K.PendingAuctions,
+		5: f.K.PendingAuctions,
+		6: f.K.PendingAuctions,
+		7: f.K.CancelledAuctions,
+	}
+	for id, queue := range queues {
+		auction := at.ReserveAuction{Id: id, Owner: f.Addrs[0].String(), AuctionType: f.ReserveAuctionType}
+		require.NoError(f.K.Auctions.Set(f.Ctx, id, &auction))
+		require.NoError(queue.Set(f.Ctx, id))
+	}
+
+	unpack := func(auctions []*codectypes.Any) []uint64 {
+		var ids []uint64
+		for _, aa := range auctions {
+			var auction auctiontypes.Auction
+			require.NoError(f.EnCfg.InterfaceRegistry.UnpackAny(aa, &auction))
+			ids = append(ids, auction.GetId())
+		}
+		return ids
+	}
+
+	testCases := []struct {
+		name    string
+		query   func(pageReq *query.PageRequest) ([]*codectypes.Any, *query.PageResponse, error)
+		pageReq *query.PageRequest
+		expIds  []uint64
+		expNext bool
+	}{
+		{
+			name: "active auctions",
+			query: func(pageReq *query.PageRequest) ([]*codectypes.Any, *query.PageResponse, error) {
+				res, err := f.QueryServer.ActiveAuctions(f.Ctx, &auctiontypes.QueryActiveAuctionsRequest{Pagination: pageReq})
+				return res.GetAuctions(), res.GetPagination(), err
+			},
+			expIds: []uint64{1, 2},
+		},
+		{
+			name: "expired auctions",
+			query: func(pageReq *query.PageRequest) ([]*codectypes.Any, *query.PageResponse, error) {
+				res, err := f.QueryServer.ExpiredAuctions(f.Ctx, &auctiontypes.QueryExpiredAuctionsRequest{Pagination: pageReq})
+				return res.GetAuctions(), res.GetPagination(), err
+			},
+			expIds: []uint64{3},
+		},
+		{
+			name: "pending auctions",
+			query: func(pageReq *query.PageRequest) ([]*codectypes.Any, *query.PageResponse, error) {
+				res, err := f.QueryServer.PendingAuctions(f.Ctx, &auctiontypes.QueryPendingAuctionsRequest{Pagination: pageReq})
+				return res.GetAuctions(), res.GetPagination(), err
+			},
+			expIds: []uint64{4, 5, 6},
+		},
+		{
+			name: "pending auctions second page",
+			query: func(pageReq *query.PageRequest) ([]*codectypes.Any, *query.PageResponse, error) {
+				res, err := f.QueryServer.PendingAuctions(f.Ctx, &auctiontypes.QueryPendingAuctionsRequest{Pagination: pageReq})
+				return res.GetAuctions(), res.GetPagination(), err
+			},
+			pageReq: &query.PageRequest{Offset: 1, Limit: 1},
+			expIds:  []uint64{5},
+			expNext: true,
+		},
+		{
+			name: "cancelled auctions",
+			query: func(pageReq *query.PageRequest) ([]*codectypes.Any, *query.PageResponse, error) {
+				res, err := f.QueryServer.CancelledAuctions(f.Ctx, &auctiontypes.QueryCancelledAuctionsRequest{Pagination: pageReq})
+				return res.GetAuctions(), res.GetPagination(), err
+			},
+			expIds: []uint64{7},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			auctions, pageRes, err := tc.query(tc.pageReq)
+			require.NoError(err)
+			require.Equal(tc.expIds, unpack(auctions))
+			require.Equal(tc.expNext, len(pageRes.GetNextKey()) > 0)
+		})
+	}
+}
+
+func TestQueryParams(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	require := require.New(t)
+
+	queryRes, err := f.QueryServer.Params(f.Ctx, &auctiontypes.QueryParamsRequest{})
+	require.NoError(err)
+	require.Equal(auctiontypes.DefaultParams(), queryRes.Params)
+
+	params := auctiontypes.DefaultParams()
+	params.AllowedDepositDenoms = []string{f.K.GetDefaultDenom()}
+	require.NoError(f.K.Params.Set(f.Ctx, params))
+
+	queryRes, err = f.QueryServer.Params(f.Ctx, &auctiontypes.QueryParamsRequest{})
 	require.NoError(err)
-	require.Len(queryRes.Auctions, len(auctions))
+	require.Equal(params, queryRes.Params)
 }
@@ -0,0 +1,66 @@
+package v2
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	at "github.com/fatal-fruit/auction/auctiontypes"
+	auctiontypes "github.com/fatal-fruit/auction/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2. The migration
+// moves the bids held inline on reserve auctions into the bids collection,
+// keyed by auction ID and bid sequence, and records the leading bid and bid
+// count on the auction metadata. Version 1 stored no params, so the default params are
+// set.
+func MigrateStore(
+	ctx context.Context,
+	params collections.Item[auctiontypes.Params],
+	auctions collections.Map[uint64, auctiontypes.Auction],
+	bids collections.Map[collections.Pair[uint64, uint64], auctiontypes.Bid],
+	bidderAuctions collections.KeySet[collections.Pair[sdk.AccAddress, uint64]],
+) error {
+	if err := params.Set(ctx, auctiontypes.DefaultParams()); err != nil {
+		return err
+	}
+
+	var migrated []*at.ReserveAuction
+	err := auctions.Walk(ctx, nil, func(_ uint64, auction auctiontypes.Auction) (stop bool, err error) {
+		ra, ok := auction.(*at.ReserveAuction)
+		if !ok || ra.Metadata == nil || len(ra.Metadata.Bids) == 0 {
+			return false, nil
+		}
+		migrated = append(migrated, ra)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, ra := range migrated {
+		for i, b := range ra.Metadata.Bids {
+			if err := bids.Set(ctx, collections.Join(ra.Id, uint64(i)), *b); err != nil {
+				return err
+			}
+
+			bidder, err := sdk.AccAddressFromBech32(b.Bidder)
+			if err != nil {
+				return err
+			}
+			if err := bidderAuctions.Set(ctx, collections.Join(bidder, ra.Id)); err != nil {
+				return err
+			}
+		}
+
+		// Accepted bids strictly increase, so the last bid is the leading one
+		ra.Metadata.HighestBid = ra.Metadata.Bids[len(ra.Metadata.Bids)-1]
+		ra.Metadata.NumBids = uint64(len(ra.Metadata.Bids))
+		ra.Metadata.Bids = nil
+		if err := auctions.Set(ctx, ra.Id, ra); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
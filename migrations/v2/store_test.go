@@ -0,0 +1,80 @@
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	at "github.com/fatal-fruit/auction/auctiontypes"
+	v2 "github.com/fatal-fruit/auction/migrations/v2"
+	auctiontestutil "github.com/fatal-fruit/auction/testutil"
+	auctiontypes "github.com/fatal-fruit/auction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateStore(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	require := require.New(t)
+
+	bid := func(id uint64, bidder sdk.AccAddress, amount int64) *auctiontypes.Bid {
+		return &auctiontypes.Bid{
+			AuctionId: id,
+			Bidder:    bidder.String(),
+			BidPrice:  sdk.NewInt64Coin(f.K.GetDefaultDenom(), amount),
+			Timestamp: time.Now().UTC(),
+		}
+	}
+	legacy := []*at.ReserveAuction{
+		{Id: 0, Metadata: &at.ReserveAuctionMetadata{}},
+		{Id: 1, Metadata: &at.ReserveAuctionMetadata{Bids: []*auctiontypes.Bid{bid(1, f.Addrs[1], 1100), bid(1, f.Addrs[2], 1200)}}},
+		{Id: 2, Metadata: &at.ReserveAuctionMetadata{Bids: []*auctiontypes.Bid{bid(2, f.Addrs[3], 1500)}}},
+	}
+	for _, a := range legacy {
+		a.Owner = f.Addrs[0].String()
+		a.AuctionType = f.ReserveAuctionType
+		require.NoError(f.K.Auctions.Set(f.Ctx, a.Id, a))
+	}
+
+	// Version 1 had no params
+	require.NoError(f.K.Params.Remove(f.Ctx))
+
+	require.NoError(v2.MigrateStore(f.Ctx, f.K.Params, f.K.Auctions, f.K.Bids, f.K.BidderAuctions))
+
+	params, err := f.K.Params.Get(f.Ctx)
+	require.NoError(err)
+	require.Equal(auctiontypes.DefaultParams(), params)
+
+	testCases := []struct {
+		id         uint64
+		expBidders []sdk.AccAddress
+	}{
+		{id: 0},
+		{id: 1, expBidders: []sdk.AccAddress{f.Addrs[1], f.Addrs[2]}},
+		{id: 2, expBidders: []sdk.AccAddress{f.Addrs[3]}},
+	}
+	for _, tc := range testCases {
+		auction, err := f.K.Auctions.Get(f.Ctx, tc.id)
+		require.NoError(err)
+		ra := auction.(*at.ReserveAuction)
+		require.Empty(ra.Metadata.Bids)
+		require.Equal(uint64(len(tc.expBidders)), ra.NumBids())
+
+		bids, err := f.K.GetAuctionBids(f.Ctx, tc.id)
+		require.NoError(err)
+		require.Len(bids, len(tc.expBidders))
+		for i, bidder := range tc.expBidders {
+			require.Equal(bidder.String(), bids[i].Bidder)
+
+			has, err := f.K.BidderAuctions.Has(f.Ctx, collections.Join(bidder, tc.id))
+			require.NoError(err)
+			require.True(has)
+		}
+
+		if len(tc.expBidders) > 0 {
+			require.Equal(bids[len(bids)-1].String(), ra.GetLeadingBid().String())
+		} else {
+			require.Nil(ra.GetLeadingBid())
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package v3
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	at "github.com/fatal-fruit/auction/auctiontypes"
+	auctiontypes "github.com/fatal-fruit/auction/types"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3. The migration
+// packs the settle strategy held on each auction's metadata into the
+// settlement strategy Any, which is resolved by its type URL.
+func MigrateStore(ctx context.Context, auctions collections.Map[uint64, auctiontypes.Auction]) error {
+	var migrated []auctiontypes.Auction
+	err := auctions.Walk(ctx, nil, func(_ uint64, auction auctiontypes.Auction) (stop bool, err error) {
+		ok, err := migrateStrategy(auction)
+		if err != nil {
+			return true, err
+		}
+		if ok {
+			migrated = append(migrated, auction)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, a := range migrated {
+		if err := auctions.Set(ctx, a.GetId(), a); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// migrateStrategy moves the deprecated strategy of an auction into its settlement
+// strategy. It returns false if the auction has nothing to migrate.
+func migrateStrategy(auction auctiontypes.Auction) (bool, error) {
+	var err error
+	switch a := auction.(type) {
+	case *at.ReserveAuction:
+		if a.Metadata == nil || a.Metadata.Strategy == nil {
+			return false, nil
+		}
+		a.Metadata.SettlementStrategy, err = codectypes.NewAnyWithValue(a.Metadata.Strategy)
+		a.Metadata.Strategy = nil
+	case *at.DutchAuction:
+		if a.Metadata == nil || a.Metadata.Strategy == nil {
+			return false, nil
+		}
+		a.Metadata.SettlementStrategy, err = codectypes.NewAnyWithValue(a.Metadata.Strategy)
+		a.Metadata.Strategy = nil
+	case *at.SealedBidAuction:
+		if a.Metadata == nil || a.Metadata.Strategy == nil {
+			return false, nil
+		}
+		a.Metadata.SettlementStrategy, err = codectypes.NewAnyWithValue(a.Metadata.Strategy)
+		a.Metadata.Strategy = nil
+	default:
+		return false, nil
+	}
+	return err == nil, err
+}
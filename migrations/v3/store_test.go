@@ -0,0 +1,61 @@
+package v3_test
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	at "github.com/fatal-fruit/auction/auctiontypes"
+	v3 "github.com/fatal-fruit/auction/migrations/v3"
+	auctiontestutil "github.com/fatal-fruit/auction/testutil"
+	auctiontypes "github.com/fatal-fruit/auction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateStore(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	require := require.New(t)
+
+	strategy := func(id uint64) *at.SettleStrategy {
+		return &at.SettleStrategy{
+			StrategyType:          auctiontypes.SETTLE,
+			EscrowContractId:      id,
+			EscrowContractAddress: f.Addrs[2].String(),
+		}
+	}
+	legacy := []auctiontypes.Auction{
+		&at.ReserveAuction{Id: 0, AuctionType: f.ReserveAuctionType, Metadata: &at.ReserveAuctionMetadata{Strategy: strategy(0)}},
+		&at.DutchAuction{Id: 1, AuctionType: f.DutchAuctionType, Metadata: &at.DutchAuctionMetadata{Strategy: strategy(1)}},
+		&at.SealedBidAuction{Id: 2, AuctionType: f.SealedBidAuctionType, Metadata: &at.SealedBidAuctionMetadata{Strategy: strategy(2)}},
+		// Already migrated auctions are left untouched
+		&at.ReserveAuction{Id: 3, AuctionType: f.ReserveAuctionType, Metadata: &at.ReserveAuctionMetadata{
+			SettlementStrategy: codectypes.UnsafePackAny(strategy(3)),
+		}},
+	}
+	for _, a := range legacy {
+		a.SetOwner(f.Addrs[0])
+		require.NoError(f.K.Auctions.Set(f.Ctx, a.GetId(), a))
+	}
+
+	require.NoError(v3.MigrateStore(f.Ctx, f.K.Auctions))
+
+	for _, a := range legacy {
+		auction, err := f.K.Auctions.Get(f.Ctx, a.GetId())
+		require.NoError(err)
+
+		var settlement *codectypes.Any
+		switch m := auction.GetAuctionMetadata().(type) {
+		case *at.ReserveAuctionMetadata:
+			require.Nil(m.Strategy)
+			settlement = m.SettlementStrategy
+		case *at.DutchAuctionMetadata:
+			require.Nil(m.Strategy)
+			settlement = m.SettlementStrategy
+		case *at.SealedBidAuctionMetadata:
+			require.Nil(m.Strategy)
+			settlement = m.SettlementStrategy
+		}
+		require.Equal(sdk.MsgTypeURL(&at.SettleStrategy{}), settlement.GetTypeUrl())
+		require.Equal(strategy(a.GetId()), at.GetStrategy(settlement))
+	}
+}
@@ -0,0 +1,137 @@
+package v4
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	at "github.com/fatal-fruit/auction/auctiontypes"
+	auctiontypes "github.com/fatal-fruit/auction/types"
+)
+
+// Statuses stored on auctions before statuses were validated. Auctions were stored as
+// ACTIVE when created, CLOSED when executed and CANCELLED when cancelled.
+const (
+	legacyActive    = "ACTIVE"
+	legacyClosed    = "CLOSED"
+	legacyCancelled = "CANCELLED"
+)
+
+// Queues holds the auction queues the status of an auction is derived from.
+type Queues struct {
+	Active    collections.KeySet[uint64]
+	Reveal    collections.KeySet[uint64]
+	Expired   collections.KeySet[uint64]
+	Pending   collections.KeySet[uint64]
+	Cancelled collections.KeySet[uint64]
+}
+
+// MigrateStore performs in-place store migrations from v3 to v4. The migration sets the
+// status of every auction from the queue holding it. Auctions outside of the queues were
+// created but not started, executed or cancelled, which is read from their legacy status.
+//
+// The cancelled queue now holds auctions whose funds have not been returned. Auctions
+// cancelled by their owner were refunded when cancelled, so they are removed from it.
+func MigrateStore(ctx context.Context, auctions collections.Map[uint64, auctiontypes.Auction], queues Queues) error {
+	var migrated []auctiontypes.Auction
+	var refunded []uint64
+	err := auctions.Walk(ctx, nil, func(id uint64, auction auctiontypes.Auction) (stop bool, err error) {
+		status, err := queueStatus(ctx, id, queues)
+		if err != nil {
+			return true, err
+		}
+		switch {
+		case status == auctiontypes.AUCTION_STATUS_UNSPECIFIED:
+			status, err = unqueuedStatus(auction)
+			if err != nil {
+				return true, err
+			}
+		case status == auctiontypes.CANCELLED && getLegacyStatus(auction) == legacyCancelled:
+			refunded = append(refunded, id)
+		}
+
+		auction.UpdateStatus(status)
+		clearLegacyStatus(auction)
+		migrated = append(migrated, auction)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, a := range migrated {
+		if err := auctions.Set(ctx, a.GetId(), a); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range refunded {
+		if err := queues.Cancelled.Remove(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// queueStatus returns the status matching the queue holding an auction, or
+// AUCTION_STATUS_UNSPECIFIED if it is not queued.
+func queueStatus(ctx context.Context, id uint64, queues Queues) (auctiontypes.AuctionStatus, error) {
+	statuses := []struct {
+		queue  collections.KeySet[uint64]
+		status auctiontypes.AuctionStatus
+	}{
+		{queues.Active, auctiontypes.ACTIVE},
+		{queues.Reveal, auctiontypes.ACTIVE},
+		{queues.Expired, auctiontypes.EXPIRED},
+		{queues.Pending, auctiontypes.PENDING},
+		{queues.Cancelled, auctiontypes.CANCELLED},
+	}
+	for _, s := range statuses {
+		has, err := s.queue.Has(ctx, id)
+		if err != nil {
+			return auctiontypes.AUCTION_STATUS_UNSPECIFIED, err
+		}
+		if has {
+			return s.status, nil
+		}
+	}
+	return auctiontypes.AUCTION_STATUS_UNSPECIFIED, nil
+}
+
+// unqueuedStatus returns the status of an auction outside of the queues from its legacy
+// status.
+func unqueuedStatus(auction auctiontypes.Auction) (auctiontypes.AuctionStatus, error) {
+	switch legacy := getLegacyStatus(auction); legacy {
+	case legacyActive:
+		return auctiontypes.CREATED, nil
+	case legacyClosed:
+		return auctiontypes.SETTLED, nil
+	case legacyCancelled:
+		return auctiontypes.CANCELLED, nil
+	default:
+		return auctiontypes.AUCTION_STATUS_UNSPECIFIED, fmt.Errorf("invalid legacy status %q for auction %d", legacy, auction.GetId())
+	}
+}
+
+func getLegacyStatus(auction auctiontypes.Auction) string {
+	if a, ok := auction.(interface{ GetLegacyStatus() string }); ok {
+		return a.GetLegacyStatus()
+	}
+	return ""
+}
+
+func clearLegacyStatus(auction auctiontypes.Auction) {
+	switch a := auction.(type) {
+	case *at.ReserveAuction:
+		a.LegacyStatus = ""
+	case *at.DutchAuction:
+		a.LegacyStatus = ""
+	case *at.SealedBidAuction:
+		a.LegacyStatus = ""
+	case *at.BatchAuction:
+		a.LegacyStatus = ""
+	case *at.ReverseAuction:
+		a.LegacyStatus = ""
+	}
+}
@@ -0,0 +1,138 @@
+package v4_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	at "github.com/fatal-fruit/auction/auctiontypes"
+	v4 "github.com/fatal-fruit/auction/migrations/v4"
+	auctiontestutil "github.com/fatal-fruit/auction/testutil"
+	auctiontypes "github.com/fatal-fruit/auction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateStore(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	require := require.New(t)
+
+	testCases := []struct {
+		auction      auctiontypes.Auction
+		queue        func(ctx context.Context, id uint64) error
+		expStatus    auctiontypes.AuctionStatus
+		expCancelled bool
+	}{
+		{&at.ReserveAuction{Id: 0, LegacyStatus: "ACTIVE"}, f.K.ActiveAuctions.Set, auctiontypes.ACTIVE, false},
+		{&at.SealedBidAuction{Id: 1, LegacyStatus: "ACTIVE"}, f.K.RevealAuctions.Set, auctiontypes.ACTIVE, false},
+		{&at.DutchAuction{Id: 2, LegacyStatus: "ACTIVE"}, f.K.ExpiredAuctions.Set, auctiontypes.EXPIRED, false},
+		{&at.BatchAuction{Id: 3, LegacyStatus: "ACTIVE"}, f.K.PendingAuctions.Set, auctiontypes.PENDING, false},
+		// Auctions expired without bids kept their active status and still hold their funds
+		{&at.ReverseAuction{Id: 4, LegacyStatus: "ACTIVE"}, f.K.CancelledAuctions.Set, auctiontypes.CANCELLED, true},
+		// Auctions cancelled by their owner were refunded when cancelled
+		{&at.ReserveAuction{Id: 5, LegacyStatus: "CANCELLED"}, f.K.CancelledAuctions.Set, auctiontypes.CANCELLED, false},
+		// Auctions that left the queues were executed or cancelled
+		{&at.ReserveAuction{Id: 6, LegacyStatus: "CLOSED"}, nil, auctiontypes.SETTLED, false},
+		{&at.ReserveAuction{Id: 7, LegacyStatus: "CANCELLED"}, nil, auctiontypes.CANCELLED, false},
+		// Auctions created but not started were never queued
+		{&at.DutchAuction{Id: 8, LegacyStatus: "ACTIVE"}, nil, auctiontypes.CREATED, false},
+	}
+	for _, tc := range testCases {
+		tc.auction.SetOwner(f.Addrs[0])
+		require.NoError(f.K.Auctions.Set(f.Ctx, tc.auction.GetId(), tc.auction))
+		if tc.queue != nil {
+			require.NoError(tc.queue(f.Ctx, tc.auction.GetId()))
+		}
+	}
+
+	require.NoError(v4.MigrateStore(f.Ctx, f.K.Auctions, v4.Queues{
+		Active:    f.K.ActiveAuctions,
+		Reveal:    f.K.RevealAuctions,
+		Expired:   f.K.ExpiredAuctions,
+		Pending:   f.K.PendingAuctions,
+		Cancelled: f.K.CancelledAuctions,
+	}))
+
+	for _, tc := range testCases {
+		auction, err := f.K.Auctions.Get(f.Ctx, tc.auction.GetId())
+		require.NoError(err)
+		require.Equal(tc.expStatus, auction.GetStatus(), "auction %d", tc.auction.GetId())
+		require.Empty(auction.(interface{ GetLegacyStatus() string }).GetLegacyStatus())
+
+		isCancelled, err := f.K.CancelledAuctions.Has(f.Ctx, tc.auction.GetId())
+		require.NoError(err)
+		require.Equal(tc.expCancelled, isCancelled, "auction %d", tc.auction.GetId())
+	}
+}
+
+func TestMigrateStoreRefundsExpiredAuctions(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	require := require.New(t)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("uasset", 1))
+	newAuction := func(id uint64, legacy string) *at.ReserveAuction {
+		return &at.ReserveAuction{
+			Id:           id,
+			LegacyStatus: legacy,
+			Owner:        f.Addrs[0].String(),
+			AuctionType:  f.ReserveAuctionType,
+			Deposit:      deposit,
+			Metadata: &at.ReserveAuctionMetadata{
+				ReservePrice: sdk.NewInt64Coin(f.K.GetDefaultDenom(), 1000),
+				SettlementStrategy: codectypes.UnsafePackAny(&at.SettleStrategy{
+					StrategyType:          auctiontypes.SETTLE,
+					EscrowContractId:      id,
+					EscrowContractAddress: f.Addrs[2].String(),
+				}),
+			},
+		}
+	}
+
+	// An auction that expired without bids before the upgrade still holds its deposit, while
+	// the one cancelled by its owner was refunded when cancelled
+	expired, cancelled := uint64(0), uint64(1)
+	require.NoError(f.K.Auctions.Set(f.Ctx, expired, newAuction(expired, "ACTIVE")))
+	require.NoError(f.K.CancelledAuctions.Set(f.Ctx, expired))
+	require.NoError(f.K.Auctions.Set(f.Ctx, cancelled, newAuction(cancelled, "CANCELLED")))
+	require.NoError(f.K.CancelledAuctions.Set(f.Ctx, cancelled))
+
+	require.NoError(v4.MigrateStore(f.Ctx, f.K.Auctions, v4.Queues{
+		Active:    f.K.ActiveAuctions,
+		Reveal:    f.K.RevealAuctions,
+		Expired:   f.K.ExpiredAuctions,
+		Pending:   f.K.PendingAuctions,
+		Cancelled: f.K.CancelledAuctions,
+	}))
+
+	// Only the expired auction is refunded
+	f.MockEscrowService.EXPECT().Release(f.Ctx, expired, f.Addrs[2], f.Addrs[0]).Return(nil).Times(1)
+	f.MockBankKeeper.EXPECT().SendCoinsFromModuleToAccount(f.Ctx, auctiontypes.ModuleName, f.Addrs[0], deposit).Return(nil).Times(1)
+
+	require.NoError(f.K.ProcessCancelledAuctions(f.Ctx, nil))
+
+	isCancelled, err := f.K.CancelledAuctions.Has(f.Ctx, expired)
+	require.NoError(err)
+	require.False(isCancelled)
+}
+
+func TestMigrateStoreInvalidLegacyStatus(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	require := require.New(t)
+
+	for id, legacy := range []string{"", "EXPIRED"} {
+		auction := &at.ReserveAuction{Id: uint64(id), LegacyStatus: legacy}
+		auction.SetOwner(f.Addrs[0])
+		require.NoError(f.K.Auctions.Set(f.Ctx, auction.GetId(), auction))
+
+		err := v4.MigrateStore(f.Ctx, f.K.Auctions, v4.Queues{
+			Active:    f.K.ActiveAuctions,
+			Reveal:    f.K.RevealAuctions,
+			Expired:   f.K.ExpiredAuctions,
+			Pending:   f.K.PendingAuctions,
+			Cancelled: f.K.CancelledAuctions,
+		})
+		require.ErrorContains(err, fmt.Sprintf("invalid legacy status %q", legacy))
+		require.NoError(f.K.Auctions.Remove(f.Ctx, auction.GetId()))
+	}
+}
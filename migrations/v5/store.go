@@ -0,0 +1,34 @@
+package v5
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	auctiontypes "github.com/fatal-fruit/auction/types"
+)
+
+// MigrateStore performs in-place store migrations from v4 to v5. The migration indexes
+// every auction in the active queue by its end time.
+func MigrateStore(ctx context.Context, auctions collections.Map[uint64, auctiontypes.Auction], active collections.KeySet[uint64], byEndTime collections.KeySet[collections.Pair[time.Time, uint64]]) error {
+	var keys []collections.Pair[time.Time, uint64]
+	err := active.Walk(ctx, nil, func(id uint64) (stop bool, err error) {
+		auction, err := auctions.Get(ctx, id)
+		if err != nil {
+			return true, err
+		}
+		keys = append(keys, collections.Join(auction.GetEndTime(), id))
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := byEndTime.Set(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package v5_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	at "github.com/fatal-fruit/auction/auctiontypes"
+	v5 "github.com/fatal-fruit/auction/migrations/v5"
+	auctiontestutil "github.com/fatal-fruit/auction/testutil"
+	auctiontypes "github.com/fatal-fruit/auction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateStore(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	require := require.New(t)
+
+	end := time.Unix(1700000000, 0).UTC()
+	auctions := []auctiontypes.Auction{
+		&at.ReserveAuction{Id: 0, Status: auctiontypes.ACTIVE, Metadata: &at.ReserveAuctionMetadata{EndTime: end}},
+		&at.DutchAuction{Id: 1, Status: auctiontypes.ACTIVE, Metadata: &at.DutchAuctionMetadata{EndTime: end.Add(time.Minute)}},
+		// Auctions outside of the active queue are not indexed
+		&at.SealedBidAuction{Id: 2, Status: auctiontypes.ACTIVE, Metadata: &at.SealedBidAuctionMetadata{EndTime: end}},
+		&at.BatchAuction{Id: 3, Status: auctiontypes.PENDING, Metadata: &at.BatchAuctionMetadata{EndTime: end}},
+	}
+	for _, a := range auctions {
+		a.SetOwner(f.Addrs[0])
+		require.NoError(f.K.Auctions.Set(f.Ctx, a.GetId(), a))
+	}
+	require.NoError(f.K.ActiveAuctions.Set(f.Ctx, 0))
+	require.NoError(f.K.ActiveAuctions.Set(f.Ctx, 1))
+	require.NoError(f.K.RevealAuctions.Set(f.Ctx, 2))
+	require.NoError(f.K.PendingAuctions.Set(f.Ctx, 3))
+
+	require.NoError(v5.MigrateStore(f.Ctx, f.K.Auctions, f.K.ActiveAuctions, f.K.ActiveAuctionsByEndTime))
+
+	var indexed []collections.Pair[time.Time, uint64]
+	err := f.K.ActiveAuctionsByEndTime.Walk(f.Ctx, nil, func(key collections.Pair[time.Time, uint64]) (stop bool, err error) {
+		indexed = append(indexed, key)
+		return false, nil
+	})
+	require.NoError(err)
+	require.Equal([]collections.Pair[time.Time, uint64]{
+		collections.Join(end, uint64(0)),
+		collections.Join(end.Add(time.Minute), uint64(1)),
+	}, indexed)
+}
@@ -0,0 +1,34 @@
+package v6
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	auctiontypes "github.com/fatal-fruit/auction/types"
+)
+
+// MigrateStore performs in-place store migrations from v5 to v6. The migration indexes
+// every stored bid by its bidder, auction and sequence.
+func MigrateStore(ctx context.Context, bids collections.Map[collections.Pair[uint64, uint64], auctiontypes.Bid], bidderBids collections.KeySet[collections.Triple[sdk.AccAddress, uint64, uint64]]) error {
+	var keys []collections.Triple[sdk.AccAddress, uint64, uint64]
+	err := bids.Walk(ctx, nil, func(key collections.Pair[uint64, uint64], bid auctiontypes.Bid) (stop bool, err error) {
+		bidder, err := sdk.AccAddressFromBech32(bid.Bidder)
+		if err != nil {
+			return true, err
+		}
+		keys = append(keys, collections.Join3(bidder, key.K1(), key.K2()))
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := bidderBids.Set(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package v6_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v6 "github.com/fatal-fruit/auction/migrations/v6"
+	auctiontestutil "github.com/fatal-fruit/auction/testutil"
+	auctiontypes "github.com/fatal-fruit/auction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateStore(t *testing.T) {
+	f := auctiontestutil.InitFixture(t)
+	require := require.New(t)
+
+	bids := []struct {
+		auctionId uint64
+		seq       uint64
+		bidder    sdk.AccAddress
+	}{
+		{1, 0, f.Addrs[1]},
+		{1, 1, f.Addrs[2]},
+		{1, 2, f.Addrs[1]},
+		{2, 0, f.Addrs[2]},
+	}
+	for _, b := range bids {
+		require.NoError(f.K.Bids.Set(f.Ctx, collections.Join(b.auctionId, b.seq), auctiontypes.Bid{
+			AuctionId: b.auctionId,
+			Bidder:    b.bidder.String(),
+		}))
+	}
+
+	require.NoError(v6.MigrateStore(f.Ctx, f.K.Bids, f.K.BidderBids))
+
+	for _, b := range bids {
+		has, err := f.K.BidderBids.Has(f.Ctx, collections.Join3(b.bidder, b.auctionId, b.seq))
+		require.NoError(err)
+		require.True(has)
+	}
+
+	got, err := f.K.GetBidderBids(f.Ctx, f.Addrs[1], 1)
+	require.NoError(err)
+	require.Len(got, 2)
+	for _, b := range got {
+		require.Equal(f.Addrs[1].String(), b.Bidder)
+	}
+}
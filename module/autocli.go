@@ -19,16 +19,83 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "id"},
 					},
 				},
+				{
+					RpcMethod: "NextMinimumBid",
+					Use:       "next-minimum-bid [auction_id]",
+					Short:     "Get the lowest bid an auction will currently accept",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "auction_id"},
+					},
+				},
 				{
 					RpcMethod: "AllAuctions",
 					Use:       "all-auctions",
-					Short:     "Get all auctions",
+					Short:     "Get all auctions, optionally filtered by status and type",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"status": {
+							Usage: "only return auctions with this status",
+						},
+						"auction_type": {
+							Usage: "only return auctions of this type URL",
+						},
+					},
+				},
+				{
+					RpcMethod: "ActiveAuctions",
+					Use:       "active-auctions",
+					Short:     "Get auctions in the active queue",
+				},
+				{
+					RpcMethod: "RevealAuctions",
+					Use:       "reveal-auctions",
+					Short:     "Get sealed-bid auctions in the reveal queue",
+				},
+				{
+					RpcMethod: "ExpiredAuctions",
+					Use:       "expired-auctions",
+					Short:     "Get auctions in the expired queue",
+				},
+				{
+					RpcMethod: "PendingAuctions",
+					Use:       "pending-auctions",
+					Short:     "Get auctions in the pending queue",
+				},
+				{
+					RpcMethod: "CancelledAuctions",
+					Use:       "cancelled-auctions",
+					Short:     "Get auctions in the cancelled queue",
 				},
 				{
 					RpcMethod: "OwnerAuctions",
 					Use:       "owner-auctions [owner-address]",
 					Short:     "Query auctions by owner address",
 				},
+				{
+					RpcMethod: "AuctionBids",
+					Use:       "auction-bids [auction_id]",
+					Short:     "Get the bids placed on an auction",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "auction_id"},
+					},
+				},
+				{
+					RpcMethod: "BidderAuctions",
+					Use:       "bidder-auctions [bidder-address]",
+					Short:     "Query auctions and bids by bidder address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "bidder_address"},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"winning_only": {
+							Usage: "only return auctions the bidder is currently winning",
+						},
+					},
+				},
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current auction module parameters",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -18,16 +18,17 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 
 	auctionabci "github.com/fatal-fruit/auction/abci"
+	at "github.com/fatal-fruit/auction/auctiontypes"
 	auctioncli "github.com/fatal-fruit/auction/client"
 	"github.com/fatal-fruit/auction/keeper"
 )
 
-const ConsensusVersion = 1
+const ConsensusVersion = 6
 
 var (
 	_ module.AppModuleBasic = AppModule{}
-	//_ module.HasGenesis     = AppModule{}
-	_ module.HasServices = AppModule{}
+	_ module.HasABCIGenesis = AppModule{}
+	_ module.HasServices    = AppModule{}
 
 	_ appmodule.AppModule     = AppModule{}
 	_ appmodule.HasEndBlocker = AppModule{}
@@ -63,6 +64,7 @@ func (AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwrunt
 
 func (AppModule) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	auctiontypes.RegisterInterfaces(registry)
+	at.RegisterInterfaces(registry)
 }
 
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
@@ -76,10 +78,22 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	auctiontypes.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerImpl(am.keeper))
 
 	// Register in place module state migration migrations
-	// m := keeper.NewMigrator(am.keeper)
-	// if err := cfg.RegisterMigration(ns.ModuleName, 1, m.Migrate1to2); err != nil {
-	// 	panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", ns.ModuleName, err))
-	// }
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(auctiontypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", auctiontypes.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(auctiontypes.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", auctiontypes.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(auctiontypes.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 3 to 4: %v", auctiontypes.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(auctiontypes.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 4 to 5: %v", auctiontypes.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(auctiontypes.ModuleName, 5, m.Migrate5to6); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 5 to 6: %v", auctiontypes.ModuleName, err))
+	}
 }
 
 func (AppModule) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
@@ -128,6 +142,9 @@ func (AppModule) GetTxCmd() *cobra.Command {
 		auctioncli.NewAuctionCmd(),
 		auctioncli.StartAuctionCmd(),
 		auctioncli.BidCmd(),
+		auctioncli.SealedBidCmd(),
+		auctioncli.BatchBidCmd(),
+		auctioncli.RevealBidCmd(),
 		auctioncli.ExecuteAuctionCmd(),
 		auctioncli.CancelAuctionCmd(),
 	)
@@ -20,11 +20,11 @@ Escrow Service Test Implementation
 
 BasicEscrowService is a testing util for the purpose of facilitating simple escrow contracts.
 The ReserveAuction implementation specifies a strategy to settle the auction. This strategy is instantiated
-with an escrow contract before the auction is started. When the auction is executed, it releases the contract
-and sends its balance to the winner of the auction.
+with an escrow contract before the auction is started. Bids are held by the contract; when the auction is
+executed, the winning bid is paid to the owner and every other bid is returned to its bidder.
 
 EscrowService specifies two functions, NewContract, and Release. NewContract is called when the Auction
-implementation calls CreateAuction. Release is called when the Auction calls Exec.
+implementation calls CreateAuction. Release is called when the Auction is cancelled.
 
 EscrowModContract consists of a single Id and Module Account address.
 */
@@ -25,33 +25,60 @@ type TestFixture struct {
 	QueryServer auctiontypes.QueryServer
 	Resolver    auctiontypes.AuctionResolver
 
+	StrategyResolver auctiontypes.StrategyResolver
+
 	MockAcctKeeper    *MockAccountKeeper
 	MockBankKeeper    *MockBankKeeper
 	MockEscrowService *MockEscrowService
 
-	Addrs              []sdk.AccAddress
-	ModAccount         *authtypes.ModuleAccount
-	ModAddr            sdk.AccAddress
-	Logger             log.Logger
-	ReserveAuctionType string
+	Addrs                []sdk.AccAddress
+	ModAccount           *authtypes.ModuleAccount
+	ModAddr              sdk.AccAddress
+	Logger               log.Logger
+	ReserveAuctionType   string
+	DutchAuctionType     string
+	SealedBidAuctionType string
+	BatchAuctionType     string
+	ReverseAuctionType   string
 }
 
-func InitFixture(t *testing.T) *TestFixture {
+func InitFixture(t testing.TB) *TestFixture {
 	encConfig := moduletestutil.MakeTestEncodingConfig()
 	encConfig.InterfaceRegistry.RegisterInterface(
 		"fatal_fruit.auction.v1.AuctionMetadata",
 		(*auctiontypes.AuctionMetadata)(nil),
 		&at.ReserveAuctionMetadata{},
+		&at.DutchAuctionMetadata{},
+		&at.SealedBidAuctionMetadata{},
+		&at.BatchAuctionMetadata{},
+		&at.ReverseAuctionMetadata{},
 	)
 	encConfig.InterfaceRegistry.RegisterInterface(
 		"fatal_fruit.auction.v1.Auction",
 		(*auctiontypes.Auction)(nil),
 		&at.ReserveAuction{},
+		&at.DutchAuction{},
+		&at.SealedBidAuction{},
+		&at.BatchAuction{},
+		&at.ReverseAuction{},
+	)
+	encConfig.InterfaceRegistry.RegisterInterface(
+		"fatal_fruit.auction.v1.BidMetadata",
+		(*auctiontypes.BidMetadata)(nil),
+		&at.SealedBid{},
+		&at.BatchBid{},
+	)
+	encConfig.InterfaceRegistry.RegisterInterface(
+		"fatal_fruit.auction.v1.Strategy",
+		(*auctiontypes.Strategy)(nil),
+		&at.SettleStrategy{},
+		&at.UniformPriceStrategy{},
+		&at.ProcurementStrategy{},
 	)
 	storeKey := storetypes.NewKVStoreKey(auctiontypes.ModuleName)
 	testCtx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("t_test"))
 	storeService := runtime.NewKVStoreService(storeKey)
-	addrs := simtestutil.CreateIncrementalAccounts(3)
+	addrs := simtestutil.CreateIncrementalAccounts(4)
 	authority := authtypes.NewModuleAddress("gov")
 	auctionModAddr := authtypes.NewModuleAddress(auctiontypes.ModuleName)
 	auctionAcct := authtypes.NewEmptyModuleAccount(auctiontypes.ModuleName, authtypes.Minter)
@@ -61,9 +88,19 @@ func InitFixture(t *testing.T) *TestFixture {
 	mockBankKeeper := NewMockBankKeeper(ctrl)
 	mockEscrowService := NewMockEscrowService(ctrl)
 
+	strategyResolver := auctiontypes.NewStrategyResolver()
+	strategyResolver.AddType(sdk.MsgTypeURL(&at.SettleStrategy{}), at.NewSettleStrategyHandler(mockEscrowService, mockBankKeeper))
+	strategyResolver.AddType(sdk.MsgTypeURL(&at.UniformPriceStrategy{}), at.NewUniformPriceStrategyHandler(mockEscrowService, mockBankKeeper))
+	strategyResolver.AddType(sdk.MsgTypeURL(&at.ProcurementStrategy{}), at.NewProcurementStrategyHandler(mockBankKeeper))
+	strategyResolver.Seal()
+
 	resolver := auctiontypes.NewResolver()
-	handler := at.NewReserveAuctionHandler(mockEscrowService, mockBankKeeper)
+	handler := at.NewReserveAuctionHandler(mockEscrowService, mockBankKeeper, strategyResolver)
 	resolver.AddType(sdk.MsgTypeURL(&at.ReserveAuction{}), handler)
+	resolver.AddType(sdk.MsgTypeURL(&at.DutchAuction{}), at.NewDutchAuctionHandler(mockEscrowService, mockBankKeeper, strategyResolver))
+	resolver.AddType(sdk.MsgTypeURL(&at.SealedBidAuction{}), at.NewSealedBidAuctionHandler(mockEscrowService, mockBankKeeper, strategyResolver))
+	resolver.AddType(sdk.MsgTypeURL(&at.BatchAuction{}), at.NewBatchAuctionHandler(mockEscrowService, mockBankKeeper, strategyResolver))
+	resolver.AddType(sdk.MsgTypeURL(&at.ReverseAuction{}), at.NewReverseAuctionHandler(mockBankKeeper, strategyResolver))
 	resolver.Seal()
 
 	k := keeper.NewKeeper(
@@ -83,18 +120,23 @@ func InitFixture(t *testing.T) *TestFixture {
 	}
 
 	return &TestFixture{
-		Ctx:                testCtx.Ctx,
-		EnCfg:              encConfig,
-		K:                  k,
-		MsgServer:          keeper.NewMsgServerImpl(k),
-		QueryServer:        keeper.NewQueryServerImpl(k),
-		Addrs:              addrs,
-		ModAccount:         auctionAcct,
-		ModAddr:            auctionModAddr,
-		MockAcctKeeper:     mockAcctKeeper,
-		MockBankKeeper:     mockBankKeeper,
-		MockEscrowService:  mockEscrowService,
-		Resolver:           resolver,
-		ReserveAuctionType: sdk.MsgTypeURL(&at.ReserveAuction{}),
+		Ctx:                  testCtx.Ctx,
+		EnCfg:                encConfig,
+		K:                    k,
+		MsgServer:            keeper.NewMsgServerImpl(k),
+		QueryServer:          keeper.NewQueryServerImpl(k),
+		Addrs:                addrs,
+		ModAccount:           auctionAcct,
+		ModAddr:              auctionModAddr,
+		MockAcctKeeper:       mockAcctKeeper,
+		MockBankKeeper:       mockBankKeeper,
+		MockEscrowService:    mockEscrowService,
+		Resolver:             resolver,
+		StrategyResolver:     strategyResolver,
+		ReserveAuctionType:   sdk.MsgTypeURL(&at.ReserveAuction{}),
+		DutchAuctionType:     sdk.MsgTypeURL(&at.DutchAuction{}),
+		SealedBidAuctionType: sdk.MsgTypeURL(&at.SealedBidAuction{}),
+		BatchAuctionType:     sdk.MsgTypeURL(&at.BatchAuction{}),
+		ReverseAuctionType:   sdk.MsgTypeURL(&at.ReverseAuction{}),
 	}
 }
@@ -12,6 +12,8 @@ import (
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgNewAuction{}, "auction/MsgNewAuction")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelAuction{}, "auction/MsgCancelAuction")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "auction/MsgUpdateParams")
 
 }
 
@@ -20,9 +22,17 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgNewAuction{},
 		&MsgNewBid{},
+		&MsgRevealBid{},
 		&MsgStartAuction{},
 		&MsgExecAuction{},
+		&MsgCancelAuction{},
+		&MsgUpdateParams{},
 	)
 
+	registry.RegisterInterface("fatal_fruit.auction.v1.Auction", (*Auction)(nil))
+	registry.RegisterInterface("fatal_fruit.auction.v1.AuctionMetadata", (*AuctionMetadata)(nil))
+	registry.RegisterInterface("fatal_fruit.auction.v1.BidMetadata", (*BidMetadata)(nil))
+	registry.RegisterInterface("fatal_fruit.auction.v1.Strategy", (*Strategy)(nil))
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -5,6 +5,19 @@ import (
 )
 
 var (
-	ErrNameReserved = errors.Register(ModuleName, 1, "name already reserved")
-	ErrEmptyName    = errors.Register(ModuleName, 2, "name cannot be empty")
+	ErrNameReserved      = errors.Register(ModuleName, 1, "name already reserved")
+	ErrEmptyName         = errors.Register(ModuleName, 2, "name cannot be empty")
+	ErrAuctionNotFound   = errors.Register(ModuleName, 3, "auction not found")
+	ErrNotAuctionOwner   = errors.Register(ModuleName, 4, "sender is not the auction owner")
+	ErrAuctionNotActive  = errors.Register(ModuleName, 5, "auction is not active")
+	ErrAuctionHasBids    = errors.Register(ModuleName, 6, "auction has bids")
+	ErrInvalidSigner     = errors.Register(ModuleName, 7, "expected authority account as only signer")
+	ErrInvalidDuration   = errors.Register(ModuleName, 8, "invalid auction duration")
+	ErrDenomNotAllowed   = errors.Register(ModuleName, 9, "denom not allowed")
+	ErrMaxBidsReached    = errors.Register(ModuleName, 10, "maximum number of bids reached")
+	ErrNotRevealPhase    = errors.Register(ModuleName, 11, "auction is not in its reveal phase")
+	ErrBidNotFound       = errors.Register(ModuleName, 12, "bid not found")
+	ErrSealedBidExists   = errors.Register(ModuleName, 13, "bidder already placed a sealed bid")
+	ErrInvalidStatus     = errors.Register(ModuleName, 14, "invalid auction status")
+	ErrInvalidTransition = errors.Register(ModuleName, 15, "invalid auction status transition")
 )
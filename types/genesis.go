@@ -1,9 +1,135 @@
 package types
 
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = &GenesisState{}
+
 func NewGenesisState() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
 }
 
 func (gs *GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	ids := make(map[uint64]bool, len(gs.Auctions))
+	statuses := make(map[uint64]AuctionStatus, len(gs.Auctions))
+	var maxId uint64
+	for _, a := range gs.Auctions {
+		auction, ok := a.GetCachedValue().(Auction)
+		if !ok {
+			return fmt.Errorf("unable to unpack auction %s", a.GetTypeUrl())
+		}
+
+		id := auction.GetId()
+		if ids[id] {
+			return fmt.Errorf("duplicate auction id %d", id)
+		}
+		ids[id] = true
+		statuses[id] = auction.GetStatus()
+
+		if id > maxId {
+			maxId = id
+		}
+	}
+
+	if len(ids) > 0 && gs.AuctionSequence <= maxId {
+		return fmt.Errorf("auction sequence %d must be greater than the highest auction id %d", gs.AuctionSequence, maxId)
+	}
+
+	type bidKey struct{ auctionId, sequence uint64 }
+	bids := make(map[bidKey]bool, len(gs.Bids))
+	for _, b := range gs.Bids {
+		if !ids[b.Bid.AuctionId] {
+			return fmt.Errorf("bid %d references missing auction %d", b.Sequence, b.Bid.AuctionId)
+		}
+		if _, err := sdk.AccAddressFromBech32(b.Bid.Bidder); err != nil {
+			return fmt.Errorf("invalid bidder address %s: %w", b.Bid.Bidder, err)
+		}
+
+		key := bidKey{b.Bid.AuctionId, b.Sequence}
+		if bids[key] {
+			return fmt.Errorf("duplicate bid %d for auction %d", b.Sequence, b.Bid.AuctionId)
+		}
+		bids[key] = true
+	}
+
+	for _, oa := range gs.OwnerAuctions {
+		if _, err := sdk.AccAddressFromBech32(oa.Owner); err != nil {
+			return fmt.Errorf("invalid owner address %s: %w", oa.Owner, err)
+		}
+		for _, id := range oa.Ids {
+			if !ids[id] {
+				return fmt.Errorf("owner %s references missing auction %d", oa.Owner, id)
+			}
+		}
+	}
+
+	// Each queue only holds auctions in the status the keeper files under it, see statusQueues.
+	queues := []struct {
+		name   string
+		ids    []uint64
+		status AuctionStatus
+	}{
+		{"active", gs.ActiveAuctions, ACTIVE},
+		{"reveal", gs.RevealAuctions, ACTIVE},
+		{"expired", gs.ExpiredAuctions, EXPIRED},
+		{"pending", gs.PendingAuctions, PENDING},
+		{"cancelled", gs.CancelledAuctions, CANCELLED},
+	}
+	queued := make(map[uint64]string)
+	for _, q := range queues {
+		for _, id := range q.ids {
+			if !ids[id] {
+				return fmt.Errorf("%s auctions queue references missing auction %d", q.name, id)
+			}
+			if prev, ok := queued[id]; ok {
+				if prev == q.name {
+					return fmt.Errorf("auction %d appears twice in the %s auctions queue", id, q.name)
+				}
+				return fmt.Errorf("auction %d is in both the %s and %s auctions queues", id, prev, q.name)
+			}
+			queued[id] = q.name
+
+			if statuses[id] != q.status {
+				return fmt.Errorf("%s auction %d cannot be in the %s auctions queue", statuses[id], id, q.name)
+			}
+		}
+	}
+
+	pending := make(map[uint64]bool, len(gs.PendingAuctions))
+	for _, id := range gs.PendingAuctions {
+		pending[id] = true
+	}
+	for _, d := range gs.ExecutionDeadlines {
+		if !pending[d.AuctionId] {
+			return fmt.Errorf("execution deadline references auction %d that is not pending", d.AuctionId)
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs *GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, a := range gs.Auctions {
+		var auction Auction
+		if err := unpacker.UnpackAny(a, &auction); err != nil {
+			return err
+		}
+	}
+	for i := range gs.Bids {
+		if err := gs.Bids[i].Bid.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
 	return nil
 }
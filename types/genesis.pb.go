@@ -6,16 +6,22 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +31,28 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the auction module's genesis state.
 type GenesisState struct {
+	// auctions are all auctions in state, packed as their concrete auction type.
+	Auctions []*types.Any `protobuf:"bytes,1,rep,name=auctions,proto3" json:"auctions,omitempty"`
+	// auction_sequence is the next auction id to be assigned.
+	AuctionSequence uint64 `protobuf:"varint,2,opt,name=auction_sequence,json=auctionSequence,proto3" json:"auction_sequence,omitempty"`
+	// owner_auctions is the index of auction ids by owner address.
+	OwnerAuctions []OwnerAuctionsEntry `protobuf:"bytes,3,rep,name=owner_auctions,json=ownerAuctions,proto3" json:"owner_auctions"`
+	// active_auctions are the ids of auctions in the active queue.
+	ActiveAuctions []uint64 `protobuf:"varint,4,rep,packed,name=active_auctions,json=activeAuctions,proto3" json:"active_auctions,omitempty"`
+	// expired_auctions are the ids of auctions in the expired queue.
+	ExpiredAuctions []uint64 `protobuf:"varint,5,rep,packed,name=expired_auctions,json=expiredAuctions,proto3" json:"expired_auctions,omitempty"`
+	// pending_auctions are the ids of auctions in the pending queue.
+	PendingAuctions []uint64 `protobuf:"varint,6,rep,packed,name=pending_auctions,json=pendingAuctions,proto3" json:"pending_auctions,omitempty"`
+	// cancelled_auctions are the ids of auctions in the cancelled queue.
+	CancelledAuctions []uint64 `protobuf:"varint,7,rep,packed,name=cancelled_auctions,json=cancelledAuctions,proto3" json:"cancelled_auctions,omitempty"`
+	// params defines the module parameters.
+	Params Params `protobuf:"bytes,8,opt,name=params,proto3" json:"params"`
+	// bids are all bids in state together with their sequence within the auction.
+	Bids []BidEntry `protobuf:"bytes,9,rep,name=bids,proto3" json:"bids"`
+	// reveal_auctions are the ids of sealed-bid auctions in the reveal queue.
+	RevealAuctions []uint64 `protobuf:"varint,10,rep,packed,name=reveal_auctions,json=revealAuctions,proto3" json:"reveal_auctions,omitempty"`
+	// execution_deadlines are the times by which pending auctions must be executed.
+	ExecutionDeadlines []ExecutionDeadlineEntry `protobuf:"bytes,11,rep,name=execution_deadlines,json=executionDeadlines,proto3" json:"execution_deadlines"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -60,8 +88,247 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+func (m *GenesisState) GetAuctions() []*types.Any {
+	if m != nil {
+		return m.Auctions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuctionSequence() uint64 {
+	if m != nil {
+		return m.AuctionSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetOwnerAuctions() []OwnerAuctionsEntry {
+	if m != nil {
+		return m.OwnerAuctions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetActiveAuctions() []uint64 {
+	if m != nil {
+		return m.ActiveAuctions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExpiredAuctions() []uint64 {
+	if m != nil {
+		return m.ExpiredAuctions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingAuctions() []uint64 {
+	if m != nil {
+		return m.PendingAuctions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCancelledAuctions() []uint64 {
+	if m != nil {
+		return m.CancelledAuctions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetBids() []BidEntry {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRevealAuctions() []uint64 {
+	if m != nil {
+		return m.RevealAuctions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExecutionDeadlines() []ExecutionDeadlineEntry {
+	if m != nil {
+		return m.ExecutionDeadlines
+	}
+	return nil
+}
+
+// OwnerAuctionsEntry defines the auction ids owned by a single address.
+type OwnerAuctionsEntry struct {
+	Owner string   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Ids   []uint64 `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (m *OwnerAuctionsEntry) Reset()         { *m = OwnerAuctionsEntry{} }
+func (m *OwnerAuctionsEntry) String() string { return proto.CompactTextString(m) }
+func (*OwnerAuctionsEntry) ProtoMessage()    {}
+func (*OwnerAuctionsEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49362a460658c438, []int{1}
+}
+func (m *OwnerAuctionsEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwnerAuctionsEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OwnerAuctionsEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OwnerAuctionsEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnerAuctionsEntry.Merge(m, src)
+}
+func (m *OwnerAuctionsEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *OwnerAuctionsEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnerAuctionsEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwnerAuctionsEntry proto.InternalMessageInfo
+
+func (m *OwnerAuctionsEntry) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *OwnerAuctionsEntry) GetIds() []uint64 {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+// BidEntry defines a bid and its sequence within the auction it was placed on.
+type BidEntry struct {
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Bid      Bid    `protobuf:"bytes,2,opt,name=bid,proto3" json:"bid"`
+}
+
+func (m *BidEntry) Reset()         { *m = BidEntry{} }
+func (m *BidEntry) String() string { return proto.CompactTextString(m) }
+func (*BidEntry) ProtoMessage()    {}
+func (*BidEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49362a460658c438, []int{2}
+}
+func (m *BidEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BidEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BidEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BidEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BidEntry.Merge(m, src)
+}
+func (m *BidEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *BidEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BidEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BidEntry proto.InternalMessageInfo
+
+func (m *BidEntry) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *BidEntry) GetBid() Bid {
+	if m != nil {
+		return m.Bid
+	}
+	return Bid{}
+}
+
+// ExecutionDeadlineEntry defines the time by which a pending auction must be executed.
+type ExecutionDeadlineEntry struct {
+	AuctionId uint64    `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Deadline  time.Time `protobuf:"bytes,2,opt,name=deadline,proto3,stdtime" json:"deadline"`
+}
+
+func (m *ExecutionDeadlineEntry) Reset()         { *m = ExecutionDeadlineEntry{} }
+func (m *ExecutionDeadlineEntry) String() string { return proto.CompactTextString(m) }
+func (*ExecutionDeadlineEntry) ProtoMessage()    {}
+func (*ExecutionDeadlineEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49362a460658c438, []int{3}
+}
+func (m *ExecutionDeadlineEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionDeadlineEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionDeadlineEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionDeadlineEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionDeadlineEntry.Merge(m, src)
+}
+func (m *ExecutionDeadlineEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionDeadlineEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionDeadlineEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionDeadlineEntry proto.InternalMessageInfo
+
+func (m *ExecutionDeadlineEntry) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
+}
+
+func (m *ExecutionDeadlineEntry) GetDeadline() time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "fatal_fruit.auction.v1.GenesisState")
+	proto.RegisterType((*OwnerAuctionsEntry)(nil), "fatal_fruit.auction.v1.OwnerAuctionsEntry")
+	proto.RegisterType((*BidEntry)(nil), "fatal_fruit.auction.v1.BidEntry")
+	proto.RegisterType((*ExecutionDeadlineEntry)(nil), "fatal_fruit.auction.v1.ExecutionDeadlineEntry")
 }
 
 func init() {
@@ -69,18 +336,46 @@ func init() {
 }
 
 var fileDescriptor_49362a460658c438 = []byte{
-	// 164 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0x4b, 0x2c, 0x49,
-	0xcc, 0x89, 0x4f, 0x2b, 0x2a, 0xcd, 0x2c, 0xd1, 0x4f, 0x2c, 0x4d, 0x2e, 0xc9, 0xcc, 0xcf, 0xd3,
-	0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x43, 0x52, 0xa5, 0x07, 0x55, 0xa5, 0x57, 0x66, 0x28, 0x25, 0x99, 0x9c, 0x5f, 0x9c,
-	0x9b, 0x5f, 0x1c, 0x0f, 0x56, 0xa5, 0x0f, 0xe1, 0x40, 0xb4, 0x48, 0x29, 0xe1, 0x30, 0xb8, 0xa4,
-	0xb2, 0x20, 0x15, 0xaa, 0x46, 0x89, 0x8f, 0x8b, 0xc7, 0x1d, 0x62, 0x4f, 0x70, 0x49, 0x62, 0x49,
-	0xaa, 0x93, 0xdd, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xa9, 0xa4, 0x67,
-	0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x83, 0x0d, 0xd6, 0x45, 0x35, 0x18, 0x6c,
-	0x6a, 0x12, 0x1b, 0xd8, 0x58, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7b, 0xb8, 0x05, 0xaa,
-	0xd5, 0x00, 0x00, 0x00,
+	// 616 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xdf, 0x6e, 0xd3, 0x3e,
+	0x14, 0xc7, 0x9b, 0xb5, 0xdb, 0xaf, 0x73, 0x7f, 0x6c, 0xc3, 0x4c, 0x53, 0x56, 0x44, 0x56, 0x95,
+	0x49, 0x14, 0xa4, 0x26, 0xda, 0x76, 0x87, 0x10, 0xa2, 0x15, 0x13, 0xe2, 0x0a, 0xd4, 0x4d, 0x20,
+	0xc1, 0x45, 0xe5, 0x26, 0x67, 0xc1, 0x52, 0x6b, 0x87, 0xd8, 0x29, 0xeb, 0x5b, 0xec, 0x11, 0x78,
+	0x88, 0x3d, 0xc4, 0xc4, 0xd5, 0xc4, 0x15, 0x57, 0x80, 0xd6, 0x17, 0x41, 0xb1, 0x9d, 0x34, 0x6c,
+	0x0b, 0x77, 0xf6, 0xf1, 0xe7, 0xf8, 0xfb, 0x3d, 0xc7, 0x7f, 0xd0, 0xee, 0x09, 0x91, 0x64, 0x3c,
+	0x3c, 0x89, 0x13, 0x2a, 0x3d, 0x92, 0xf8, 0x92, 0x72, 0xe6, 0x4d, 0xf7, 0xbc, 0x10, 0x18, 0x08,
+	0x2a, 0xdc, 0x28, 0xe6, 0x92, 0xe3, 0xad, 0x02, 0xe5, 0x1a, 0xca, 0x9d, 0xee, 0x35, 0xb7, 0x7d,
+	0x2e, 0x26, 0x5c, 0x0c, 0x15, 0xe5, 0xe9, 0x89, 0x4e, 0x69, 0x6e, 0x86, 0x3c, 0xe4, 0x3a, 0x9e,
+	0x8e, 0x4c, 0x74, 0x3b, 0xe4, 0x3c, 0x1c, 0x83, 0xa7, 0x66, 0xa3, 0xe4, 0xc4, 0x23, 0x6c, 0x66,
+	0x96, 0x76, 0xae, 0x2f, 0x49, 0x3a, 0x01, 0x21, 0xc9, 0x24, 0x32, 0x40, 0xbb, 0xc4, 0xaa, 0x9c,
+	0x45, 0x90, 0xa9, 0x3e, 0x2c, 0x61, 0x22, 0x12, 0x93, 0x89, 0x81, 0xda, 0x5f, 0x97, 0xd1, 0xff,
+	0xaf, 0x74, 0x7d, 0x47, 0x92, 0x48, 0xc0, 0xc7, 0xa8, 0x6e, 0x58, 0x61, 0x5b, 0xad, 0x6a, 0xa7,
+	0xb1, 0xbf, 0xe9, 0x6a, 0x37, 0x6e, 0xe6, 0xc6, 0xed, 0xb1, 0x59, 0xbf, 0xfd, 0xed, 0xbc, 0xeb,
+	0xdc, 0xde, 0x0a, 0xb7, 0xa7, 0x87, 0x83, 0x7c, 0x27, 0xfc, 0x18, 0x6d, 0x98, 0xf1, 0x50, 0xc0,
+	0xe7, 0x04, 0x98, 0x0f, 0xf6, 0x52, 0xcb, 0xea, 0xd4, 0x06, 0xeb, 0x26, 0x7e, 0x64, 0xc2, 0xf8,
+	0x3d, 0x5a, 0xe3, 0x5f, 0x18, 0xc4, 0xc3, 0xdc, 0x46, 0x55, 0xd9, 0x78, 0xe2, 0x96, 0xa8, 0xbd,
+	0x49, 0x69, 0x23, 0x29, 0x0e, 0x99, 0x8c, 0x67, 0xfd, 0xda, 0xc5, 0xcf, 0x9d, 0xca, 0xe0, 0x0e,
+	0x2f, 0xae, 0xe0, 0x47, 0x68, 0x9d, 0xf8, 0x92, 0x4e, 0x61, 0xb1, 0x73, 0xad, 0x55, 0xed, 0xd4,
+	0x06, 0x6b, 0x3a, 0xdc, 0x2b, 0x98, 0x85, 0xd3, 0x88, 0xc6, 0x10, 0x2c, 0xc8, 0x65, 0x45, 0xae,
+	0x9b, 0x78, 0x11, 0x8d, 0x80, 0x05, 0x94, 0x85, 0x0b, 0x74, 0x45, 0xa3, 0x26, 0x9e, 0xa3, 0x5d,
+	0x84, 0x7d, 0xc2, 0x7c, 0x18, 0x8f, 0x8b, 0xfb, 0xfe, 0xa7, 0xe0, 0xbb, 0xf9, 0x4a, 0x8e, 0x3f,
+	0x43, 0x2b, 0xfa, 0xa0, 0xec, 0x7a, 0xcb, 0xea, 0x34, 0xf6, 0x9d, 0xb2, 0xf2, 0xdf, 0x2a, 0xca,
+	0x94, 0x6c, 0x72, 0xf0, 0x53, 0x54, 0x1b, 0xd1, 0x40, 0xd8, 0xab, 0xaa, 0x75, 0xad, 0xb2, 0xdc,
+	0x3e, 0x0d, 0x8a, 0x0d, 0x53, 0x39, 0x69, 0x9f, 0x62, 0x98, 0x02, 0x19, 0x2f, 0x5c, 0x22, 0xdd,
+	0x27, 0x1d, 0xce, 0x2d, 0x02, 0xba, 0x07, 0xa7, 0xe0, 0x27, 0xea, 0x58, 0x03, 0x20, 0xc1, 0x98,
+	0x32, 0x10, 0x76, 0x43, 0x69, 0xba, 0x65, 0x9a, 0x87, 0x59, 0xca, 0x4b, 0x93, 0x51, 0x74, 0x80,
+	0xe1, 0xfa, 0xaa, 0x68, 0xbf, 0x43, 0xf8, 0xe6, 0x11, 0x63, 0x17, 0x2d, 0xab, 0xe3, 0xb5, 0xad,
+	0x96, 0xd5, 0x59, 0xed, 0xdb, 0xdf, 0xcf, 0xbb, 0x9b, 0xe6, 0xd1, 0xf5, 0x82, 0x20, 0x06, 0x21,
+	0x8e, 0x64, 0x4c, 0x59, 0x38, 0xd0, 0x18, 0xde, 0x40, 0xd5, 0xb4, 0x21, 0x4b, 0xaa, 0x92, 0x74,
+	0xd8, 0xfe, 0x88, 0xea, 0x59, 0xfd, 0xb8, 0x89, 0xea, 0xf9, 0xbd, 0xb4, 0xd4, 0xbd, 0xcc, 0xe7,
+	0xf8, 0x00, 0x55, 0x47, 0x34, 0x50, 0xd7, 0xb5, 0xb1, 0x7f, 0xff, 0x1f, 0xad, 0x34, 0x35, 0xa4,
+	0x74, 0x7b, 0x86, 0xb6, 0x6e, 0x2f, 0x14, 0x3f, 0x40, 0x28, 0x7b, 0x0a, 0x34, 0x30, 0x62, 0xab,
+	0x26, 0xf2, 0x3a, 0xc0, 0x2f, 0x50, 0x3d, 0x6b, 0xa5, 0x91, 0x6c, 0xde, 0x78, 0x7f, 0xc7, 0xd9,
+	0x6f, 0xd0, 0xaf, 0xa7, 0x8a, 0x67, 0xbf, 0x76, 0xac, 0x41, 0x9e, 0xd5, 0x7f, 0x7e, 0x71, 0xe5,
+	0x58, 0x97, 0x57, 0x8e, 0xf5, 0xfb, 0xca, 0xb1, 0xce, 0xe6, 0x4e, 0xe5, 0x72, 0xee, 0x54, 0x7e,
+	0xcc, 0x9d, 0xca, 0x87, 0xdd, 0x90, 0xca, 0x4f, 0xc9, 0xc8, 0xf5, 0xf9, 0xc4, 0x53, 0x65, 0x74,
+	0xff, 0xfe, 0x1c, 0xd4, 0xef, 0x31, 0x5a, 0x51, 0x3a, 0x07, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff,
+	0x58, 0xf3, 0x3f, 0x98, 0x0f, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -103,6 +398,289 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExecutionDeadlines) > 0 {
+		for iNdEx := len(m.ExecutionDeadlines) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutionDeadlines[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.RevealAuctions) > 0 {
+		dAtA2 := make([]byte, len(m.RevealAuctions)*10)
+		var j1 int
+		for _, num := range m.RevealAuctions {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.CancelledAuctions) > 0 {
+		dAtA5 := make([]byte, len(m.CancelledAuctions)*10)
+		var j4 int
+		for _, num := range m.CancelledAuctions {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintGenesis(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PendingAuctions) > 0 {
+		dAtA7 := make([]byte, len(m.PendingAuctions)*10)
+		var j6 int
+		for _, num := range m.PendingAuctions {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintGenesis(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ExpiredAuctions) > 0 {
+		dAtA9 := make([]byte, len(m.ExpiredAuctions)*10)
+		var j8 int
+		for _, num := range m.ExpiredAuctions {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintGenesis(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ActiveAuctions) > 0 {
+		dAtA11 := make([]byte, len(m.ActiveAuctions)*10)
+		var j10 int
+		for _, num := range m.ActiveAuctions {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintGenesis(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OwnerAuctions) > 0 {
+		for iNdEx := len(m.OwnerAuctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OwnerAuctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.AuctionSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AuctionSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Auctions) > 0 {
+		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OwnerAuctionsEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OwnerAuctionsEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnerAuctionsEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		dAtA13 := make([]byte, len(m.Ids)*10)
+		var j12 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintGenesis(dAtA, i, uint64(j12))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BidEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BidEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BidEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Bid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionDeadlineEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionDeadlineEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionDeadlineEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintGenesis(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x12
+	if m.AuctionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -123,17 +701,129 @@ func (m *GenesisState) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AuctionSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.AuctionSequence))
+	}
+	if len(m.OwnerAuctions) > 0 {
+		for _, e := range m.OwnerAuctions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ActiveAuctions) > 0 {
+		l = 0
+		for _, e := range m.ActiveAuctions {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.ExpiredAuctions) > 0 {
+		l = 0
+		for _, e := range m.ExpiredAuctions {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.PendingAuctions) > 0 {
+		l = 0
+		for _, e := range m.PendingAuctions {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.CancelledAuctions) > 0 {
+		l = 0
+		for _, e := range m.CancelledAuctions {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RevealAuctions) > 0 {
+		l = 0
+		for _, e := range m.RevealAuctions {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.ExecutionDeadlines) > 0 {
+		for _, e := range m.ExecutionDeadlines {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+func (m *OwnerAuctionsEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *BidEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	l = m.Bid.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ExecutionDeadlineEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovGenesis(uint64(m.AuctionId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -161,6 +851,936 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctions = append(m.Auctions, &types.Any{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionSequence", wireType)
+			}
+			m.AuctionSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAuctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAuctions = append(m.OwnerAuctions, OwnerAuctionsEntry{})
+			if err := m.OwnerAuctions[len(m.OwnerAuctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ActiveAuctions = append(m.ActiveAuctions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ActiveAuctions) == 0 {
+					m.ActiveAuctions = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ActiveAuctions = append(m.ActiveAuctions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveAuctions", wireType)
+			}
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ExpiredAuctions = append(m.ExpiredAuctions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ExpiredAuctions) == 0 {
+					m.ExpiredAuctions = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ExpiredAuctions = append(m.ExpiredAuctions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredAuctions", wireType)
+			}
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PendingAuctions = append(m.PendingAuctions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PendingAuctions) == 0 {
+					m.PendingAuctions = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PendingAuctions = append(m.PendingAuctions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAuctions", wireType)
+			}
+		case 7:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CancelledAuctions = append(m.CancelledAuctions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CancelledAuctions) == 0 {
+					m.CancelledAuctions = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CancelledAuctions = append(m.CancelledAuctions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledAuctions", wireType)
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, BidEntry{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RevealAuctions = append(m.RevealAuctions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RevealAuctions) == 0 {
+					m.RevealAuctions = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RevealAuctions = append(m.RevealAuctions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealAuctions", wireType)
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDeadlines", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionDeadlines = append(m.ExecutionDeadlines, ExecutionDeadlineEntry{})
+			if err := m.ExecutionDeadlines[len(m.ExecutionDeadlines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OwnerAuctionsEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnerAuctionsEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnerAuctionsEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BidEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BidEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BidEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutionDeadlineEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionDeadlineEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionDeadlineEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,269 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	at "github.com/fatal-fruit/auction/auctiontypes"
+	auctiontypes "github.com/fatal-fruit/auction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	addrs := simtestutil.CreateIncrementalAccounts(2)
+
+	newAuction := func(id uint64, status auctiontypes.AuctionStatus) *codectypes.Any {
+		aa, err := codectypes.NewAnyWithValue(&at.ReserveAuction{
+			Id:       id,
+			Status:   status,
+			Owner:    addrs[0].String(),
+			Metadata: &at.ReserveAuctionMetadata{},
+		})
+		require.NoError(t, err)
+		return aa
+	}
+	newAuctions := func(ids ...uint64) []*codectypes.Any {
+		var anys []*codectypes.Any
+		for _, id := range ids {
+			anys = append(anys, newAuction(id, auctiontypes.CREATED))
+		}
+		return anys
+	}
+	newBid := func(id, seq uint64, bidder string) auctiontypes.BidEntry {
+		return auctiontypes.BidEntry{
+			Sequence: seq,
+			Bid:      auctiontypes.Bid{AuctionId: id, Bidder: bidder},
+		}
+	}
+
+	testCases := []struct {
+		name   string
+		gs     *auctiontypes.GenesisState
+		expErr bool
+	}{
+		{
+			name: "default genesis",
+			gs:   auctiontypes.NewGenesisState(),
+		},
+		{
+			name: "valid genesis",
+			gs: &auctiontypes.GenesisState{
+				Params: auctiontypes.DefaultParams(),
+				Auctions: []*codectypes.Any{
+					newAuction(0, auctiontypes.ACTIVE),
+					newAuction(1, auctiontypes.EXPIRED),
+					newAuction(2, auctiontypes.CANCELLED),
+				},
+				AuctionSequence: 3,
+				OwnerAuctions: []auctiontypes.OwnerAuctionsEntry{
+					{Owner: addrs[0].String(), Ids: []uint64{0, 1, 2}},
+				},
+				Bids: []auctiontypes.BidEntry{
+					newBid(1, 0, addrs[1].String()),
+					newBid(1, 1, addrs[0].String()),
+				},
+				ActiveAuctions:    []uint64{0},
+				ExpiredAuctions:   []uint64{1},
+				CancelledAuctions: []uint64{2},
+			},
+		},
+		{
+			name: "invalid params",
+			gs: &auctiontypes.GenesisState{
+				Params: auctiontypes.NewParams(time.Minute, time.Hour, time.Hour, 0, nil, nil, 0, 0),
+			},
+			expErr: true,
+		},
+		{
+			name: "duplicate auction ids",
+			gs: &auctiontypes.GenesisState{
+				Params:          auctiontypes.DefaultParams(),
+				Auctions:        newAuctions(0, 0),
+				AuctionSequence: 1,
+			},
+			expErr: true,
+		},
+		{
+			name: "sequence lower than highest auction id",
+			gs: &auctiontypes.GenesisState{
+				Params:          auctiontypes.DefaultParams(),
+				Auctions:        newAuctions(0, 5),
+				AuctionSequence: 3,
+			},
+			expErr: true,
+		},
+		{
+			name: "sequence equal to highest auction id",
+			gs: &auctiontypes.GenesisState{
+				Params:          auctiontypes.DefaultParams(),
+				Auctions:        newAuctions(0, 1),
+				AuctionSequence: 1,
+			},
+			expErr: true,
+		},
+		{
+			name: "queue references missing auction",
+			gs: &auctiontypes.GenesisState{
+				Params:          auctiontypes.DefaultParams(),
+				Auctions:        newAuctions(0),
+				AuctionSequence: 1,
+				PendingAuctions: []uint64{4},
+			},
+			expErr: true,
+		},
+		{
+			name: "reveal queue references missing auction",
+			gs: &auctiontypes.GenesisState{
+				Params:          auctiontypes.DefaultParams(),
+				Auctions:        newAuctions(0),
+				AuctionSequence: 1,
+				RevealAuctions:  []uint64{2},
+			},
+			expErr: true,
+		},
+		{
+			name: "active auction in pending queue",
+			gs: &auctiontypes.GenesisState{
+				Params:          auctiontypes.DefaultParams(),
+				Auctions:        []*codectypes.Any{newAuction(0, auctiontypes.ACTIVE)},
+				AuctionSequence: 1,
+				PendingAuctions: []uint64{0},
+			},
+			expErr: true,
+		},
+		{
+			name: "settled auction in cancelled queue",
+			gs: &auctiontypes.GenesisState{
+				Params:            auctiontypes.DefaultParams(),
+				Auctions:          []*codectypes.Any{newAuction(0, auctiontypes.SETTLED)},
+				AuctionSequence:   1,
+				CancelledAuctions: []uint64{0},
+			},
+			expErr: true,
+		},
+		{
+			name: "created auction in active queue",
+			gs: &auctiontypes.GenesisState{
+				Params:          auctiontypes.DefaultParams(),
+				Auctions:        newAuctions(0),
+				AuctionSequence: 1,
+				ActiveAuctions:  []uint64{0},
+			},
+			expErr: true,
+		},
+		{
+			name: "active auction in reveal queue",
+			gs: &auctiontypes.GenesisState{
+				Params:          auctiontypes.DefaultParams(),
+				Auctions:        []*codectypes.Any{newAuction(0, auctiontypes.ACTIVE)},
+				AuctionSequence: 1,
+				RevealAuctions:  []uint64{0},
+			},
+		},
+		{
+			name: "auction twice in one queue",
+			gs: &auctiontypes.GenesisState{
+				Params:          auctiontypes.DefaultParams(),
+				Auctions:        []*codectypes.Any{newAuction(0, auctiontypes.EXPIRED)},
+				AuctionSequence: 1,
+				ExpiredAuctions: []uint64{0, 0},
+			},
+			expErr: true,
+		},
+		{
+			name: "auction in two queues",
+			gs: &auctiontypes.GenesisState{
+				Params:          auctiontypes.DefaultParams(),
+				Auctions:        []*codectypes.Any{newAuction(0, auctiontypes.ACTIVE)},
+				AuctionSequence: 1,
+				ActiveAuctions:  []uint64{0},
+				RevealAuctions:  []uint64{0},
+			},
+			expErr: true,
+		},
+		{
+			name: "execution deadline for auction that is not pending",
+			gs: &auctiontypes.GenesisState{
+				Params:          auctiontypes.DefaultParams(),
+				Auctions:        []*codectypes.Any{newAuction(0, auctiontypes.ACTIVE)},
+				AuctionSequence: 1,
+				ActiveAuctions:  []uint64{0},
+				ExecutionDeadlines: []auctiontypes.ExecutionDeadlineEntry{
+					{AuctionId: 0, Deadline: time.Now()},
+				},
+			},
+			expErr: true,
+		},
+		{
+			name: "owner index references missing auction",
+			gs: &auctiontypes.GenesisState{
+				Params:          auctiontypes.DefaultParams(),
+				Auctions:        newAuctions(0),
+				AuctionSequence: 1,
+				OwnerAuctions: []auctiontypes.OwnerAuctionsEntry{
+					{Owner: addrs[1].String(), Ids: []uint64{7}},
+				},
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid owner address",
+			gs: &auctiontypes.GenesisState{
+				Params:          auctiontypes.DefaultParams(),
+				Auctions:        newAuctions(0),
+				AuctionSequence: 1,
+				OwnerAuctions: []auctiontypes.OwnerAuctionsEntry{
+					{Owner: "invalid", Ids: []uint64{0}},
+				},
+			},
+			expErr: true,
+		},
+		{
+			name: "bid references missing auction",
+			gs: &auctiontypes.GenesisState{
+				Params:          auctiontypes.DefaultParams(),
+				Auctions:        newAuctions(0),
+				AuctionSequence: 1,
+				Bids:            []auctiontypes.BidEntry{newBid(3, 0, addrs[1].String())},
+			},
+			expErr: true,
+		},
+		{
+			name: "duplicate bid sequence",
+			gs: &auctiontypes.GenesisState{
+				Params:          auctiontypes.DefaultParams(),
+				Auctions:        newAuctions(0),
+				AuctionSequence: 1,
+				Bids: []auctiontypes.BidEntry{
+					newBid(0, 0, addrs[1].String()),
+					newBid(0, 0, addrs[0].String()),
+				},
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid bidder address",
+			gs: &auctiontypes.GenesisState{
+				Params:          auctiontypes.DefaultParams(),
+				Auctions:        newAuctions(0),
+				AuctionSequence: 1,
+				Bids:            []auctiontypes.BidEntry{newBid(0, 0, "invalid")},
+			},
+			expErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.gs.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
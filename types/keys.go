@@ -8,10 +8,22 @@ const (
 
 	// Strategy Types
 	SETTLE = "SETTLE"
+	// SECOND_PRICE charges the winner the highest competing bid, or the reserve price
+	// if there is none
+	SECOND_PRICE = "SECOND_PRICE"
 
 	// Auction Status
-	ACTIVE = "ACTIVE"
-	CLOSED = "CLOSED"
+	CREATED   = AUCTION_STATUS_CREATED
+	ACTIVE    = AUCTION_STATUS_ACTIVE
+	EXPIRED   = AUCTION_STATUS_EXPIRED
+	PENDING   = AUCTION_STATUS_PENDING
+	CANCELLED = AUCTION_STATUS_CANCELLED
+	SETTLED   = AUCTION_STATUS_SETTLED
+
+	// Cancellation Reasons
+	CancelReasonOwner            = "cancelled by owner"
+	CancelReasonNoBids           = "expired without bids"
+	CancelReasonExecutionTimeout = "execution window elapsed"
 )
 
 var (
@@ -23,4 +35,11 @@ var (
 	CancelledAuctionsKey  = collections.NewPrefix(5)
 	PendingAuctionsKey    = collections.NewPrefix(6)
 	ContractAddressPrefix = collections.NewPrefix(7)
+	ParamsKey             = collections.NewPrefix(8)
+	BidderAuctionsKey     = collections.NewPrefix(9)
+	BidsKey               = collections.NewPrefix(10)
+	RevealAuctionsKey     = collections.NewPrefix(11)
+	ExecutionDeadlinesKey = collections.NewPrefix(12)
+	EndTimeIndexKey       = collections.NewPrefix(13)
+	BidderBidsKey         = collections.NewPrefix(14)
 )
@@ -1 +1,136 @@
 package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultMaxAuctionDuration          = 30 * 24 * time.Hour
+	DefaultMinAuctionDuration          = 10 * time.Second
+	DefaultExecutionDuration           = 14 * 24 * time.Hour
+	DefaultMaxBidsPerAuction           = uint64(0)
+	DefaultMaxTransitionsPerBlock      = uint64(0)
+	DefaultReservedTransitionsPerBlock = uint64(0)
+)
+
+// NewParams creates a new Params instance.
+func NewParams(maxDuration, minDuration, execDuration time.Duration, maxBids uint64, depositDenoms, bidDenoms []string, maxTransitions, reservedTransitions uint64) Params {
+	return Params{
+		MaxAuctionDuration:          maxDuration,
+		MinAuctionDuration:          minDuration,
+		ExecutionDuration:           execDuration,
+		MaxBidsPerAuction:           maxBids,
+		AllowedDepositDenoms:        depositDenoms,
+		AllowedBidDenoms:            bidDenoms,
+		MaxTransitionsPerBlock:      maxTransitions,
+		ReservedTransitionsPerBlock: reservedTransitions,
+	}
+}
+
+// DefaultParams returns the default auction module parameters.
+func DefaultParams() Params {
+	return NewParams(
+		DefaultMaxAuctionDuration,
+		DefaultMinAuctionDuration,
+		DefaultExecutionDuration,
+		DefaultMaxBidsPerAuction,
+		nil,
+		nil,
+		DefaultMaxTransitionsPerBlock,
+		DefaultReservedTransitionsPerBlock,
+	)
+}
+
+// Validate performs basic validation of the auction module parameters.
+func (p Params) Validate() error {
+	if p.MinAuctionDuration <= 0 {
+		return fmt.Errorf("min auction duration must be positive: %s", p.MinAuctionDuration)
+	}
+
+	if p.MaxAuctionDuration < p.MinAuctionDuration {
+		return fmt.Errorf("max auction duration %s must not be less than min auction duration %s", p.MaxAuctionDuration, p.MinAuctionDuration)
+	}
+
+	if p.ExecutionDuration <= 0 {
+		return fmt.Errorf("execution duration must be positive: %s", p.ExecutionDuration)
+	}
+
+	if err := validateDenoms(p.AllowedDepositDenoms); err != nil {
+		return fmt.Errorf("invalid allowed deposit denoms: %w", err)
+	}
+
+	if err := validateDenoms(p.AllowedBidDenoms); err != nil {
+		return fmt.Errorf("invalid allowed bid denoms: %w", err)
+	}
+
+	if err := validateReservedTransitions(p.MaxTransitionsPerBlock, p.ReservedTransitionsPerBlock); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateDuration checks the auction duration is within the allowed range.
+func (p Params) ValidateDuration(duration time.Duration) error {
+	if duration < p.MinAuctionDuration || duration > p.MaxAuctionDuration {
+		return ErrInvalidDuration.Wrapf("duration %s must be between %s and %s", duration, p.MinAuctionDuration, p.MaxAuctionDuration)
+	}
+	return nil
+}
+
+// IsAllowedDepositDenom returns true if the denom may be used for auction deposits.
+func (p Params) IsAllowedDepositDenom(denom string) bool {
+	return isAllowedDenom(p.AllowedDepositDenoms, denom)
+}
+
+// IsAllowedBidDenom returns true if the denom may be used for bids.
+func (p Params) IsAllowedBidDenom(denom string) bool {
+	return isAllowedDenom(p.AllowedBidDenoms, denom)
+}
+
+func isAllowedDenom(allowed []string, denom string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, d := range allowed {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+func validateDenoms(denoms []string) error {
+	seen := make(map[string]bool, len(denoms))
+	for _, d := range denoms {
+		if err := sdk.ValidateDenom(d); err != nil {
+			return err
+		}
+		if seen[d] {
+			return fmt.Errorf("duplicate denom %s", d)
+		}
+		seen[d] = true
+	}
+	return nil
+}
+
+// validateReservedTransitions checks a transition limit leaves room for both the reserved
+// share and the auctions ending. A limit of 0 is unlimited and reserves nothing.
+func validateReservedTransitions(limit, reserved uint64) error {
+	if limit == 0 {
+		if reserved != 0 {
+			return fmt.Errorf("reserved transitions per block %d require a max transitions per block", reserved)
+		}
+		return nil
+	}
+	if reserved == 0 {
+		return fmt.Errorf("reserved transitions per block must be positive when max transitions per block is set")
+	}
+	if reserved >= limit {
+		return fmt.Errorf("reserved transitions per block %d must be less than max transitions per block %d", reserved, limit)
+	}
+	return nil
+}
@@ -0,0 +1,674 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: fatal_fruit/auction/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters of the auction module.
+type Params struct {
+	// max_auction_duration is the longest duration an auction can run for.
+	MaxAuctionDuration time.Duration `protobuf:"bytes,1,opt,name=max_auction_duration,json=maxAuctionDuration,proto3,stdduration" json:"max_auction_duration"`
+	// min_auction_duration is the shortest duration an auction can run for.
+	MinAuctionDuration time.Duration `protobuf:"bytes,2,opt,name=min_auction_duration,json=minAuctionDuration,proto3,stdduration" json:"min_auction_duration"`
+	// execution_duration is the window after an auction ends in which a pending auction can be
+	// executed before it is cancelled.
+	ExecutionDuration time.Duration `protobuf:"bytes,3,opt,name=execution_duration,json=executionDuration,proto3,stdduration" json:"execution_duration"`
+	// max_bids_per_auction is the maximum number of bids accepted by a single auction.
+	// A value of 0 disables the limit.
+	MaxBidsPerAuction uint64 `protobuf:"varint,4,opt,name=max_bids_per_auction,json=maxBidsPerAuction,proto3" json:"max_bids_per_auction,omitempty"`
+	// allowed_deposit_denoms are the denoms accepted as auction deposits.
+	// An empty list allows any denom.
+	AllowedDepositDenoms []string `protobuf:"bytes,5,rep,name=allowed_deposit_denoms,json=allowedDepositDenoms,proto3" json:"allowed_deposit_denoms,omitempty"`
+	// allowed_bid_denoms are the denoms accepted for bids.
+	// An empty list allows any denom.
+	AllowedBidDenoms []string `protobuf:"bytes,6,rep,name=allowed_bid_denoms,json=allowedBidDenoms,proto3" json:"allowed_bid_denoms,omitempty"`
+	// max_transitions_per_block is the maximum number of auctions the EndBlocker moves between
+	// queues in a single block. Auctions over the limit are processed in later blocks.
+	// A value of 0 disables the limit.
+	MaxTransitionsPerBlock uint64 `protobuf:"varint,7,opt,name=max_transitions_per_block,json=maxTransitionsPerBlock,proto3" json:"max_transitions_per_block,omitempty"`
+	// reserved_transitions_per_block is the part of max_transitions_per_block that only the
+	// pending and cancelled queues may spend, so execution timeouts and refunds are not held
+	// back by auctions ending. It must be at least 1 and less than max_transitions_per_block
+	// when the limit is set, and 0 otherwise.
+	ReservedTransitionsPerBlock uint64 `protobuf:"varint,8,opt,name=reserved_transitions_per_block,json=reservedTransitionsPerBlock,proto3" json:"reserved_transitions_per_block,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_496df7066200440b, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxAuctionDuration() time.Duration {
+	if m != nil {
+		return m.MaxAuctionDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMinAuctionDuration() time.Duration {
+	if m != nil {
+		return m.MinAuctionDuration
+	}
+	return 0
+}
+
+func (m *Params) GetExecutionDuration() time.Duration {
+	if m != nil {
+		return m.ExecutionDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBidsPerAuction() uint64 {
+	if m != nil {
+		return m.MaxBidsPerAuction
+	}
+	return 0
+}
+
+func (m *Params) GetAllowedDepositDenoms() []string {
+	if m != nil {
+		return m.AllowedDepositDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetAllowedBidDenoms() []string {
+	if m != nil {
+		return m.AllowedBidDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetMaxTransitionsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxTransitionsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetReservedTransitionsPerBlock() uint64 {
+	if m != nil {
+		return m.ReservedTransitionsPerBlock
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "fatal_fruit.auction.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("fatal_fruit/auction/v1/params.proto", fileDescriptor_496df7066200440b)
+}
+
+var fileDescriptor_496df7066200440b = []byte{
+	// 420 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x8a, 0xd4, 0x30,
+	0x1c, 0xc6, 0x1b, 0x77, 0x1c, 0x35, 0x22, 0x38, 0x61, 0x18, 0xba, 0x2b, 0x64, 0x07, 0xf5, 0x30,
+	0x88, 0x36, 0xac, 0x7a, 0xf1, 0x22, 0x58, 0xe7, 0x01, 0x86, 0x41, 0x10, 0xf6, 0x52, 0xd2, 0x26,
+	0x53, 0x83, 0x4d, 0x53, 0x92, 0x74, 0xac, 0x6f, 0xe1, 0xd1, 0x47, 0xf0, 0x24, 0x3e, 0xc6, 0x1e,
+	0xf7, 0xe8, 0x49, 0x65, 0xe6, 0xe0, 0x6b, 0x48, 0xd3, 0x74, 0x75, 0xd4, 0x3d, 0xcc, 0xa5, 0xb4,
+	0xf9, 0xbe, 0xef, 0xf7, 0xff, 0x68, 0xfe, 0xf0, 0xde, 0x8a, 0x5a, 0x5a, 0x24, 0x2b, 0x5d, 0x0b,
+	0x4b, 0x68, 0x9d, 0x59, 0xa1, 0x4a, 0xb2, 0x3e, 0x21, 0x15, 0xd5, 0x54, 0x9a, 0xa8, 0xd2, 0xca,
+	0x2a, 0x34, 0xf9, 0xc3, 0x14, 0x79, 0x53, 0xb4, 0x3e, 0x39, 0x1a, 0xe7, 0x2a, 0x57, 0xce, 0x42,
+	0xda, 0xb7, 0xce, 0x7d, 0x34, 0xa2, 0x52, 0x94, 0x8a, 0xb8, 0xa7, 0x3f, 0xc2, 0xb9, 0x52, 0x79,
+	0xc1, 0x89, 0xfb, 0x4a, 0xeb, 0x15, 0x61, 0xb5, 0xa6, 0x8e, 0xe2, 0x4e, 0xee, 0x7e, 0x1e, 0xc0,
+	0xe1, 0xc2, 0x4d, 0x44, 0xa7, 0x70, 0x2c, 0x69, 0x93, 0xf8, 0x29, 0x49, 0x6f, 0x0c, 0xc1, 0x14,
+	0xcc, 0x6e, 0x3e, 0x3e, 0x8c, 0x3a, 0x52, 0xd4, 0x93, 0xa2, 0xb9, 0x37, 0xc4, 0xb7, 0xce, 0xbe,
+	0x1d, 0x07, 0x1f, 0xbf, 0x1f, 0x83, 0x4f, 0x3f, 0xbf, 0x3c, 0x00, 0x4b, 0x24, 0x69, 0xf3, 0xa2,
+	0x83, 0xf4, 0x16, 0xc7, 0x16, 0xe5, 0xbf, 0xec, 0x2b, 0x7b, 0xb3, 0x45, 0xf9, 0x37, 0xfb, 0x35,
+	0x44, 0xbc, 0xe1, 0x59, 0xbd, 0x4b, 0x3e, 0xd8, 0x93, 0x3c, 0xba, 0x60, 0x5c, 0x80, 0x49, 0xf7,
+	0x43, 0x52, 0xc1, 0x4c, 0x52, 0x71, 0xdd, 0xb7, 0x0f, 0x07, 0x53, 0x30, 0x1b, 0x2c, 0x47, 0x92,
+	0x36, 0xb1, 0x60, 0x66, 0xc1, 0xb5, 0x6f, 0x84, 0x9e, 0xc2, 0x09, 0x2d, 0x0a, 0xf5, 0x8e, 0xb3,
+	0x84, 0xf1, 0x4a, 0x19, 0x61, 0x13, 0xc6, 0x4b, 0x25, 0x4d, 0x78, 0x75, 0x7a, 0x30, 0xbb, 0xb1,
+	0x1c, 0x7b, 0x75, 0xde, 0x89, 0x73, 0xa7, 0xa1, 0x87, 0x10, 0xf5, 0xa9, 0x54, 0xb0, 0x3e, 0x31,
+	0x74, 0x89, 0xdb, 0x5e, 0x89, 0x05, 0xf3, 0xee, 0x67, 0xf0, 0xb0, 0x2d, 0x65, 0x35, 0x2d, 0x8d,
+	0x68, 0xa7, 0x76, 0xdd, 0xd2, 0x42, 0x65, 0x6f, 0xc3, 0x6b, 0xae, 0xd9, 0x44, 0xd2, 0xe6, 0xd5,
+	0x6f, 0x7d, 0xc1, 0x75, 0xdc, 0xaa, 0xe8, 0x25, 0xc4, 0x9a, 0x1b, 0xae, 0xd7, 0x9c, 0x5d, 0x92,
+	0xbf, 0xee, 0xf2, 0x77, 0x7a, 0xd7, 0x7f, 0x20, 0xf1, 0xf3, 0xb3, 0x0d, 0x06, 0xe7, 0x1b, 0x0c,
+	0x7e, 0x6c, 0x30, 0xf8, 0xb0, 0xc5, 0xc1, 0xf9, 0x16, 0x07, 0x5f, 0xb7, 0x38, 0x38, 0xbd, 0x9f,
+	0x0b, 0xfb, 0xa6, 0x4e, 0xa3, 0x4c, 0x49, 0xe2, 0xd6, 0xf6, 0xd1, 0xee, 0x6e, 0xdb, 0xf7, 0x15,
+	0x37, 0xe9, 0xd0, 0xdd, 0xc4, 0x93, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x89, 0x43, 0xe8, 0xf0,
+	0xff, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReservedTransitionsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReservedTransitionsPerBlock))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxTransitionsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTransitionsPerBlock))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.AllowedBidDenoms) > 0 {
+		for iNdEx := len(m.AllowedBidDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedBidDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedBidDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedBidDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AllowedDepositDenoms) > 0 {
+		for iNdEx := len(m.AllowedDepositDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDepositDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDepositDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedDepositDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MaxBidsPerAuction != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBidsPerAuction))
+		i--
+		dAtA[i] = 0x20
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ExecutionDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExecutionDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinAuctionDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinAuctionDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxAuctionDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAuctionDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAuctionDuration)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinAuctionDuration)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExecutionDuration)
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxBidsPerAuction != 0 {
+		n += 1 + sovParams(uint64(m.MaxBidsPerAuction))
+	}
+	if len(m.AllowedDepositDenoms) > 0 {
+		for _, s := range m.AllowedDepositDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.AllowedBidDenoms) > 0 {
+		for _, s := range m.AllowedBidDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxTransitionsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxTransitionsPerBlock))
+	}
+	if m.ReservedTransitionsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.ReservedTransitionsPerBlock))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAuctionDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxAuctionDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAuctionDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinAuctionDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ExecutionDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBidsPerAuction", wireType)
+			}
+			m.MaxBidsPerAuction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBidsPerAuction |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDepositDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDepositDenoms = append(m.AllowedDepositDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedBidDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedBidDenoms = append(m.AllowedBidDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTransitionsPerBlock", wireType)
+			}
+			m.MaxTransitionsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTransitionsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedTransitionsPerBlock", wireType)
+			}
+			m.ReservedTransitionsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReservedTransitionsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
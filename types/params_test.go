@@ -0,0 +1,43 @@
+package types_test
+
+import (
+	"testing"
+
+	auctiontypes "github.com/fatal-fruit/auction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidateTransitions(t *testing.T) {
+	testCases := []struct {
+		name     string
+		limit    uint64
+		reserved uint64
+		expErr   bool
+	}{
+		{name: "unlimited", limit: 0, reserved: 0},
+		{name: "reserve without limit", limit: 0, reserved: 1, expErr: true},
+		{name: "one reserved", limit: 2, reserved: 1},
+		{name: "half reserved", limit: 10, reserved: 5},
+		{name: "nothing reserved", limit: 10, reserved: 0, expErr: true},
+		{name: "whole limit reserved", limit: 10, reserved: 10, expErr: true},
+		// A single transition cannot be shared by ending auctions and the reserve
+		{name: "limit of one", limit: 1, reserved: 0, expErr: true},
+		{name: "limit of one reserved", limit: 1, reserved: 1, expErr: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			params := auctiontypes.DefaultParams()
+			params.MaxTransitionsPerBlock = tc.limit
+			params.ReservedTransitionsPerBlock = tc.reserved
+
+			err := params.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
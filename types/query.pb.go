@@ -8,7 +8,10 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -211,21 +214,24 @@ func (m *QueryOwnerAuctionsResponse) GetAuctions() []*types.Any {
 	return nil
 }
 
-type QueryAllAuctionsRequest struct {
+// QueryAuctionBidsRequest is the request type for the Query/AuctionBids RPC method.
+type QueryAuctionBidsRequest struct {
+	AuctionId  uint64             `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllAuctionsRequest) Reset()         { *m = QueryAllAuctionsRequest{} }
-func (m *QueryAllAuctionsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllAuctionsRequest) ProtoMessage()    {}
-func (*QueryAllAuctionsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryAuctionBidsRequest) Reset()         { *m = QueryAuctionBidsRequest{} }
+func (m *QueryAuctionBidsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionBidsRequest) ProtoMessage()    {}
+func (*QueryAuctionBidsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b8d1b80edb3d51e, []int{4}
 }
-func (m *QueryAllAuctionsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAuctionBidsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllAuctionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAuctionBidsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllAuctionsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAuctionBidsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -235,34 +241,50 @@ func (m *QueryAllAuctionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryAllAuctionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllAuctionsRequest.Merge(m, src)
+func (m *QueryAuctionBidsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionBidsRequest.Merge(m, src)
 }
-func (m *QueryAllAuctionsRequest) XXX_Size() int {
+func (m *QueryAuctionBidsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllAuctionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllAuctionsRequest.DiscardUnknown(m)
+func (m *QueryAuctionBidsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionBidsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllAuctionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAuctionBidsRequest proto.InternalMessageInfo
 
-type QueryAllAuctionsResponse struct {
-	Auctions []*types.Any `protobuf:"bytes,1,rep,name=auctions,proto3" json:"auctions,omitempty"`
+func (m *QueryAuctionBidsRequest) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
 }
 
-func (m *QueryAllAuctionsResponse) Reset()         { *m = QueryAllAuctionsResponse{} }
-func (m *QueryAllAuctionsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllAuctionsResponse) ProtoMessage()    {}
-func (*QueryAllAuctionsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryAuctionBidsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAuctionBidsResponse is the response type for the Query/AuctionBids RPC method.
+type QueryAuctionBidsResponse struct {
+	Bids       []Bid               `protobuf:"bytes,1,rep,name=bids,proto3" json:"bids"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAuctionBidsResponse) Reset()         { *m = QueryAuctionBidsResponse{} }
+func (m *QueryAuctionBidsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionBidsResponse) ProtoMessage()    {}
+func (*QueryAuctionBidsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b8d1b80edb3d51e, []int{5}
 }
-func (m *QueryAllAuctionsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAuctionBidsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllAuctionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAuctionBidsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllAuctionsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAuctionBidsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -272,517 +294,4968 @@ func (m *QueryAllAuctionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryAllAuctionsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllAuctionsResponse.Merge(m, src)
+func (m *QueryAuctionBidsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionBidsResponse.Merge(m, src)
 }
-func (m *QueryAllAuctionsResponse) XXX_Size() int {
+func (m *QueryAuctionBidsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllAuctionsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllAuctionsResponse.DiscardUnknown(m)
+func (m *QueryAuctionBidsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionBidsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllAuctionsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAuctionBidsResponse proto.InternalMessageInfo
 
-func (m *QueryAllAuctionsResponse) GetAuctions() []*types.Any {
+func (m *QueryAuctionBidsResponse) GetBids() []Bid {
 	if m != nil {
-		return m.Auctions
+		return m.Bids
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryAuctionRequest)(nil), "fatal_fruit.auction.v1.QueryAuctionRequest")
-	proto.RegisterType((*QueryAuctionResponse)(nil), "fatal_fruit.auction.v1.QueryAuctionResponse")
-	proto.RegisterType((*QueryOwnerAuctionsRequest)(nil), "fatal_fruit.auction.v1.QueryOwnerAuctionsRequest")
-	proto.RegisterType((*QueryOwnerAuctionsResponse)(nil), "fatal_fruit.auction.v1.QueryOwnerAuctionsResponse")
-	proto.RegisterType((*QueryAllAuctionsRequest)(nil), "fatal_fruit.auction.v1.QueryAllAuctionsRequest")
-	proto.RegisterType((*QueryAllAuctionsResponse)(nil), "fatal_fruit.auction.v1.QueryAllAuctionsResponse")
+func (m *QueryAuctionBidsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("fatal_fruit/auction/v1/query.proto", fileDescriptor_9b8d1b80edb3d51e)
+// QueryNextMinimumBidRequest is the request type for the Query/NextMinimumBid RPC method.
+type QueryNextMinimumBidRequest struct {
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
 }
 
-var fileDescriptor_9b8d1b80edb3d51e = []byte{
-	// 503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xc1, 0x6e, 0x13, 0x31,
-	0x14, 0xcc, 0xa6, 0x40, 0xc1, 0xa5, 0x1c, 0x4c, 0x04, 0xc9, 0xaa, 0xb2, 0x2a, 0xab, 0x48, 0x08,
-	0x88, 0xdd, 0x0d, 0x12, 0x37, 0x90, 0x92, 0x1f, 0x40, 0x04, 0x4e, 0xbd, 0x44, 0xde, 0xac, 0xb3,
-	0x18, 0xa5, 0xf6, 0x76, 0xed, 0x0d, 0x8a, 0xaa, 0x5e, 0x38, 0x72, 0x42, 0xf0, 0x1f, 0x9c, 0xfa,
-	0x11, 0x88, 0x53, 0x05, 0x17, 0xc4, 0x09, 0x25, 0x7c, 0x08, 0x8a, 0xed, 0xad, 0x9a, 0x36, 0xa9,
-	0x82, 0x10, 0xa7, 0x95, 0xf7, 0xcd, 0x9b, 0x99, 0xf7, 0x3c, 0x32, 0xc0, 0x03, 0x66, 0xd8, 0xb0,
-	0x37, 0xc8, 0x0b, 0x61, 0x28, 0x2b, 0xfa, 0x46, 0x28, 0x49, 0x47, 0x11, 0x3d, 0x28, 0x78, 0x3e,
-	0x26, 0x59, 0xae, 0x8c, 0x82, 0x77, 0xce, 0x60, 0x88, 0xc7, 0x90, 0x51, 0x14, 0x6e, 0xa5, 0x4a,
-	0xa5, 0x43, 0x4e, 0x59, 0x26, 0x28, 0x93, 0x52, 0x19, 0x36, 0xab, 0x68, 0xd7, 0x15, 0x3e, 0xe8,
-	0x2b, 0xbd, 0xaf, 0x34, 0x8d, 0x99, 0xe6, 0x8e, 0x8e, 0x8e, 0xa2, 0x98, 0x1b, 0x16, 0xd1, 0x8c,
-	0xa5, 0x42, 0x5a, 0xb0, 0xc7, 0x36, 0x1c, 0xb6, 0x67, 0x4f, 0xd4, 0x1d, 0x7c, 0x69, 0x99, 0x41,
-	0x33, 0xce, 0x78, 0x89, 0x69, 0x78, 0x23, 0xf6, 0x14, 0x17, 0x03, 0xca, 0xa4, 0xf7, 0x8e, 0xef,
-	0x81, 0xdb, 0x2f, 0x66, 0xda, 0x6d, 0xd7, 0xd9, 0xe5, 0x07, 0x05, 0xd7, 0x06, 0xde, 0x02, 0x55,
-	0x91, 0xd4, 0x83, 0xed, 0xe0, 0xfe, 0x95, 0x6e, 0x55, 0x24, 0xf8, 0x0d, 0xa8, 0xcd, 0xc3, 0x74,
-	0xa6, 0xa4, 0xe6, 0xb0, 0x0b, 0xd6, 0xbd, 0xa6, 0x05, 0x6f, 0xb4, 0x6a, 0xc4, 0x69, 0x91, 0x52,
-	0x8b, 0xb4, 0xe5, 0xb8, 0x83, 0xbf, 0x1e, 0x37, 0xd1, 0xe2, 0x2d, 0x91, 0x92, 0xb2, 0x24, 0xc2,
-	0x7b, 0xa0, 0x61, 0xb5, 0x9e, 0xbf, 0x95, 0x3c, 0xf7, 0x55, 0x5d, 0x1a, 0x7b, 0x0a, 0x36, 0xd5,
-	0xec, 0x7f, 0x8f, 0x25, 0x49, 0xce, 0xb5, 0xb6, 0xb2, 0x37, 0x3a, 0xf5, 0x6f, 0xc7, 0xcd, 0x9a,
-	0xdf, 0x4b, 0xdb, 0x55, 0x5e, 0x9a, 0x5c, 0xc8, 0xb4, 0x7b, 0xd3, 0xc2, 0xfd, 0x3f, 0x9c, 0x83,
-	0x70, 0x11, 0xb7, 0x9f, 0xe6, 0x15, 0xb8, 0xee, 0x4d, 0xcc, 0x78, 0xd7, 0xfe, 0x69, 0x9c, 0x53,
-	0x26, 0xdc, 0x00, 0x77, 0xdd, 0xee, 0x86, 0xc3, 0x73, 0xd3, 0xe0, 0x0c, 0xd4, 0x2f, 0x96, 0xfe,
-	0xa7, 0x99, 0xd6, 0xcf, 0x35, 0x70, 0xd5, 0x4a, 0xc2, 0xf7, 0x01, 0x58, 0xf7, 0x75, 0xf8, 0x90,
-	0x2c, 0x21, 0x58, 0x90, 0x8d, 0xf0, 0xd1, 0x6a, 0x60, 0x37, 0x06, 0xde, 0x79, 0xf7, 0xfd, 0xf7,
-	0xa7, 0x2a, 0x82, 0x5b, 0x3e, 0xb6, 0xa7, 0x19, 0x2d, 0xbf, 0x87, 0x22, 0x39, 0x82, 0x9f, 0x03,
-	0xb0, 0x39, 0x77, 0x27, 0x30, 0xba, 0x54, 0x65, 0x51, 0x36, 0xc2, 0xd6, 0xdf, 0xb4, 0x78, 0x7b,
-	0x4f, 0xac, 0xbd, 0x5d, 0x48, 0xce, 0xdb, 0xb3, 0xb1, 0xa1, 0x87, 0x73, 0x61, 0x3b, 0x2a, 0xab,
-	0x1a, 0x7e, 0x0c, 0xc0, 0xc6, 0x99, 0x5b, 0x83, 0xf4, 0xf2, 0xa5, 0x5c, 0xb8, 0xfa, 0x70, 0x77,
-	0xf5, 0x06, 0x6f, 0x75, 0xdb, 0x5a, 0x0d, 0x61, 0x7d, 0xc9, 0x26, 0x75, 0xe7, 0xd9, 0x97, 0x09,
-	0x0a, 0x4e, 0x26, 0x28, 0xf8, 0x35, 0x41, 0xc1, 0x87, 0x29, 0xaa, 0x9c, 0x4c, 0x51, 0xe5, 0xc7,
-	0x14, 0x55, 0xf6, 0x76, 0x52, 0x61, 0x5e, 0x17, 0x31, 0xe9, 0xab, 0x7d, 0x6a, 0x75, 0x9b, 0xf3,
-	0x0f, 0x86, 0x7d, 0x2d, 0xe2, 0x6b, 0x36, 0x58, 0x8f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xfa,
-	0xfc, 0x9d, 0xed, 0xf5, 0x04, 0x00, 0x00,
+func (m *QueryNextMinimumBidRequest) Reset()         { *m = QueryNextMinimumBidRequest{} }
+func (m *QueryNextMinimumBidRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextMinimumBidRequest) ProtoMessage()    {}
+func (*QueryNextMinimumBidRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b8d1b80edb3d51e, []int{6}
+}
+func (m *QueryNextMinimumBidRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextMinimumBidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextMinimumBidRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextMinimumBidRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextMinimumBidRequest.Merge(m, src)
+}
+func (m *QueryNextMinimumBidRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextMinimumBidRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextMinimumBidRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryNextMinimumBidRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Auction retrieves the details of an auction by its ID.
-	Auction(ctx context.Context, in *QueryAuctionRequest, opts ...grpc.CallOption) (*QueryAuctionResponse, error)
-	// OwnerAuctions retrieves all auctions owned by an address.
-	OwnerAuctions(ctx context.Context, in *QueryOwnerAuctionsRequest, opts ...grpc.CallOption) (*QueryOwnerAuctionsResponse, error)
-	// AllAuctions retrieves a paginated list of all auctions.
-	AllAuctions(ctx context.Context, in *QueryAllAuctionsRequest, opts ...grpc.CallOption) (*QueryAllAuctionsResponse, error)
+func (m *QueryNextMinimumBidRequest) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryNextMinimumBidResponse is the response type for the Query/NextMinimumBid RPC method.
+type QueryNextMinimumBidResponse struct {
+	MinBid types1.Coin `protobuf:"bytes,1,opt,name=min_bid,json=minBid,proto3" json:"min_bid"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryNextMinimumBidResponse) Reset()         { *m = QueryNextMinimumBidResponse{} }
+func (m *QueryNextMinimumBidResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextMinimumBidResponse) ProtoMessage()    {}
+func (*QueryNextMinimumBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b8d1b80edb3d51e, []int{7}
 }
-
-func (c *queryClient) Auction(ctx context.Context, in *QueryAuctionRequest, opts ...grpc.CallOption) (*QueryAuctionResponse, error) {
-	out := new(QueryAuctionResponse)
-	err := c.cc.Invoke(ctx, "/fatal_fruit.auction.v1.Query/Auction", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryNextMinimumBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) OwnerAuctions(ctx context.Context, in *QueryOwnerAuctionsRequest, opts ...grpc.CallOption) (*QueryOwnerAuctionsResponse, error) {
-	out := new(QueryOwnerAuctionsResponse)
-	err := c.cc.Invoke(ctx, "/fatal_fruit.auction.v1.Query/OwnerAuctions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryNextMinimumBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextMinimumBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryNextMinimumBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextMinimumBidResponse.Merge(m, src)
+}
+func (m *QueryNextMinimumBidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextMinimumBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextMinimumBidResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) AllAuctions(ctx context.Context, in *QueryAllAuctionsRequest, opts ...grpc.CallOption) (*QueryAllAuctionsResponse, error) {
-	out := new(QueryAllAuctionsResponse)
-	err := c.cc.Invoke(ctx, "/fatal_fruit.auction.v1.Query/AllAuctions", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryNextMinimumBidResponse proto.InternalMessageInfo
+
+func (m *QueryNextMinimumBidResponse) GetMinBid() types1.Coin {
+	if m != nil {
+		return m.MinBid
 	}
-	return out, nil
+	return types1.Coin{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Auction retrieves the details of an auction by its ID.
-	Auction(context.Context, *QueryAuctionRequest) (*QueryAuctionResponse, error)
-	// OwnerAuctions retrieves all auctions owned by an address.
-	OwnerAuctions(context.Context, *QueryOwnerAuctionsRequest) (*QueryOwnerAuctionsResponse, error)
-	// AllAuctions retrieves a paginated list of all auctions.
-	AllAuctions(context.Context, *QueryAllAuctionsRequest) (*QueryAllAuctionsResponse, error)
+// QueryBidderAuctionsRequest is the request type for the Query/BidderAuctions RPC method.
+type QueryBidderAuctionsRequest struct {
+	BidderAddress string `protobuf:"bytes,1,opt,name=bidder_address,json=bidderAddress,proto3" json:"bidder_address,omitempty"`
+	// winning_only restricts the results to auctions the bidder is currently winning.
+	WinningOnly bool               `protobuf:"varint,2,opt,name=winning_only,json=winningOnly,proto3" json:"winning_only,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryBidderAuctionsRequest) Reset()         { *m = QueryBidderAuctionsRequest{} }
+func (m *QueryBidderAuctionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBidderAuctionsRequest) ProtoMessage()    {}
+func (*QueryBidderAuctionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b8d1b80edb3d51e, []int{8}
 }
-
-func (*UnimplementedQueryServer) Auction(ctx context.Context, req *QueryAuctionRequest) (*QueryAuctionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Auction not implemented")
+func (m *QueryBidderAuctionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) OwnerAuctions(ctx context.Context, req *QueryOwnerAuctionsRequest) (*QueryOwnerAuctionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OwnerAuctions not implemented")
+func (m *QueryBidderAuctionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBidderAuctionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) AllAuctions(ctx context.Context, req *QueryAllAuctionsRequest) (*QueryAllAuctionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllAuctions not implemented")
+func (m *QueryBidderAuctionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBidderAuctionsRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryBidderAuctionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBidderAuctionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBidderAuctionsRequest.DiscardUnknown(m)
 }
 
-func _Query_Auction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAuctionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Auction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fatal_fruit.auction.v1.Query/Auction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Auction(ctx, req.(*QueryAuctionRequest))
+var xxx_messageInfo_QueryBidderAuctionsRequest proto.InternalMessageInfo
+
+func (m *QueryBidderAuctionsRequest) GetBidderAddress() string {
+	if m != nil {
+		return m.BidderAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_OwnerAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOwnerAuctionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OwnerAuctions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fatal_fruit.auction.v1.Query/OwnerAuctions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OwnerAuctions(ctx, req.(*QueryOwnerAuctionsRequest))
+func (m *QueryBidderAuctionsRequest) GetWinningOnly() bool {
+	if m != nil {
+		return m.WinningOnly
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_AllAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllAuctionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllAuctions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fatal_fruit.auction.v1.Query/AllAuctions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllAuctions(ctx, req.(*QueryAllAuctionsRequest))
+func (m *QueryBidderAuctionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "fatal_fruit.auction.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Auction",
-			Handler:    _Query_Auction_Handler,
-		},
-		{
-			MethodName: "OwnerAuctions",
-			Handler:    _Query_OwnerAuctions_Handler,
-		},
-		{
-			MethodName: "AllAuctions",
-			Handler:    _Query_AllAuctions_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "fatal_fruit/auction/v1/query.proto",
+// QueryBidderAuctionsResponse is the response type for the Query/BidderAuctions RPC method.
+type QueryBidderAuctionsResponse struct {
+	Auctions   []BidderAuction     `protobuf:"bytes,1,rep,name=auctions,proto3" json:"auctions"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAuctionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBidderAuctionsResponse) Reset()         { *m = QueryBidderAuctionsResponse{} }
+func (m *QueryBidderAuctionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBidderAuctionsResponse) ProtoMessage()    {}
+func (*QueryBidderAuctionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b8d1b80edb3d51e, []int{9}
+}
+func (m *QueryBidderAuctionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBidderAuctionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBidderAuctionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryAuctionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBidderAuctionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBidderAuctionsResponse.Merge(m, src)
 }
-
-func (m *QueryAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryBidderAuctionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBidderAuctionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBidderAuctionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBidderAuctionsResponse proto.InternalMessageInfo
+
+func (m *QueryBidderAuctionsResponse) GetAuctions() []BidderAuction {
+	if m != nil {
+		return m.Auctions
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAuctionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBidderAuctionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BidderAuction is an auction together with the bids a single bidder placed on it.
+type BidderAuction struct {
+	Auction *types.Any `protobuf:"bytes,1,opt,name=auction,proto3" json:"auction,omitempty"`
+	Bids    []*Bid     `protobuf:"bytes,2,rep,name=bids,proto3" json:"bids,omitempty"`
 }
 
-func (m *QueryAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Auction != nil {
-		{
-			size, err := m.Auction.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *BidderAuction) Reset()         { *m = BidderAuction{} }
+func (m *BidderAuction) String() string { return proto.CompactTextString(m) }
+func (*BidderAuction) ProtoMessage()    {}
+func (*BidderAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b8d1b80edb3d51e, []int{10}
+}
+func (m *BidderAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BidderAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BidderAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BidderAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BidderAuction.Merge(m, src)
+}
+func (m *BidderAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *BidderAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_BidderAuction.DiscardUnknown(m)
 }
 
-func (m *QueryOwnerAuctionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BidderAuction proto.InternalMessageInfo
+
+func (m *BidderAuction) GetAuction() *types.Any {
+	if m != nil {
+		return m.Auction
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryOwnerAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BidderAuction) GetBids() []*Bid {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
 }
 
-func (m *QueryOwnerAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OwnerAddress) > 0 {
-		i -= len(m.OwnerAddress)
-		copy(dAtA[i:], m.OwnerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OwnerAddress)))
-		i--
-		dAtA[i] = 0xa
+// QueryAllAuctionsRequest is the request type for the Query/AllAuctions RPC method.
+type QueryAllAuctionsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// status optionally filters auctions by their status, given by its AuctionStatus name
+	// with or without the AUCTION_STATUS_ prefix.
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// auction_type optionally filters auctions by their type URL.
+	AuctionType string `protobuf:"bytes,3,opt,name=auction_type,json=auctionType,proto3" json:"auction_type,omitempty"`
+}
+
+func (m *QueryAllAuctionsRequest) Reset()         { *m = QueryAllAuctionsRequest{} }
+func (m *QueryAllAuctionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAuctionsRequest) ProtoMessage()    {}
+func (*QueryAllAuctionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b8d1b80edb3d51e, []int{11}
+}
+func (m *QueryAllAuctionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAuctionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAuctionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllAuctionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAuctionsRequest.Merge(m, src)
+}
+func (m *QueryAllAuctionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAuctionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAuctionsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryOwnerAuctionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllAuctionsRequest proto.InternalMessageInfo
+
+func (m *QueryAllAuctionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryOwnerAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllAuctionsRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
 }
 
-func (m *QueryOwnerAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Auctions) > 0 {
-		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAllAuctionsRequest) GetAuctionType() string {
+	if m != nil {
+		return m.AuctionType
+	}
+	return ""
+}
+
+// QueryAllAuctionsResponse is the response type for the Query/AllAuctions RPC method.
+type QueryAllAuctionsResponse struct {
+	Auctions   []*types.Any        `protobuf:"bytes,1,rep,name=auctions,proto3" json:"auctions,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllAuctionsResponse) Reset()         { *m = QueryAllAuctionsResponse{} }
+func (m *QueryAllAuctionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAuctionsResponse) ProtoMessage()    {}
+func (*QueryAllAuctionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b8d1b80edb3d51e, []int{12}
+}
+func (m *QueryAllAuctionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAuctionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAuctionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllAuctionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAuctionsResponse.Merge(m, src)
+}
+func (m *QueryAllAuctionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAuctionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAuctionsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllAuctionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllAuctionsResponse proto.InternalMessageInfo
+
+func (m *QueryAllAuctionsResponse) GetAuctions() []*types.Any {
+	if m != nil {
+		return m.Auctions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllAuctionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryActiveAuctionsRequest is the request type for the Query/ActiveAuctions RPC method.
+type QueryActiveAuctionsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllAuctionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryActiveAuctionsRequest) Reset()         { *m = QueryActiveAuctionsRequest{} }
+func (m *QueryActiveAuctionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveAuctionsRequest) ProtoMessage()    {}
+func (*QueryActiveAuctionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b8d1b80edb3d51e, []int{13}
+}
+func (m *QueryActiveAuctionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveAuctionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveAuctionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryActiveAuctionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveAuctionsRequest.Merge(m, src)
+}
+func (m *QueryActiveAuctionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveAuctionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveAuctionsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryActiveAuctionsRequest proto.InternalMessageInfo
+
+func (m *QueryActiveAuctionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Auctions) > 0 {
-		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+// QueryActiveAuctionsResponse is the response type for the Query/ActiveAuctions RPC method.
+type QueryActiveAuctionsResponse struct {
+	Auctions   []*types.Any        `protobuf:"bytes,1,rep,name=auctions,proto3" json:"auctions,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryActiveAuctionsResponse) Reset()         { *m = QueryActiveAuctionsResponse{} }
+func (m *QueryActiveAuctionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveAuctionsResponse) ProtoMessage()    {}
+func (*QueryActiveAuctionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b8d1b80edb3d51e, []int{14}
+}
+func (m *QueryActiveAuctionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveAuctionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveAuctionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActiveAuctionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveAuctionsResponse.Merge(m, src)
+}
+func (m *QueryActiveAuctionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveAuctionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveAuctionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActiveAuctionsResponse proto.InternalMessageInfo
+
+func (m *QueryActiveAuctionsResponse) GetAuctions() []*types.Any {
+	if m != nil {
+		return m.Auctions
+	}
+	return nil
+}
+
+func (m *QueryActiveAuctionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRevealAuctionsRequest is the request type for the Query/RevealAuctions RPC method.
+type QueryRevealAuctionsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRevealAuctionsRequest) Reset()         { *m = QueryRevealAuctionsRequest{} }
+func (m *QueryRevealAuctionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRevealAuctionsRequest) ProtoMessage()    {}
+func (*QueryRevealAuctionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b8d1b80edb3d51e, []int{15}
+}
+func (m *QueryRevealAuctionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRevealAuctionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRevealAuctionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRevealAuctionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRevealAuctionsRequest.Merge(m, src)
+}
+func (m *QueryRevealAuctionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRevealAuctionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRevealAuctionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRevealAuctionsRequest proto.InternalMessageInfo
+
+func (m *QueryRevealAuctionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRevealAuctionsResponse is the response type for the Query/RevealAuctions RPC method.
+type QueryRevealAuctionsResponse struct {
+	Auctions   []*types.Any        `protobuf:"bytes,1,rep,name=auctions,proto3" json:"auctions,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRevealAuctionsResponse) Reset()         { *m = QueryRevealAuctionsResponse{} }
+func (m *QueryRevealAuctionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRevealAuctionsResponse) ProtoMessage()    {}
+func (*QueryRevealAuctionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b8d1b80edb3d51e, []int{16}
+}
+func (m *QueryRevealAuctionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRevealAuctionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRevealAuctionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRevealAuctionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRevealAuctionsResponse.Merge(m, src)
+}
+func (m *QueryRevealAuctionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRevealAuctionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRevealAuctionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRevealAuctionsResponse proto.InternalMessageInfo
+
+func (m *QueryRevealAuctionsResponse) GetAuctions() []*types.Any {
+	if m != nil {
+		return m.Auctions
+	}
+	return nil
+}
+
+func (m *QueryRevealAuctionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryExpiredAuctionsRequest is the request type for the Query/ExpiredAuctions RPC method.
+type QueryExpiredAuctionsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExpiredAuctionsRequest) Reset()         { *m = QueryExpiredAuctionsRequest{} }
+func (m *QueryExpiredAuctionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExpiredAuctionsRequest) ProtoMessage()    {}
+func (*QueryExpiredAuctionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b8d1b80edb3d51e, []int{17}
+}
+func (m *QueryExpiredAuctionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpiredAuctionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpiredAuctionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExpiredAuctionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpiredAuctionsRequest.Merge(m, src)
+}
+func (m *QueryExpiredAuctionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpiredAuctionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpiredAuctionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExpiredAuctionsRequest proto.InternalMessageInfo
+
+func (m *QueryExpiredAuctionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryExpiredAuctionsResponse is the response type for the Query/ExpiredAuctions RPC method.
+type QueryExpiredAuctionsResponse struct {
+	Auctions   []*types.Any        `protobuf:"bytes,1,rep,name=auctions,proto3" json:"auctions,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExpiredAuctionsResponse) Reset()         { *m = QueryExpiredAuctionsResponse{} }
+func (m *QueryExpiredAuctionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExpiredAuctionsResponse) ProtoMessage()    {}
+func (*QueryExpiredAuctionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b8d1b80edb3d51e, []int{18}
+}
+func (m *QueryExpiredAuctionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpiredAuctionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpiredAuctionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExpiredAuctionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpiredAuctionsResponse.Merge(m, src)
+}
+func (m *QueryExpiredAuctionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpiredAuctionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpiredAuctionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExpiredAuctionsResponse proto.InternalMessageInfo
+
+func (m *QueryExpiredAuctionsResponse) GetAuctions() []*types.Any {
+	if m != nil {
+		return m.Auctions
+	}
+	return nil
+}
+
+func (m *QueryExpiredAuctionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingAuctionsRequest is the request type for the Query/PendingAuctions RPC method.
+type QueryPendingAuctionsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingAuctionsRequest) Reset()         { *m = QueryPendingAuctionsRequest{} }
+func (m *QueryPendingAuctionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingAuctionsRequest) ProtoMessage()    {}
+func (*QueryPendingAuctionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b8d1b80edb3d51e, []int{19}
+}
+func (m *QueryPendingAuctionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingAuctionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingAuctionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingAuctionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingAuctionsRequest.Merge(m, src)
+}
+func (m *QueryPendingAuctionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingAuctionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingAuctionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingAuctionsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingAuctionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingAuctionsResponse is the response type for the Query/PendingAuctions RPC method.
+type QueryPendingAuctionsResponse struct {
+	Auctions   []*types.Any        `protobuf:"bytes,1,rep,name=auctions,proto3" json:"auctions,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingAuctionsResponse) Reset()         { *m = QueryPendingAuctionsResponse{} }
+func (m *QueryPendingAuctionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingAuctionsResponse) ProtoMessage()    {}
+func (*QueryPendingAuctionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b8d1b80edb3d51e, []int{20}
+}
+func (m *QueryPendingAuctionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingAuctionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingAuctionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingAuctionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingAuctionsResponse.Merge(m, src)
+}
+func (m *QueryPendingAuctionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingAuctionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingAuctionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingAuctionsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingAuctionsResponse) GetAuctions() []*types.Any {
+	if m != nil {
+		return m.Auctions
+	}
+	return nil
+}
+
+func (m *QueryPendingAuctionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCancelledAuctionsRequest is the request type for the Query/CancelledAuctions RPC method.
+type QueryCancelledAuctionsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCancelledAuctionsRequest) Reset()         { *m = QueryCancelledAuctionsRequest{} }
+func (m *QueryCancelledAuctionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCancelledAuctionsRequest) ProtoMessage()    {}
+func (*QueryCancelledAuctionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b8d1b80edb3d51e, []int{21}
+}
+func (m *QueryCancelledAuctionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCancelledAuctionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCancelledAuctionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCancelledAuctionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCancelledAuctionsRequest.Merge(m, src)
+}
+func (m *QueryCancelledAuctionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCancelledAuctionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCancelledAuctionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCancelledAuctionsRequest proto.InternalMessageInfo
+
+func (m *QueryCancelledAuctionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCancelledAuctionsResponse is the response type for the Query/CancelledAuctions RPC method.
+type QueryCancelledAuctionsResponse struct {
+	Auctions   []*types.Any        `protobuf:"bytes,1,rep,name=auctions,proto3" json:"auctions,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCancelledAuctionsResponse) Reset()         { *m = QueryCancelledAuctionsResponse{} }
+func (m *QueryCancelledAuctionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCancelledAuctionsResponse) ProtoMessage()    {}
+func (*QueryCancelledAuctionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b8d1b80edb3d51e, []int{22}
+}
+func (m *QueryCancelledAuctionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCancelledAuctionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCancelledAuctionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCancelledAuctionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCancelledAuctionsResponse.Merge(m, src)
+}
+func (m *QueryCancelledAuctionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCancelledAuctionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCancelledAuctionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCancelledAuctionsResponse proto.InternalMessageInfo
+
+func (m *QueryCancelledAuctionsResponse) GetAuctions() []*types.Any {
+	if m != nil {
+		return m.Auctions
+	}
+	return nil
+}
+
+func (m *QueryCancelledAuctionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b8d1b80edb3d51e, []int{23}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b8d1b80edb3d51e, []int{24}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func init() {
+	proto.RegisterType((*QueryAuctionRequest)(nil), "fatal_fruit.auction.v1.QueryAuctionRequest")
+	proto.RegisterType((*QueryAuctionResponse)(nil), "fatal_fruit.auction.v1.QueryAuctionResponse")
+	proto.RegisterType((*QueryOwnerAuctionsRequest)(nil), "fatal_fruit.auction.v1.QueryOwnerAuctionsRequest")
+	proto.RegisterType((*QueryOwnerAuctionsResponse)(nil), "fatal_fruit.auction.v1.QueryOwnerAuctionsResponse")
+	proto.RegisterType((*QueryAuctionBidsRequest)(nil), "fatal_fruit.auction.v1.QueryAuctionBidsRequest")
+	proto.RegisterType((*QueryAuctionBidsResponse)(nil), "fatal_fruit.auction.v1.QueryAuctionBidsResponse")
+	proto.RegisterType((*QueryNextMinimumBidRequest)(nil), "fatal_fruit.auction.v1.QueryNextMinimumBidRequest")
+	proto.RegisterType((*QueryNextMinimumBidResponse)(nil), "fatal_fruit.auction.v1.QueryNextMinimumBidResponse")
+	proto.RegisterType((*QueryBidderAuctionsRequest)(nil), "fatal_fruit.auction.v1.QueryBidderAuctionsRequest")
+	proto.RegisterType((*QueryBidderAuctionsResponse)(nil), "fatal_fruit.auction.v1.QueryBidderAuctionsResponse")
+	proto.RegisterType((*BidderAuction)(nil), "fatal_fruit.auction.v1.BidderAuction")
+	proto.RegisterType((*QueryAllAuctionsRequest)(nil), "fatal_fruit.auction.v1.QueryAllAuctionsRequest")
+	proto.RegisterType((*QueryAllAuctionsResponse)(nil), "fatal_fruit.auction.v1.QueryAllAuctionsResponse")
+	proto.RegisterType((*QueryActiveAuctionsRequest)(nil), "fatal_fruit.auction.v1.QueryActiveAuctionsRequest")
+	proto.RegisterType((*QueryActiveAuctionsResponse)(nil), "fatal_fruit.auction.v1.QueryActiveAuctionsResponse")
+	proto.RegisterType((*QueryRevealAuctionsRequest)(nil), "fatal_fruit.auction.v1.QueryRevealAuctionsRequest")
+	proto.RegisterType((*QueryRevealAuctionsResponse)(nil), "fatal_fruit.auction.v1.QueryRevealAuctionsResponse")
+	proto.RegisterType((*QueryExpiredAuctionsRequest)(nil), "fatal_fruit.auction.v1.QueryExpiredAuctionsRequest")
+	proto.RegisterType((*QueryExpiredAuctionsResponse)(nil), "fatal_fruit.auction.v1.QueryExpiredAuctionsResponse")
+	proto.RegisterType((*QueryPendingAuctionsRequest)(nil), "fatal_fruit.auction.v1.QueryPendingAuctionsRequest")
+	proto.RegisterType((*QueryPendingAuctionsResponse)(nil), "fatal_fruit.auction.v1.QueryPendingAuctionsResponse")
+	proto.RegisterType((*QueryCancelledAuctionsRequest)(nil), "fatal_fruit.auction.v1.QueryCancelledAuctionsRequest")
+	proto.RegisterType((*QueryCancelledAuctionsResponse)(nil), "fatal_fruit.auction.v1.QueryCancelledAuctionsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "fatal_fruit.auction.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "fatal_fruit.auction.v1.QueryParamsResponse")
+}
+
+func init() {
+	proto.RegisterFile("fatal_fruit/auction/v1/query.proto", fileDescriptor_9b8d1b80edb3d51e)
+}
+
+var fileDescriptor_9b8d1b80edb3d51e = []byte{
+	// 1234 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x98, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0x33, 0x69, 0x48, 0x9a, 0x49, 0x93, 0xaa, 0x43, 0x14, 0x36, 0x6e, 0xea, 0xa6, 0x43,
+	0x4a, 0x4b, 0x42, 0xec, 0x6c, 0x4a, 0x0a, 0x12, 0x04, 0x94, 0xad, 0xa0, 0xe2, 0x00, 0x2d, 0x6e,
+	0x4f, 0x15, 0xd2, 0xca, 0xbb, 0x9e, 0x98, 0x41, 0xbb, 0xe3, 0xed, 0xda, 0x9b, 0x66, 0x15, 0x45,
+	0x02, 0x8e, 0x9c, 0x10, 0x70, 0x42, 0x9c, 0x40, 0x42, 0x9c, 0x00, 0x89, 0x1e, 0x40, 0x02, 0x21,
+	0x71, 0x8a, 0x38, 0x55, 0x70, 0xe1, 0x84, 0x50, 0x82, 0xc4, 0xd7, 0x40, 0x9e, 0x79, 0xde, 0xac,
+	0x77, 0xed, 0xf5, 0xa6, 0xed, 0x1e, 0x72, 0x69, 0xe3, 0xf1, 0x7b, 0xf3, 0x7e, 0xef, 0x3f, 0xcf,
+	0xf6, 0x7b, 0x8b, 0xe9, 0xa6, 0x1d, 0xd8, 0x95, 0xe2, 0x66, 0xbd, 0xc1, 0x03, 0xd3, 0x6e, 0x94,
+	0x03, 0xee, 0x09, 0x73, 0x2b, 0x6f, 0xde, 0x6d, 0xb0, 0x7a, 0xd3, 0xa8, 0xd5, 0xbd, 0xc0, 0x23,
+	0x33, 0x6d, 0x36, 0x06, 0xd8, 0x18, 0x5b, 0x79, 0x6d, 0xce, 0xf5, 0x3c, 0xb7, 0xc2, 0x4c, 0xbb,
+	0xc6, 0x4d, 0x5b, 0x08, 0x2f, 0xb0, 0xc3, 0x3b, 0xbe, 0xf2, 0xd2, 0x16, 0xcb, 0x9e, 0x5f, 0xf5,
+	0x7c, 0xb3, 0x64, 0xfb, 0x4c, 0x6d, 0x67, 0x6e, 0xe5, 0x4b, 0x2c, 0xb0, 0xf3, 0x66, 0xcd, 0x76,
+	0xb9, 0x90, 0xc6, 0x60, 0x3b, 0xab, 0x6c, 0x8b, 0xf2, 0xca, 0x54, 0x17, 0x70, 0x2b, 0x0d, 0x30,
+	0x68, 0xd6, 0x58, 0x64, 0xf3, 0x74, 0x8a, 0x4d, 0xcd, 0xae, 0xdb, 0xd5, 0xc8, 0x68, 0xda, 0xf5,
+	0x5c, 0x4f, 0x05, 0x08, 0xff, 0x8a, 0x22, 0x43, 0x0e, 0xf2, 0xaa, 0xd4, 0xd8, 0x34, 0x6d, 0x01,
+	0x69, 0x6b, 0x7a, 0x7b, 0x02, 0x11, 0x7a, 0xd9, 0xe3, 0x11, 0xf4, 0x19, 0xbb, 0xca, 0x85, 0x67,
+	0xca, 0x7f, 0xd5, 0x12, 0xbd, 0x88, 0x9f, 0x7c, 0x3b, 0xcc, 0x74, 0x43, 0x31, 0x58, 0xec, 0x6e,
+	0x83, 0xf9, 0x01, 0x99, 0xc2, 0xc3, 0xdc, 0xc9, 0xa1, 0x79, 0x74, 0x79, 0xc4, 0x1a, 0xe6, 0x0e,
+	0x7d, 0x0f, 0x4f, 0xc7, 0xcd, 0xfc, 0x9a, 0x27, 0x7c, 0x46, 0x2c, 0x3c, 0x06, 0xf4, 0xd2, 0x78,
+	0x62, 0x75, 0xda, 0x50, 0x78, 0x46, 0x84, 0x67, 0x6c, 0x88, 0x66, 0x81, 0xfe, 0x7e, 0x7f, 0x59,
+	0x4f, 0x3e, 0x13, 0x23, 0xda, 0x32, 0xda, 0x88, 0xde, 0xc1, 0xb3, 0x32, 0xd6, 0x8d, 0x7b, 0x82,
+	0xd5, 0xe1, 0xae, 0x1f, 0x81, 0xad, 0xe3, 0x49, 0x2f, 0x5c, 0x2f, 0xda, 0x8e, 0x53, 0x67, 0xbe,
+	0x2f, 0xc3, 0x8e, 0x17, 0x72, 0x7f, 0xdc, 0x5f, 0x9e, 0x86, 0x53, 0xd8, 0x50, 0x77, 0x6e, 0x05,
+	0x75, 0x2e, 0x5c, 0xeb, 0x94, 0x34, 0x87, 0x35, 0x5a, 0xc7, 0x5a, 0xd2, 0xde, 0x90, 0xcd, 0x6d,
+	0x7c, 0x12, 0x20, 0xc2, 0x7d, 0x4f, 0x3c, 0x52, 0x3a, 0xad, 0x9d, 0xe8, 0xfb, 0x08, 0x3f, 0xd5,
+	0x2e, 0x5e, 0x81, 0x3b, 0xad, 0x74, 0xce, 0x61, 0x0c, 0x76, 0xc5, 0x96, 0xde, 0xe3, 0xb0, 0xf2,
+	0x86, 0x43, 0x5e, 0xc7, 0xf8, 0xb0, 0xf2, 0x72, 0xc3, 0x52, 0xe1, 0x67, 0x0c, 0xc8, 0x33, 0x3c,
+	0x65, 0x43, 0x55, 0x3d, 0x9c, 0xb5, 0x71, 0xd3, 0x76, 0x19, 0x6c, 0x6d, 0xb5, 0x79, 0xd2, 0xcf,
+	0x11, 0xce, 0x75, 0x23, 0x40, 0xd6, 0x6b, 0x78, 0xa4, 0xc4, 0x9d, 0x28, 0xe3, 0xb3, 0x46, 0x4a,
+	0x62, 0x05, 0xee, 0x14, 0x46, 0xf6, 0xfe, 0x3e, 0x3f, 0x64, 0x49, 0x73, 0x72, 0x3d, 0x81, 0xed,
+	0x52, 0x26, 0x9b, 0x8a, 0x19, 0x83, 0x7b, 0x09, 0xce, 0xe4, 0x2d, 0xb6, 0x1d, 0xbc, 0xc9, 0x05,
+	0xaf, 0x36, 0xaa, 0x05, 0xee, 0xf4, 0xa7, 0x10, 0x7d, 0x07, 0x9f, 0x4d, 0x74, 0x86, 0xdc, 0xd6,
+	0xf1, 0x58, 0x95, 0x8b, 0x62, 0x09, 0x5c, 0x27, 0x56, 0x67, 0x63, 0x84, 0x11, 0xdb, 0x35, 0x8f,
+	0x8b, 0xc2, 0x78, 0x98, 0xdc, 0x37, 0xff, 0x7d, 0xbf, 0x88, 0xac, 0xd1, 0x2a, 0x0f, 0x35, 0xa2,
+	0x7b, 0x08, 0xd8, 0x0a, 0xdc, 0x71, 0xba, 0x8b, 0xf1, 0x55, 0x3c, 0x55, 0x92, 0x37, 0xfa, 0xae,
+	0xc6, 0x49, 0x65, 0x0f, 0x8b, 0xe4, 0x02, 0x3e, 0x75, 0x8f, 0x0b, 0xc1, 0x85, 0x5b, 0xf4, 0x44,
+	0xa5, 0x29, 0x55, 0x3c, 0x69, 0x4d, 0xc0, 0xda, 0x0d, 0x51, 0x69, 0x76, 0x94, 0xc0, 0x89, 0x87,
+	0x2e, 0x81, 0xef, 0x10, 0x28, 0xd5, 0x99, 0x0a, 0x28, 0x75, 0xbd, 0xab, 0xf6, 0x2f, 0xf6, 0xa8,
+	0x84, 0xc3, 0x1d, 0xa0, 0x26, 0x5a, 0xce, 0x8f, 0xaf, 0x2e, 0x3e, 0x43, 0x78, 0x32, 0x16, 0x6a,
+	0x10, 0x6f, 0x1b, 0x62, 0x42, 0xf5, 0x0f, 0x67, 0x56, 0xbf, 0xaa, 0x7b, 0xfa, 0x45, 0xeb, 0x71,
+	0xae, 0x54, 0x3a, 0x0b, 0x22, 0x7e, 0x58, 0xe8, 0x61, 0x0f, 0x8b, 0xcc, 0xe0, 0x51, 0x3f, 0xb0,
+	0x83, 0x86, 0x2f, 0xf5, 0x1b, 0xb7, 0xe0, 0x2a, 0xac, 0x97, 0xe8, 0x61, 0x08, 0xbf, 0x26, 0xb2,
+	0x1c, 0xc6, 0xad, 0x09, 0x58, 0xbb, 0xdd, 0xac, 0x31, 0xfa, 0x53, 0xeb, 0x51, 0x6f, 0xc7, 0x1b,
+	0xe4, 0x0b, 0xee, 0xf1, 0x9d, 0xb8, 0x03, 0x4f, 0xdb, 0x46, 0x39, 0xe0, 0x5b, 0x6c, 0x40, 0xe2,
+	0xd2, 0x9f, 0xa3, 0x27, 0xa1, 0x33, 0xcc, 0xf1, 0x12, 0xc9, 0x62, 0x5b, 0xcc, 0xae, 0x0c, 0x5c,
+	0xa4, 0xce, 0x30, 0xc7, 0x43, 0x24, 0x06, 0xf4, 0xaf, 0x6d, 0xd7, 0x78, 0x9d, 0x39, 0x83, 0x52,
+	0xe9, 0x17, 0x84, 0xe7, 0x92, 0xe3, 0x1c, 0x2f, 0x99, 0x6e, 0x32, 0xe1, 0x70, 0xe1, 0x0e, 0x5c,
+	0xa6, 0xae, 0x38, 0xc7, 0x43, 0x26, 0x17, 0x9f, 0x93, 0xf8, 0xd7, 0x6c, 0x51, 0x66, 0x95, 0xca,
+	0xe0, 0xea, 0xe9, 0x57, 0x84, 0xf5, 0xb4, 0x48, 0xc7, 0x43, 0xaa, 0x69, 0x4c, 0xd4, 0x49, 0xcb,
+	0x41, 0x06, 0x72, 0xa4, 0xb7, 0x60, 0xca, 0x88, 0x56, 0x21, 0x97, 0x97, 0xf1, 0xa8, 0x1a, 0x78,
+	0x40, 0x32, 0x3d, 0xed, 0xeb, 0xab, 0xfc, 0xa0, 0xd5, 0x00, 0x9f, 0xd5, 0xdf, 0x4e, 0xe3, 0x27,
+	0xe4, 0xae, 0xe4, 0x23, 0x84, 0xc7, 0xa2, 0x1e, 0x61, 0x29, 0x6d, 0x8f, 0x84, 0x31, 0x47, 0x7b,
+	0xae, 0x3f, 0x63, 0x85, 0x4b, 0x17, 0x3e, 0xfc, 0xf3, 0xdf, 0x4f, 0x87, 0x75, 0x32, 0x07, 0xf3,
+	0x5e, 0x6b, 0x70, 0x8b, 0xfe, 0xdf, 0xe1, 0xce, 0x2e, 0xf9, 0x16, 0xe1, 0xc9, 0xd8, 0x78, 0x41,
+	0xf2, 0x3d, 0xa3, 0x24, 0x8d, 0x39, 0xda, 0xea, 0x51, 0x5c, 0x00, 0xef, 0xaa, 0xc4, 0x5b, 0x21,
+	0x46, 0x27, 0x9e, 0x9c, 0x80, 0xcc, 0x9d, 0xd8, 0xdc, 0xb4, 0x6b, 0xb6, 0xce, 0xfe, 0x2b, 0x84,
+	0x27, 0xda, 0xe6, 0x02, 0x62, 0xf6, 0x23, 0x4a, 0xdb, 0x10, 0xa3, 0xad, 0xf4, 0xef, 0x00, 0xa8,
+	0x79, 0x89, 0xba, 0x44, 0x9e, 0x4d, 0x55, 0xf2, 0xb0, 0xe7, 0xdf, 0x35, 0xe5, 0xb8, 0xf1, 0x23,
+	0xc2, 0x53, 0xf1, 0x26, 0x9f, 0xf4, 0x16, 0x29, 0x71, 0x9c, 0xd0, 0xae, 0x1c, 0xc9, 0x07, 0x70,
+	0xd7, 0x25, 0xee, 0x0b, 0x64, 0xad, 0x2f, 0x5c, 0xc1, 0xb6, 0x83, 0x62, 0x55, 0xed, 0x12, 0x4e,
+	0x1e, 0xe4, 0x07, 0x84, 0xa7, 0xe2, 0x5d, 0x77, 0x06, 0x7a, 0xe2, 0xb4, 0x91, 0x81, 0x9e, 0xdc,
+	0xd6, 0xd3, 0x17, 0x25, 0xfa, 0x2a, 0x59, 0xe9, 0x44, 0x57, 0x83, 0x88, 0xb9, 0x13, 0x1f, 0x60,
+	0xda, 0xca, 0xe2, 0x93, 0xb0, 0x2c, 0x0e, 0x7b, 0xc8, 0xac, 0xb2, 0xe8, 0x6a, 0x86, 0xb3, 0xca,
+	0xa2, 0xbb, 0x3d, 0xa5, 0xf3, 0x12, 0x56, 0x23, 0xb9, 0x14, 0x9d, 0x7d, 0xf2, 0x25, 0xc2, 0x53,
+	0xf1, 0xb6, 0x2d, 0x43, 0xca, 0xc4, 0x56, 0x32, 0x43, 0xca, 0xe4, 0xbe, 0x90, 0x5e, 0x92, 0x74,
+	0x17, 0xc8, 0xf9, 0x34, 0x3a, 0xd3, 0x96, 0x8e, 0x12, 0x32, 0xde, 0x36, 0x65, 0x40, 0x26, 0xb6,
+	0x72, 0x19, 0x90, 0xc9, 0x7d, 0x59, 0x1f, 0x90, 0x75, 0xe9, 0x48, 0xbe, 0x46, 0xf8, 0x74, 0x47,
+	0xd7, 0x42, 0x7a, 0x47, 0x4c, 0xee, 0xa5, 0xb4, 0xe7, 0x8f, 0xe6, 0x04, 0x9c, 0x97, 0x25, 0x27,
+	0x25, 0xf3, 0xa9, 0x9c, 0x4c, 0x79, 0x4a, 0xd0, 0x8e, 0xbe, 0x21, 0x03, 0x34, 0xb9, 0x9b, 0xc9,
+	0x00, 0x4d, 0x69, 0x4d, 0xfa, 0x00, 0xad, 0x29, 0xcf, 0xf0, 0xc5, 0x7f, 0xa6, 0xeb, 0xbb, 0x4d,
+	0xd6, 0x7a, 0x46, 0x4d, 0xeb, 0x28, 0xb4, 0xab, 0x47, 0x75, 0x03, 0xdc, 0x45, 0x89, 0xbb, 0x40,
+	0x68, 0x2a, 0x6e, 0x39, 0xf2, 0x25, 0x1f, 0x20, 0x3c, 0xaa, 0xbe, 0xac, 0x64, 0xb1, 0xb7, 0x36,
+	0xed, 0x1f, 0x73, 0x6d, 0xa9, 0x2f, 0x5b, 0xe0, 0xd1, 0x25, 0x4f, 0x8e, 0xcc, 0x74, 0xf2, 0xa8,
+	0x8f, 0x78, 0xe1, 0x95, 0xbd, 0x7d, 0x1d, 0x3d, 0xd8, 0xd7, 0xd1, 0x3f, 0xfb, 0x3a, 0xfa, 0xf8,
+	0x40, 0x1f, 0x7a, 0x70, 0xa0, 0x0f, 0xfd, 0x75, 0xa0, 0x0f, 0xdd, 0x59, 0x70, 0x79, 0xf0, 0x6e,
+	0xa3, 0x64, 0x94, 0xbd, 0xaa, 0x29, 0x03, 0x2e, 0xc7, 0x7f, 0x2d, 0x95, 0x3f, 0xa7, 0x96, 0x46,
+	0x65, 0xd3, 0x73, 0xe5, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x6e, 0x12, 0xf8, 0x16, 0x16,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Auction retrieves the details of an auction by its ID.
+	Auction(ctx context.Context, in *QueryAuctionRequest, opts ...grpc.CallOption) (*QueryAuctionResponse, error)
+	// OwnerAuctions retrieves all auctions owned by an address.
+	OwnerAuctions(ctx context.Context, in *QueryOwnerAuctionsRequest, opts ...grpc.CallOption) (*QueryOwnerAuctionsResponse, error)
+	// AuctionBids retrieves a paginated list of the bids placed on an auction in the order they were accepted.
+	AuctionBids(ctx context.Context, in *QueryAuctionBidsRequest, opts ...grpc.CallOption) (*QueryAuctionBidsResponse, error)
+	// NextMinimumBid retrieves the lowest bid an auction will currently accept.
+	NextMinimumBid(ctx context.Context, in *QueryNextMinimumBidRequest, opts ...grpc.CallOption) (*QueryNextMinimumBidResponse, error)
+	// BidderAuctions retrieves a paginated list of auctions an address has bid on, along with its bids.
+	BidderAuctions(ctx context.Context, in *QueryBidderAuctionsRequest, opts ...grpc.CallOption) (*QueryBidderAuctionsResponse, error)
+	// AllAuctions retrieves a paginated list of all auctions.
+	AllAuctions(ctx context.Context, in *QueryAllAuctionsRequest, opts ...grpc.CallOption) (*QueryAllAuctionsResponse, error)
+	// ActiveAuctions retrieves a paginated list of auctions in the active queue.
+	ActiveAuctions(ctx context.Context, in *QueryActiveAuctionsRequest, opts ...grpc.CallOption) (*QueryActiveAuctionsResponse, error)
+	// RevealAuctions retrieves a paginated list of sealed-bid auctions in their reveal phase.
+	RevealAuctions(ctx context.Context, in *QueryRevealAuctionsRequest, opts ...grpc.CallOption) (*QueryRevealAuctionsResponse, error)
+	// ExpiredAuctions retrieves a paginated list of auctions in the expired queue awaiting processing.
+	ExpiredAuctions(ctx context.Context, in *QueryExpiredAuctionsRequest, opts ...grpc.CallOption) (*QueryExpiredAuctionsResponse, error)
+	// PendingAuctions retrieves a paginated list of auctions in the pending queue waiting to be executed.
+	PendingAuctions(ctx context.Context, in *QueryPendingAuctionsRequest, opts ...grpc.CallOption) (*QueryPendingAuctionsResponse, error)
+	// CancelledAuctions retrieves a paginated list of auctions in the cancelled queue.
+	CancelledAuctions(ctx context.Context, in *QueryCancelledAuctionsRequest, opts ...grpc.CallOption) (*QueryCancelledAuctionsResponse, error)
+	// Params retrieves the module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Auction(ctx context.Context, in *QueryAuctionRequest, opts ...grpc.CallOption) (*QueryAuctionResponse, error) {
+	out := new(QueryAuctionResponse)
+	err := c.cc.Invoke(ctx, "/fatal_fruit.auction.v1.Query/Auction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OwnerAuctions(ctx context.Context, in *QueryOwnerAuctionsRequest, opts ...grpc.CallOption) (*QueryOwnerAuctionsResponse, error) {
+	out := new(QueryOwnerAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/fatal_fruit.auction.v1.Query/OwnerAuctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuctionBids(ctx context.Context, in *QueryAuctionBidsRequest, opts ...grpc.CallOption) (*QueryAuctionBidsResponse, error) {
+	out := new(QueryAuctionBidsResponse)
+	err := c.cc.Invoke(ctx, "/fatal_fruit.auction.v1.Query/AuctionBids", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NextMinimumBid(ctx context.Context, in *QueryNextMinimumBidRequest, opts ...grpc.CallOption) (*QueryNextMinimumBidResponse, error) {
+	out := new(QueryNextMinimumBidResponse)
+	err := c.cc.Invoke(ctx, "/fatal_fruit.auction.v1.Query/NextMinimumBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BidderAuctions(ctx context.Context, in *QueryBidderAuctionsRequest, opts ...grpc.CallOption) (*QueryBidderAuctionsResponse, error) {
+	out := new(QueryBidderAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/fatal_fruit.auction.v1.Query/BidderAuctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllAuctions(ctx context.Context, in *QueryAllAuctionsRequest, opts ...grpc.CallOption) (*QueryAllAuctionsResponse, error) {
+	out := new(QueryAllAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/fatal_fruit.auction.v1.Query/AllAuctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ActiveAuctions(ctx context.Context, in *QueryActiveAuctionsRequest, opts ...grpc.CallOption) (*QueryActiveAuctionsResponse, error) {
+	out := new(QueryActiveAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/fatal_fruit.auction.v1.Query/ActiveAuctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RevealAuctions(ctx context.Context, in *QueryRevealAuctionsRequest, opts ...grpc.CallOption) (*QueryRevealAuctionsResponse, error) {
+	out := new(QueryRevealAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/fatal_fruit.auction.v1.Query/RevealAuctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExpiredAuctions(ctx context.Context, in *QueryExpiredAuctionsRequest, opts ...grpc.CallOption) (*QueryExpiredAuctionsResponse, error) {
+	out := new(QueryExpiredAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/fatal_fruit.auction.v1.Query/ExpiredAuctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingAuctions(ctx context.Context, in *QueryPendingAuctionsRequest, opts ...grpc.CallOption) (*QueryPendingAuctionsResponse, error) {
+	out := new(QueryPendingAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/fatal_fruit.auction.v1.Query/PendingAuctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CancelledAuctions(ctx context.Context, in *QueryCancelledAuctionsRequest, opts ...grpc.CallOption) (*QueryCancelledAuctionsResponse, error) {
+	out := new(QueryCancelledAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/fatal_fruit.auction.v1.Query/CancelledAuctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/fatal_fruit.auction.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Auction retrieves the details of an auction by its ID.
+	Auction(context.Context, *QueryAuctionRequest) (*QueryAuctionResponse, error)
+	// OwnerAuctions retrieves all auctions owned by an address.
+	OwnerAuctions(context.Context, *QueryOwnerAuctionsRequest) (*QueryOwnerAuctionsResponse, error)
+	// AuctionBids retrieves a paginated list of the bids placed on an auction in the order they were accepted.
+	AuctionBids(context.Context, *QueryAuctionBidsRequest) (*QueryAuctionBidsResponse, error)
+	// NextMinimumBid retrieves the lowest bid an auction will currently accept.
+	NextMinimumBid(context.Context, *QueryNextMinimumBidRequest) (*QueryNextMinimumBidResponse, error)
+	// BidderAuctions retrieves a paginated list of auctions an address has bid on, along with its bids.
+	BidderAuctions(context.Context, *QueryBidderAuctionsRequest) (*QueryBidderAuctionsResponse, error)
+	// AllAuctions retrieves a paginated list of all auctions.
+	AllAuctions(context.Context, *QueryAllAuctionsRequest) (*QueryAllAuctionsResponse, error)
+	// ActiveAuctions retrieves a paginated list of auctions in the active queue.
+	ActiveAuctions(context.Context, *QueryActiveAuctionsRequest) (*QueryActiveAuctionsResponse, error)
+	// RevealAuctions retrieves a paginated list of sealed-bid auctions in their reveal phase.
+	RevealAuctions(context.Context, *QueryRevealAuctionsRequest) (*QueryRevealAuctionsResponse, error)
+	// ExpiredAuctions retrieves a paginated list of auctions in the expired queue awaiting processing.
+	ExpiredAuctions(context.Context, *QueryExpiredAuctionsRequest) (*QueryExpiredAuctionsResponse, error)
+	// PendingAuctions retrieves a paginated list of auctions in the pending queue waiting to be executed.
+	PendingAuctions(context.Context, *QueryPendingAuctionsRequest) (*QueryPendingAuctionsResponse, error)
+	// CancelledAuctions retrieves a paginated list of auctions in the cancelled queue.
+	CancelledAuctions(context.Context, *QueryCancelledAuctionsRequest) (*QueryCancelledAuctionsResponse, error)
+	// Params retrieves the module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Auction(ctx context.Context, req *QueryAuctionRequest) (*QueryAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Auction not implemented")
+}
+func (*UnimplementedQueryServer) OwnerAuctions(ctx context.Context, req *QueryOwnerAuctionsRequest) (*QueryOwnerAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OwnerAuctions not implemented")
+}
+func (*UnimplementedQueryServer) AuctionBids(ctx context.Context, req *QueryAuctionBidsRequest) (*QueryAuctionBidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuctionBids not implemented")
+}
+func (*UnimplementedQueryServer) NextMinimumBid(ctx context.Context, req *QueryNextMinimumBidRequest) (*QueryNextMinimumBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextMinimumBid not implemented")
+}
+func (*UnimplementedQueryServer) BidderAuctions(ctx context.Context, req *QueryBidderAuctionsRequest) (*QueryBidderAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BidderAuctions not implemented")
+}
+func (*UnimplementedQueryServer) AllAuctions(ctx context.Context, req *QueryAllAuctionsRequest) (*QueryAllAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllAuctions not implemented")
+}
+func (*UnimplementedQueryServer) ActiveAuctions(ctx context.Context, req *QueryActiveAuctionsRequest) (*QueryActiveAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveAuctions not implemented")
+}
+func (*UnimplementedQueryServer) RevealAuctions(ctx context.Context, req *QueryRevealAuctionsRequest) (*QueryRevealAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealAuctions not implemented")
+}
+func (*UnimplementedQueryServer) ExpiredAuctions(ctx context.Context, req *QueryExpiredAuctionsRequest) (*QueryExpiredAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpiredAuctions not implemented")
+}
+func (*UnimplementedQueryServer) PendingAuctions(ctx context.Context, req *QueryPendingAuctionsRequest) (*QueryPendingAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingAuctions not implemented")
+}
+func (*UnimplementedQueryServer) CancelledAuctions(ctx context.Context, req *QueryCancelledAuctionsRequest) (*QueryCancelledAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelledAuctions not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Auction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Auction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fatal_fruit.auction.v1.Query/Auction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Auction(ctx, req.(*QueryAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OwnerAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOwnerAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OwnerAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fatal_fruit.auction.v1.Query/OwnerAuctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OwnerAuctions(ctx, req.(*QueryOwnerAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuctionBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionBidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuctionBids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fatal_fruit.auction.v1.Query/AuctionBids",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuctionBids(ctx, req.(*QueryAuctionBidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextMinimumBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextMinimumBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextMinimumBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fatal_fruit.auction.v1.Query/NextMinimumBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextMinimumBid(ctx, req.(*QueryNextMinimumBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BidderAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBidderAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BidderAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fatal_fruit.auction.v1.Query/BidderAuctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BidderAuctions(ctx, req.(*QueryBidderAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fatal_fruit.auction.v1.Query/AllAuctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllAuctions(ctx, req.(*QueryAllAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ActiveAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActiveAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fatal_fruit.auction.v1.Query/ActiveAuctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActiveAuctions(ctx, req.(*QueryActiveAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RevealAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRevealAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RevealAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fatal_fruit.auction.v1.Query/RevealAuctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RevealAuctions(ctx, req.(*QueryRevealAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExpiredAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExpiredAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExpiredAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fatal_fruit.auction.v1.Query/ExpiredAuctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExpiredAuctions(ctx, req.(*QueryExpiredAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fatal_fruit.auction.v1.Query/PendingAuctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingAuctions(ctx, req.(*QueryPendingAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CancelledAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCancelledAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CancelledAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fatal_fruit.auction.v1.Query/CancelledAuctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CancelledAuctions(ctx, req.(*QueryCancelledAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fatal_fruit.auction.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "fatal_fruit.auction.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Auction",
+			Handler:    _Query_Auction_Handler,
+		},
+		{
+			MethodName: "OwnerAuctions",
+			Handler:    _Query_OwnerAuctions_Handler,
+		},
+		{
+			MethodName: "AuctionBids",
+			Handler:    _Query_AuctionBids_Handler,
+		},
+		{
+			MethodName: "NextMinimumBid",
+			Handler:    _Query_NextMinimumBid_Handler,
+		},
+		{
+			MethodName: "BidderAuctions",
+			Handler:    _Query_BidderAuctions_Handler,
+		},
+		{
+			MethodName: "AllAuctions",
+			Handler:    _Query_AllAuctions_Handler,
+		},
+		{
+			MethodName: "ActiveAuctions",
+			Handler:    _Query_ActiveAuctions_Handler,
+		},
+		{
+			MethodName: "RevealAuctions",
+			Handler:    _Query_RevealAuctions_Handler,
+		},
+		{
+			MethodName: "ExpiredAuctions",
+			Handler:    _Query_ExpiredAuctions_Handler,
+		},
+		{
+			MethodName: "PendingAuctions",
+			Handler:    _Query_PendingAuctions_Handler,
+		},
+		{
+			MethodName: "CancelledAuctions",
+			Handler:    _Query_CancelledAuctions_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "fatal_fruit/auction/v1/query.proto",
+}
+
+func (m *QueryAuctionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Auction != nil {
+		{
+			size, err := m.Auction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOwnerAuctionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOwnerAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnerAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOwnerAuctionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOwnerAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnerAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Auctions) > 0 {
+		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionBidsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionBidsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionBidsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionBidsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionBidsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionBidsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextMinimumBidRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextMinimumBidRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextMinimumBidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextMinimumBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextMinimumBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextMinimumBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MinBid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBidderAuctionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBidderAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBidderAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WinningOnly {
+		i--
+		if m.WinningOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BidderAddress) > 0 {
+		i -= len(m.BidderAddress)
+		copy(dAtA[i:], m.BidderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BidderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBidderAuctionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBidderAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBidderAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Auctions) > 0 {
+		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BidderAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BidderAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BidderAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Auction != nil {
+		{
+			size, err := m.Auction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAuctionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AuctionType) > 0 {
+		i -= len(m.AuctionType)
+		copy(dAtA[i:], m.AuctionType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AuctionType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAuctionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Auctions) > 0 {
+		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveAuctionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveAuctionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Auctions) > 0 {
+		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRevealAuctionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRevealAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRevealAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRevealAuctionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRevealAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRevealAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Auctions) > 0 {
+		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpiredAuctionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpiredAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpiredAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpiredAuctionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpiredAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpiredAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Auctions) > 0 {
+		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingAuctionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingAuctionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Auctions) > 0 {
+		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCancelledAuctionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCancelledAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCancelledAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCancelledAuctionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCancelledAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCancelledAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Auctions) > 0 {
+		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAuctionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Auction != nil {
+		l = m.Auction.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOwnerAuctionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOwnerAuctionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAuctionBidsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuctionBidsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextMinimumBidRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *QueryNextMinimumBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinBid.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBidderAuctionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BidderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.WinningOnly {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBidderAuctionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BidderAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Auction != nil {
+		l = m.Auction.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllAuctionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AuctionType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllAuctionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActiveAuctionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActiveAuctionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRevealAuctionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRevealAuctionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExpiredAuctionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExpiredAuctionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingAuctionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingAuctionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCancelledAuctionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCancelledAuctionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAuctionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Auction == nil {
+				m.Auction = &types.Any{}
+			}
+			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOwnerAuctionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOwnerAuctionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOwnerAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOwnerAuctionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOwnerAuctionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOwnerAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctions = append(m.Auctions, &types.Any{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuctionBidsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionBidsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionBidsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuctionBidsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionBidsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionBidsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, Bid{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextMinimumBidRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextMinimumBidRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextMinimumBidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextMinimumBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextMinimumBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextMinimumBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBidderAuctionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBidderAuctionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBidderAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinningOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WinningOnly = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBidderAuctionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBidderAuctionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBidderAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctions = append(m.Auctions, BidderAuction{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BidderAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BidderAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BidderAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Auction == nil {
+				m.Auction = &types.Any{}
+			}
+			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, &Bid{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAuctionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAuctionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAuctionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAuctionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctions = append(m.Auctions, &types.Any{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveAuctionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveAuctionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveAuctionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveAuctionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctions = append(m.Auctions, &types.Any{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRevealAuctionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRevealAuctionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRevealAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRevealAuctionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRevealAuctionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRevealAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctions = append(m.Auctions, &types.Any{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExpiredAuctionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpiredAuctionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpiredAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExpiredAuctionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpiredAuctionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpiredAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctions = append(m.Auctions, &types.Any{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryAuctionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
-	}
-	return n
-}
-
-func (m *QueryAuctionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Auction != nil {
-		l = m.Auction.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryOwnerAuctionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryOwnerAuctionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Auctions) > 0 {
-		for _, e := range m.Auctions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QueryAllAuctionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryAllAuctionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Auctions) > 0 {
-		for _, e := range m.Auctions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryAuctionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingAuctionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -805,17 +5278,17 @@ func (m *QueryAuctionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAuctionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingAuctionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -825,11 +5298,28 @@ func (m *QueryAuctionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -851,7 +5341,7 @@ func (m *QueryAuctionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAuctionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingAuctionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -874,15 +5364,15 @@ func (m *QueryAuctionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAuctionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingAuctionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -909,10 +5399,44 @@ func (m *QueryAuctionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auction == nil {
-				m.Auction = &types.Any{}
+			m.Auctions = append(m.Auctions, &types.Any{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -937,7 +5461,7 @@ func (m *QueryAuctionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOwnerAuctionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCancelledAuctionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -960,17 +5484,17 @@ func (m *QueryOwnerAuctionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOwnerAuctionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCancelledAuctionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOwnerAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCancelledAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -980,23 +5504,27 @@ func (m *QueryOwnerAuctionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1019,7 +5547,7 @@ func (m *QueryOwnerAuctionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOwnerAuctionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCancelledAuctionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1042,10 +5570,10 @@ func (m *QueryOwnerAuctionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOwnerAuctionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCancelledAuctionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOwnerAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCancelledAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1082,6 +5610,42 @@ func (m *QueryOwnerAuctionsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1103,7 +5667,7 @@ func (m *QueryOwnerAuctionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllAuctionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1126,10 +5690,10 @@ func (m *QueryAllAuctionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllAuctionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1153,7 +5717,7 @@ func (m *QueryAllAuctionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllAuctionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1176,15 +5740,15 @@ func (m *QueryAllAuctionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllAuctionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1211,8 +5775,7 @@ func (m *QueryAllAuctionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Auctions = append(m.Auctions, &types.Any{})
-			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
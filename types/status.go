@@ -0,0 +1,61 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// statusTransitions lists the statuses an auction may move to from each status. CANCELLED
+// and SETTLED are final.
+var statusTransitions = map[AuctionStatus][]AuctionStatus{
+	// Created auctions are started or cancelled by their owner
+	AUCTION_STATUS_CREATED: {AUCTION_STATUS_ACTIVE, AUCTION_STATUS_CANCELLED},
+	// Active auctions expire, close on a winning bid or are cancelled by their owner
+	AUCTION_STATUS_ACTIVE: {AUCTION_STATUS_EXPIRED, AUCTION_STATUS_PENDING, AUCTION_STATUS_CANCELLED},
+	// Expired auctions are executable if they have a winning bid
+	AUCTION_STATUS_EXPIRED: {AUCTION_STATUS_PENDING, AUCTION_STATUS_CANCELLED},
+	// Pending auctions are executed or time out
+	AUCTION_STATUS_PENDING: {AUCTION_STATUS_SETTLED, AUCTION_STATUS_CANCELLED},
+}
+
+// Validate returns an error if the status is not a known auction status.
+func (s AuctionStatus) Validate() error {
+	if _, ok := AuctionStatus_name[int32(s)]; !ok || s == AUCTION_STATUS_UNSPECIFIED {
+		return errorsmod.Wrapf(ErrInvalidStatus, "%d", s)
+	}
+	return nil
+}
+
+// ParseAuctionStatus returns the auction status with the given name. The AUCTION_STATUS_
+// prefix may be omitted, so ACTIVE and AUCTION_STATUS_ACTIVE are the same status.
+func ParseAuctionStatus(name string) (AuctionStatus, error) {
+	name = strings.ToUpper(name)
+	if !strings.HasPrefix(name, "AUCTION_STATUS_") {
+		name = "AUCTION_STATUS_" + name
+	}
+
+	s := AuctionStatus(AuctionStatus_value[name])
+	if err := s.Validate(); err != nil {
+		return AUCTION_STATUS_UNSPECIFIED, errorsmod.Wrapf(ErrInvalidStatus, "%s", name)
+	}
+	return s, nil
+}
+
+// ValidateStatusTransition returns an ErrInvalidTransition if an auction may not move from
+// one status to the other.
+func ValidateStatusTransition(from, to AuctionStatus) error {
+	if err := from.Validate(); err != nil {
+		return err
+	}
+	if err := to.Validate(); err != nil {
+		return err
+	}
+
+	for _, next := range statusTransitions[from] {
+		if next == to {
+			return nil
+		}
+	}
+	return errorsmod.Wrapf(ErrInvalidTransition, "%s -> %s", from, to)
+}
@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+
+	auctiontypes "github.com/fatal-fruit/auction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateStatusTransition(t *testing.T) {
+	testCases := []struct {
+		name   string
+		from   auctiontypes.AuctionStatus
+		to     auctiontypes.AuctionStatus
+		expErr error
+	}{
+		{"start", auctiontypes.CREATED, auctiontypes.ACTIVE, nil},
+		{"cancel created", auctiontypes.CREATED, auctiontypes.CANCELLED, nil},
+		{"expire", auctiontypes.ACTIVE, auctiontypes.EXPIRED, nil},
+		{"close on bid", auctiontypes.ACTIVE, auctiontypes.PENDING, nil},
+		{"cancel active", auctiontypes.ACTIVE, auctiontypes.CANCELLED, nil},
+		{"expired with bids", auctiontypes.EXPIRED, auctiontypes.PENDING, nil},
+		{"expired without bids", auctiontypes.EXPIRED, auctiontypes.CANCELLED, nil},
+		{"execute", auctiontypes.PENDING, auctiontypes.SETTLED, nil},
+		{"execution timeout", auctiontypes.PENDING, auctiontypes.CANCELLED, nil},
+		{"settle created", auctiontypes.CREATED, auctiontypes.SETTLED, auctiontypes.ErrInvalidTransition},
+		{"restart", auctiontypes.ACTIVE, auctiontypes.ACTIVE, auctiontypes.ErrInvalidTransition},
+		{"settle active", auctiontypes.ACTIVE, auctiontypes.SETTLED, auctiontypes.ErrInvalidTransition},
+		{"reactivate expired", auctiontypes.EXPIRED, auctiontypes.ACTIVE, auctiontypes.ErrInvalidTransition},
+		{"leave cancelled", auctiontypes.CANCELLED, auctiontypes.ACTIVE, auctiontypes.ErrInvalidTransition},
+		{"leave settled", auctiontypes.SETTLED, auctiontypes.CANCELLED, auctiontypes.ErrInvalidTransition},
+		{"unspecified from", auctiontypes.AUCTION_STATUS_UNSPECIFIED, auctiontypes.ACTIVE, auctiontypes.ErrInvalidStatus},
+		{"unknown to", auctiontypes.PENDING, auctiontypes.AuctionStatus(42), auctiontypes.ErrInvalidStatus},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := auctiontypes.ValidateStatusTransition(tc.from, tc.to)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParseAuctionStatus(t *testing.T) {
+	for _, name := range []string{"ACTIVE", "active", "AUCTION_STATUS_ACTIVE"} {
+		s, err := auctiontypes.ParseAuctionStatus(name)
+		require.NoError(t, err)
+		require.Equal(t, auctiontypes.ACTIVE, s)
+	}
+
+	for _, name := range []string{"CLOSED", "UNSPECIFIED", "AUCTION_STATUS_"} {
+		_, err := auctiontypes.ParseAuctionStatus(name)
+		require.ErrorIs(t, err, auctiontypes.ErrInvalidStatus)
+	}
+}
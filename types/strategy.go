@@ -0,0 +1,90 @@
+package types
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// Strategy is the settlement strategy of an auction. Strategies are stored on the auction
+// as Anys and selected by their type URL.
+type Strategy interface {
+	proto.Message
+
+	// GetEscrowContractId returns the id of the escrow contract holding the auction's bids.
+	GetEscrowContractId() uint64
+	// GetEscrowContractAddress returns the address of the escrow contract holding the
+	// auction's bids.
+	GetEscrowContractAddress() string
+}
+
+// StrategyHandler creates and executes a settlement strategy type. Auction handlers
+// escrow bids; the strategy decides what the winners pay and where the payment goes.
+type StrategyHandler interface {
+	// CreateStrategy initializes the strategy selected for a new auction and creates the
+	// escrow contract that will hold its bids.
+	CreateStrategy(ctx context.Context, auctionId uint64, s Strategy) (Strategy, error)
+	// SettlementPrice returns the amount the winning bidder pays.
+	SettlementPrice(a Auction, s Strategy, winningBid *Bid, bids []*Bid, reservePrice sdk.Coin) sdk.Coin
+	// Settle pays out the settlement price held in escrow when the auction is executed.
+	Settle(ctx context.Context, a Auction, s Strategy, price sdk.Coin, bids []*Bid) error
+}
+
+type StrategyResolver interface {
+	AddType(key string, h StrategyHandler) (rsv StrategyResolver)
+	HasType(key string) bool
+	GetHandler(key string) (h StrategyHandler)
+	Seal()
+}
+
+type strategyResolver struct {
+	handlers map[string]StrategyHandler
+	sealed   bool
+}
+
+// NewStrategyResolver creates a new Strategy Resolver interface instance
+func NewStrategyResolver() StrategyResolver {
+	return &strategyResolver{
+		handlers: make(map[string]StrategyHandler),
+	}
+}
+
+// Seal seals the resolver which prohibits any additional strategy types to be
+// registered. Seal panics if called more than once.
+func (sr *strategyResolver) Seal() {
+	if sr.sealed {
+		panic("strategy resolver already sealed")
+	}
+	sr.sealed = true
+}
+
+// AddType adds a strategy type and its handler. It returns the Strategy Resolver so
+// AddType calls can be chained so long as it has not already been sealed.
+func (sr *strategyResolver) AddType(key string, h StrategyHandler) StrategyResolver {
+	if sr.sealed {
+		panic("strategy resolver sealed; cannot add strategy type handler")
+	}
+
+	if sr.HasType(key) {
+		panic(fmt.Sprintf("strategy type %s has already been initialized", key))
+	}
+
+	sr.handlers[key] = h
+	return sr
+}
+
+// HasType returns true if the strategy type handler has been registered.
+func (sr *strategyResolver) HasType(key string) bool {
+	return sr.handlers[key] != nil
+}
+
+// GetHandler returns the strategy type handler for a given key.
+func (sr *strategyResolver) GetHandler(key string) StrategyHandler {
+	if !sr.HasType(key) {
+		panic(fmt.Sprintf("strategy type handler \"%s\" does not exist", key))
+	}
+
+	return sr.handlers[key]
+}
@@ -441,6 +441,116 @@ func (m *MsgNewBidResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgNewBidResponse proto.InternalMessageInfo
 
+// MsgRevealBid represents a request to reveal a sealed bid.
+type MsgRevealBid struct {
+	// bidder is the address that placed the sealed bid.
+	Bidder string `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// auction_id is the unique identifier of the auction the bid was placed on.
+	AuctionId uint64 `protobuf:"varint,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// amount is the bid amount the commitment was made to.
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// salt is the secret used when computing the commitment.
+	Salt []byte `protobuf:"bytes,4,opt,name=salt,proto3" json:"salt,omitempty"`
+}
+
+func (m *MsgRevealBid) Reset()         { *m = MsgRevealBid{} }
+func (m *MsgRevealBid) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealBid) ProtoMessage()    {}
+func (*MsgRevealBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_885159ca31442fc0, []int{8}
+}
+func (m *MsgRevealBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealBid.Merge(m, src)
+}
+func (m *MsgRevealBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealBid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealBid proto.InternalMessageInfo
+
+func (m *MsgRevealBid) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
+}
+
+func (m *MsgRevealBid) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
+}
+
+func (m *MsgRevealBid) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *MsgRevealBid) GetSalt() []byte {
+	if m != nil {
+		return m.Salt
+	}
+	return nil
+}
+
+// MsgRevealBidResponse defines the response for a successful bid reveal.
+type MsgRevealBidResponse struct {
+}
+
+func (m *MsgRevealBidResponse) Reset()         { *m = MsgRevealBidResponse{} }
+func (m *MsgRevealBidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealBidResponse) ProtoMessage()    {}
+func (*MsgRevealBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_885159ca31442fc0, []int{9}
+}
+func (m *MsgRevealBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealBidResponse.Merge(m, src)
+}
+func (m *MsgRevealBidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealBidResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealBidResponse proto.InternalMessageInfo
+
 // MsgExecAuction represents a request to execute an auction.
 type MsgExecAuction struct {
 	// sender is the address of the request initiator.
@@ -453,7 +563,7 @@ func (m *MsgExecAuction) Reset()         { *m = MsgExecAuction{} }
 func (m *MsgExecAuction) String() string { return proto.CompactTextString(m) }
 func (*MsgExecAuction) ProtoMessage()    {}
 func (*MsgExecAuction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_885159ca31442fc0, []int{8}
+	return fileDescriptor_885159ca31442fc0, []int{10}
 }
 func (m *MsgExecAuction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -504,7 +614,7 @@ func (m *MsgExecAuctionResponse) Reset()         { *m = MsgExecAuctionResponse{}
 func (m *MsgExecAuctionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExecAuctionResponse) ProtoMessage()    {}
 func (*MsgExecAuctionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_885159ca31442fc0, []int{9}
+	return fileDescriptor_885159ca31442fc0, []int{11}
 }
 func (m *MsgExecAuctionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -533,6 +643,98 @@ func (m *MsgExecAuctionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgExecAuctionResponse proto.InternalMessageInfo
 
+// MsgUpdateParams represents a request to update the module parameters.
+type MsgUpdateParams struct {
+	// authority is the address that controls the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the module parameters to update. All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_885159ca31442fc0, []int{12}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response for a successful parameter update.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_885159ca31442fc0, []int{13}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgNewAuction)(nil), "fatal_fruit.auction.v1.MsgNewAuction")
 	proto.RegisterType((*MsgNewAuctionResponse)(nil), "fatal_fruit.auction.v1.MsgNewAuctionResponse")
@@ -542,59 +744,74 @@ func init() {
 	proto.RegisterType((*MsgCancelAuctionResponse)(nil), "fatal_fruit.auction.v1.MsgCancelAuctionResponse")
 	proto.RegisterType((*MsgNewBid)(nil), "fatal_fruit.auction.v1.MsgNewBid")
 	proto.RegisterType((*MsgNewBidResponse)(nil), "fatal_fruit.auction.v1.MsgNewBidResponse")
+	proto.RegisterType((*MsgRevealBid)(nil), "fatal_fruit.auction.v1.MsgRevealBid")
+	proto.RegisterType((*MsgRevealBidResponse)(nil), "fatal_fruit.auction.v1.MsgRevealBidResponse")
 	proto.RegisterType((*MsgExecAuction)(nil), "fatal_fruit.auction.v1.MsgExecAuction")
 	proto.RegisterType((*MsgExecAuctionResponse)(nil), "fatal_fruit.auction.v1.MsgExecAuctionResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "fatal_fruit.auction.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "fatal_fruit.auction.v1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("fatal_fruit/auction/v1/tx.proto", fileDescriptor_885159ca31442fc0) }
 
 var fileDescriptor_885159ca31442fc0 = []byte{
-	// 716 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x4f, 0x13, 0x4d,
-	0x1c, 0xee, 0xb6, 0x85, 0x37, 0xfd, 0x95, 0x97, 0x3f, 0xfb, 0xf2, 0x42, 0xd9, 0xc4, 0x02, 0x8d,
-	0x42, 0x6d, 0xd2, 0x59, 0x8b, 0x37, 0x0e, 0x26, 0x2d, 0xd1, 0xc4, 0x43, 0x8d, 0x29, 0xc6, 0x83,
-	0x89, 0x36, 0xb3, 0x9d, 0x61, 0x98, 0xd0, 0xee, 0x34, 0x3b, 0x5b, 0xa0, 0xf1, 0x42, 0xfc, 0x04,
-	0xc6, 0xa3, 0x9f, 0xc0, 0x78, 0xe2, 0xc0, 0xcd, 0x2f, 0x40, 0x38, 0x11, 0x4f, 0x9c, 0xd4, 0xc0,
-	0x81, 0xaf, 0x61, 0x76, 0x77, 0x76, 0xd3, 0x45, 0x29, 0xd5, 0x03, 0x97, 0x76, 0xf7, 0x37, 0xcf,
-	0xef, 0xf7, 0x3c, 0xf3, 0xcc, 0x33, 0x2d, 0x2c, 0x6e, 0x61, 0x17, 0xb7, 0x9b, 0x5b, 0x4e, 0x8f,
-	0xbb, 0x26, 0xee, 0xb5, 0x5c, 0x2e, 0x6c, 0x73, 0xb7, 0x62, 0xba, 0xfb, 0xa8, 0xeb, 0x08, 0x57,
-	0xe8, 0x73, 0x03, 0x00, 0xa4, 0x00, 0x68, 0xb7, 0x62, 0xcc, 0xb7, 0x84, 0xec, 0x08, 0x69, 0x76,
-	0x24, 0xf3, 0xf0, 0x1d, 0xc9, 0x82, 0x06, 0x63, 0x96, 0x09, 0x26, 0xfc, 0x47, 0xd3, 0x7b, 0x52,
-	0xd5, 0xbc, 0x82, 0x5b, 0x58, 0x52, 0x73, 0xb7, 0x62, 0x51, 0x17, 0x57, 0xcc, 0x96, 0xe0, 0xb6,
-	0x5a, 0x9f, 0xc1, 0x1d, 0x6e, 0x0b, 0xd3, 0xff, 0x54, 0xa5, 0xc2, 0x75, 0xd2, 0xfa, 0x5d, 0x2a,
-	0x15, 0x66, 0x21, 0x18, 0xdb, 0x0c, 0xf8, 0x82, 0x97, 0x70, 0x89, 0x09, 0xc1, 0xda, 0xd4, 0xf4,
-	0xdf, 0xac, 0xde, 0x96, 0x89, 0xed, 0x7e, 0x28, 0xe6, 0xea, 0x12, 0xe9, 0x39, 0xd8, 0xdf, 0x98,
-	0x5f, 0x29, 0x9c, 0x25, 0xe1, 0xdf, 0xba, 0x64, 0xcf, 0xe8, 0x5e, 0x35, 0xa0, 0xd5, 0x11, 0x8c,
-	0x89, 0x3d, 0x9b, 0x3a, 0x39, 0x6d, 0x49, 0x2b, 0x66, 0x6a, 0xb9, 0xaf, 0x47, 0xe5, 0x59, 0xc5,
-	0x56, 0x25, 0xc4, 0xa1, 0x52, 0x6e, 0xba, 0x0e, 0xb7, 0x59, 0x23, 0x80, 0xe9, 0xcb, 0x30, 0xa1,
-	0x14, 0x37, 0x3d, 0xb9, 0xb9, 0xa4, 0xd7, 0xd6, 0xc8, 0xaa, 0xda, 0x8b, 0x7e, 0x97, 0xea, 0x6f,
-	0xe1, 0x1f, 0x42, 0xbb, 0x42, 0x72, 0x37, 0x97, 0x5a, 0x4a, 0x15, 0xb3, 0x6b, 0x0b, 0x48, 0x4d,
-	0xf4, 0x3c, 0x42, 0xca, 0x23, 0xb4, 0x21, 0xb8, 0x5d, 0x7b, 0x72, 0xfc, 0x6d, 0x31, 0xf1, 0xf9,
-	0xfb, 0x62, 0x91, 0x71, 0x77, 0xbb, 0x67, 0xa1, 0x96, 0xe8, 0xa8, 0xcd, 0xaa, 0xaf, 0xb2, 0x24,
-	0x3b, 0xca, 0x18, 0xaf, 0x41, 0x7e, 0xbc, 0x3c, 0x2c, 0x4d, 0xb4, 0x29, 0xc3, 0xad, 0x7e, 0xd3,
-	0x73, 0x59, 0x7e, 0xba, 0x3c, 0x2c, 0x69, 0x8d, 0x90, 0x51, 0xdf, 0x81, 0xe9, 0x50, 0x5f, 0x87,
-	0xba, 0x98, 0x60, 0x17, 0xe7, 0xd2, 0x4b, 0x5a, 0x31, 0xbb, 0x36, 0x8b, 0x02, 0x73, 0x50, 0x68,
-	0x0e, 0xaa, 0xda, 0xfd, 0x5a, 0xe9, 0xe4, 0xa8, 0xbc, 0xf2, 0xfb, 0x24, 0x20, 0xe5, 0x51, 0x5d,
-	0xcd, 0x69, 0x4c, 0xe1, 0x78, 0x61, 0x1d, 0xde, 0x5d, 0x1e, 0x96, 0x02, 0x63, 0x0a, 0xab, 0xf0,
-	0x7f, 0xcc, 0xd9, 0x06, 0x95, 0x5d, 0x61, 0x4b, 0xaa, 0x4f, 0x42, 0x92, 0x13, 0xdf, 0xde, 0x74,
-	0x23, 0xc9, 0x49, 0xe1, 0x35, 0x4c, 0xd5, 0x25, 0xdb, 0x74, 0xb1, 0xe3, 0xfe, 0xed, 0x21, 0x04,
-	0x23, 0x93, 0xe1, 0xc8, 0x98, 0x8e, 0x05, 0x98, 0xbf, 0x32, 0x3e, 0x54, 0x52, 0xe8, 0xc2, 0x74,
-	0x5d, 0xb2, 0x0d, 0x6c, 0xb7, 0x68, 0x3b, 0xa4, 0x7e, 0x00, 0xe3, 0x92, 0xda, 0x64, 0x04, 0x6e,
-	0x85, 0xd3, 0xef, 0x00, 0x84, 0x0e, 0x47, 0x22, 0x32, 0xaa, 0xf2, 0x94, 0xac, 0x67, 0x3d, 0x2d,
-	0x0a, 0x5b, 0x30, 0x20, 0x77, 0x95, 0x31, 0x52, 0xf3, 0x25, 0x09, 0x99, 0xc0, 0xb1, 0x1a, 0x27,
-	0x7f, 0x6c, 0xc1, 0x70, 0x15, 0xfa, 0x81, 0x06, 0x60, 0x71, 0xd2, 0xc4, 0x1d, 0xd1, 0xb3, 0xbd,
-	0x1c, 0x6a, 0xb7, 0x93, 0xc3, 0x8c, 0xc5, 0x49, 0xd5, 0xe7, 0xd4, 0x9f, 0x43, 0xfa, 0xc6, 0xf4,
-	0xad, 0x9c, 0x1c, 0x95, 0x0b, 0xd7, 0xa4, 0xaf, 0xc6, 0x49, 0x94, 0xbc, 0xf4, 0x2f, 0x71, 0xfb,
-	0x0f, 0x66, 0x22, 0xf3, 0x22, 0x4b, 0x6d, 0x98, 0xac, 0x4b, 0xf6, 0x78, 0x9f, 0xb6, 0x6e, 0xe7,
-	0x78, 0x73, 0x30, 0x17, 0xe7, 0x0b, 0x95, 0xac, 0x7d, 0x48, 0x41, 0xaa, 0x2e, 0x99, 0x6e, 0x01,
-	0x0c, 0xfc, 0xd8, 0xdc, 0x43, 0xd7, 0xec, 0x35, 0x76, 0x73, 0x8c, 0xf2, 0x48, 0xb0, 0xe8, 0x82,
-	0x6d, 0xc3, 0x44, 0xec, 0x36, 0xad, 0x0e, 0x69, 0x1f, 0x04, 0x1a, 0xe6, 0x88, 0xc0, 0x88, 0xe9,
-	0x25, 0x8c, 0xab, 0xb8, 0x2e, 0x0f, 0x97, 0x58, 0xe3, 0xc4, 0xb8, 0x7f, 0x23, 0x24, 0x9a, 0xfb,
-	0x06, 0xd2, 0x9e, 0x89, 0xfa, 0xca, 0x90, 0x96, 0x01, 0x97, 0x0d, 0x34, 0x1a, 0x2e, 0x9c, 0x6f,
-	0x8c, 0x1d, 0x78, 0xe1, 0xac, 0x3d, 0x3a, 0x3e, 0xcf, 0x6b, 0xa7, 0xe7, 0x79, 0xed, 0xc7, 0x79,
-	0x5e, 0x7b, 0x7f, 0x91, 0x4f, 0x9c, 0x5e, 0xe4, 0x13, 0x67, 0x17, 0xf9, 0xc4, 0xab, 0xbb, 0x03,
-	0xb1, 0xf7, 0x47, 0x97, 0xe3, 0x7f, 0x4e, 0x7e, 0xf0, 0xad, 0x71, 0x3f, 0xbb, 0x0f, 0x7f, 0x06,
-	0x00, 0x00, 0xff, 0xff, 0x12, 0xed, 0x9c, 0x1d, 0x5b, 0x07, 0x00, 0x00,
+	// 891 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x41, 0x6f, 0xe3, 0x44,
+	0x18, 0xad, 0xd3, 0x34, 0x28, 0x5f, 0xb2, 0xdd, 0x5d, 0x53, 0xba, 0xa9, 0x25, 0xdc, 0xae, 0x59,
+	0xba, 0xa1, 0x22, 0x76, 0x5b, 0x24, 0x0e, 0x3d, 0x20, 0xc5, 0x2b, 0x90, 0x38, 0x04, 0xad, 0xbc,
+	0xc0, 0x01, 0x09, 0xa2, 0x71, 0x66, 0xea, 0x8e, 0x1a, 0x7b, 0x2c, 0xcf, 0xa4, 0xbb, 0x11, 0x97,
+	0x15, 0xbf, 0x80, 0x33, 0xe2, 0x07, 0x20, 0x4e, 0x3d, 0xf4, 0xc6, 0x1f, 0x58, 0xf5, 0xb4, 0xe2,
+	0xb4, 0x27, 0x40, 0xed, 0xa1, 0x3f, 0x82, 0x0b, 0xb2, 0x3d, 0x76, 0xed, 0xd2, 0xb8, 0x81, 0x43,
+	0x2f, 0xad, 0x3d, 0x7e, 0xf3, 0xbd, 0x37, 0xef, 0x7b, 0xfe, 0x1c, 0x58, 0xdf, 0x47, 0x02, 0x8d,
+	0x87, 0xfb, 0xd1, 0x84, 0x0a, 0x0b, 0x4d, 0x46, 0x82, 0xb2, 0xc0, 0x3a, 0xda, 0xb1, 0xc4, 0x0b,
+	0x33, 0x8c, 0x98, 0x60, 0xea, 0x6a, 0x01, 0x60, 0x4a, 0x80, 0x79, 0xb4, 0xa3, 0x3d, 0x18, 0x31,
+	0xee, 0x33, 0x6e, 0xf9, 0xdc, 0x8b, 0xf1, 0x3e, 0xf7, 0xd2, 0x0d, 0xda, 0x8a, 0xc7, 0x3c, 0x96,
+	0x5c, 0x5a, 0xf1, 0x95, 0x5c, 0xd5, 0x25, 0xdc, 0x45, 0x9c, 0x58, 0x47, 0x3b, 0x2e, 0x11, 0x68,
+	0xc7, 0x1a, 0x31, 0x1a, 0xc8, 0xe7, 0xf7, 0x91, 0x4f, 0x03, 0x66, 0x25, 0x7f, 0xe5, 0x92, 0x31,
+	0x4b, 0xda, 0x34, 0x24, 0x5c, 0x62, 0xde, 0x9b, 0x81, 0x09, 0x51, 0x84, 0xfc, 0x0c, 0xb4, 0x96,
+	0x72, 0x0f, 0x53, 0x51, 0xe9, 0x4d, 0xf6, 0xc8, 0x63, 0xcc, 0x1b, 0x13, 0x2b, 0xb9, 0x73, 0x27,
+	0xfb, 0x16, 0x0a, 0xa6, 0x99, 0xe2, 0xab, 0x8f, 0xf0, 0x24, 0x42, 0xc9, 0xe9, 0x93, 0x15, 0xe3,
+	0x4d, 0x0d, 0xee, 0x0c, 0xb8, 0xf7, 0x05, 0x79, 0xde, 0x4f, 0x79, 0x55, 0x13, 0x96, 0xd8, 0xf3,
+	0x80, 0x44, 0x1d, 0x65, 0x43, 0xe9, 0x36, 0xed, 0xce, 0xef, 0x27, 0xbd, 0x15, 0xc9, 0xd6, 0xc7,
+	0x38, 0x22, 0x9c, 0x3f, 0x13, 0x11, 0x0d, 0x3c, 0x27, 0x85, 0xa9, 0x0f, 0xa1, 0x2d, 0x25, 0x0f,
+	0xe3, 0x33, 0x75, 0x6a, 0xf1, 0x36, 0xa7, 0x25, 0xd7, 0xbe, 0x9c, 0x86, 0x44, 0xfd, 0x1e, 0xde,
+	0xc2, 0x24, 0x64, 0x9c, 0x8a, 0xce, 0xe2, 0xc6, 0x62, 0xb7, 0xb5, 0xbb, 0x66, 0xca, 0x8a, 0xb1,
+	0x91, 0xa6, 0x34, 0xd2, 0x7c, 0xc2, 0x68, 0x60, 0x7f, 0xf6, 0xea, 0x8f, 0xf5, 0x85, 0x5f, 0xff,
+	0x5c, 0xef, 0x7a, 0x54, 0x1c, 0x4c, 0x5c, 0x73, 0xc4, 0x7c, 0x79, 0x58, 0xf9, 0xaf, 0xc7, 0xf1,
+	0xa1, 0x74, 0x2f, 0xde, 0xc0, 0x7f, 0xba, 0x38, 0xde, 0x6a, 0x8f, 0x89, 0x87, 0x46, 0xd3, 0x61,
+	0xdc, 0x0a, 0xfe, 0xcb, 0xc5, 0xf1, 0x96, 0xe2, 0x64, 0x8c, 0xea, 0x21, 0xdc, 0xcb, 0xf4, 0xf9,
+	0x44, 0x20, 0x8c, 0x04, 0xea, 0xd4, 0x37, 0x94, 0x6e, 0x6b, 0x77, 0xc5, 0x4c, 0xcd, 0x31, 0x33,
+	0x73, 0xcc, 0x7e, 0x30, 0xb5, 0xb7, 0x4e, 0x4f, 0x7a, 0x9b, 0xd7, 0xc7, 0xc5, 0x94, 0x1e, 0x0d,
+	0x64, 0x1d, 0xe7, 0x2e, 0x2a, 0x2f, 0xec, 0xc1, 0x0f, 0x17, 0xc7, 0x5b, 0xa9, 0x31, 0xc6, 0x63,
+	0x78, 0xa7, 0xe4, 0xac, 0x43, 0x78, 0xc8, 0x02, 0x4e, 0xd4, 0x65, 0xa8, 0x51, 0x9c, 0xd8, 0x5b,
+	0x77, 0x6a, 0x14, 0x1b, 0xdf, 0xc2, 0xdd, 0x01, 0xf7, 0x9e, 0x09, 0x14, 0x89, 0xff, 0xdb, 0x84,
+	0xb4, 0x64, 0x2d, 0x2b, 0x59, 0xd2, 0xb1, 0x06, 0x0f, 0xae, 0x94, 0xcf, 0x94, 0x18, 0x21, 0xdc,
+	0x1b, 0x70, 0xef, 0x09, 0x0a, 0x46, 0x64, 0x9c, 0x51, 0x6f, 0x43, 0x83, 0x93, 0x00, 0xcf, 0xc1,
+	0x2d, 0x71, 0xea, 0xbb, 0x00, 0x99, 0xc3, 0xb9, 0x88, 0xa6, 0x5c, 0xf9, 0x1c, 0xef, 0xb5, 0x62,
+	0x2d, 0x12, 0x6b, 0x68, 0xd0, 0xb9, 0xca, 0x98, 0xab, 0xf9, 0xad, 0x06, 0xcd, 0xd4, 0x31, 0x9b,
+	0xe2, 0xff, 0x6c, 0x41, 0xb5, 0x0a, 0xf5, 0xa5, 0x02, 0xe0, 0x52, 0x3c, 0x44, 0x3e, 0x9b, 0x04,
+	0x71, 0x0e, 0x95, 0xdb, 0xc9, 0x61, 0xd3, 0xa5, 0xb8, 0x9f, 0x70, 0xaa, 0x4f, 0xa1, 0x7e, 0x63,
+	0xfa, 0x36, 0x4f, 0x4f, 0x7a, 0xc6, 0x8c, 0xf4, 0xd9, 0x14, 0xe7, 0xc9, 0xab, 0xff, 0x2b, 0x6e,
+	0x6f, 0xc3, 0xfd, 0xdc, 0xbc, 0xdc, 0xd2, 0xbf, 0x15, 0x68, 0x0f, 0xb8, 0xe7, 0x90, 0x23, 0x82,
+	0xc6, 0xb1, 0xab, 0xdb, 0xd0, 0x70, 0x29, 0x9e, 0xab, 0xbb, 0x29, 0xee, 0x26, 0x5f, 0xa7, 0xd0,
+	0xb8, 0x6d, 0x4b, 0x25, 0xa1, 0xaa, 0x42, 0x9d, 0xa3, 0xb1, 0x48, 0xfc, 0x6c, 0x3b, 0xc9, 0xb5,
+	0x0c, 0x5b, 0x2a, 0xdd, 0x58, 0x85, 0x95, 0xe2, 0xe1, 0x73, 0x57, 0x02, 0x58, 0x1e, 0x70, 0xef,
+	0xd3, 0x17, 0x64, 0x74, 0x3b, 0xa1, 0xef, 0xc0, 0x6a, 0x99, 0x2f, 0x57, 0xf2, 0xb3, 0x92, 0xbc,
+	0xfb, 0x5f, 0x85, 0x18, 0x09, 0xf2, 0x34, 0x19, 0xf7, 0xea, 0xc7, 0xd0, 0x44, 0x13, 0x71, 0xc0,
+	0x22, 0x2a, 0xa6, 0x37, 0xca, 0xb9, 0x84, 0xaa, 0x7d, 0x68, 0xa4, 0x1f, 0x8c, 0x44, 0x4d, 0x6b,
+	0x57, 0x37, 0x67, 0xe4, 0x28, 0xe5, 0xb1, 0x9b, 0x71, 0x3b, 0xa4, 0xa3, 0xe9, 0xc6, 0xbd, 0xe5,
+	0x58, 0xf5, 0x65, 0x49, 0x39, 0x3a, 0x8a, 0xea, 0x32, 0xe5, 0xbb, 0xa7, 0x4b, 0xb0, 0x38, 0xe0,
+	0x9e, 0xea, 0x02, 0x14, 0x3e, 0x1e, 0xef, 0xcf, 0xe2, 0x2c, 0x4d, 0x42, 0xad, 0x37, 0x17, 0x2c,
+	0x1f, 0x98, 0x07, 0xd0, 0x2e, 0x4d, 0xc7, 0xc7, 0x15, 0xdb, 0x8b, 0x40, 0xcd, 0x9a, 0x13, 0x98,
+	0x33, 0x1d, 0xc2, 0x9d, 0xf2, 0x34, 0xec, 0x56, 0x54, 0x28, 0x21, 0xb5, 0xed, 0x79, 0x91, 0x39,
+	0xd9, 0xd7, 0xd0, 0x90, 0xb3, 0xee, 0x61, 0xb5, 0x1f, 0x36, 0xc5, 0xda, 0x07, 0x37, 0x42, 0xf2,
+	0xba, 0x43, 0x68, 0x5e, 0xbe, 0xf0, 0x8f, 0x2a, 0xf6, 0xe5, 0x28, 0xed, 0xc3, 0x79, 0x50, 0x39,
+	0xc1, 0x77, 0x50, 0x8f, 0xc3, 0xac, 0x6e, 0x56, 0xec, 0x2a, 0xa4, 0x5d, 0x33, 0xe7, 0xc3, 0x15,
+	0xfb, 0x5d, 0x7a, 0x23, 0xaa, 0xfa, 0x5d, 0x04, 0x56, 0xf6, 0xfb, 0xba, 0x14, 0x6b, 0x4b, 0x2f,
+	0xe3, 0xfc, 0xdb, 0x9f, 0xbc, 0x3a, 0xd3, 0x95, 0xd7, 0x67, 0xba, 0xf2, 0xd7, 0x99, 0xae, 0xfc,
+	0x78, 0xae, 0x2f, 0xbc, 0x3e, 0xd7, 0x17, 0xde, 0x9c, 0xeb, 0x0b, 0xdf, 0x3c, 0x2a, 0xcc, 0xaa,
+	0xa4, 0x76, 0xaf, 0xfc, 0x2b, 0x2d, 0x99, 0x56, 0x6e, 0x23, 0x99, 0xe1, 0x1f, 0xfd, 0x13, 0x00,
+	0x00, 0xff, 0xff, 0x4d, 0x80, 0xc2, 0x00, 0x88, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -613,10 +830,16 @@ type MsgClient interface {
 	NewAuction(ctx context.Context, in *MsgNewAuction, opts ...grpc.CallOption) (*MsgNewAuctionResponse, error)
 	// StartAuction initializes the auction
 	StartAuction(ctx context.Context, in *MsgStartAuction, opts ...grpc.CallOption) (*MsgStartAuctionResponse, error)
+	// CancelAuction cancels an active auction that has not received any bids.
+	CancelAuction(ctx context.Context, in *MsgCancelAuction, opts ...grpc.CallOption) (*MsgCancelAuctionResponse, error)
 	// NewBid places a new bid on an auction.
 	NewBid(ctx context.Context, in *MsgNewBid, opts ...grpc.CallOption) (*MsgNewBidResponse, error)
+	// RevealBid reveals a sealed bid during the reveal phase of a sealed-bid auction.
+	RevealBid(ctx context.Context, in *MsgRevealBid, opts ...grpc.CallOption) (*MsgRevealBidResponse, error)
 	// Exec executes an auction, distributing funds and finalizing the auction.
 	Exec(ctx context.Context, in *MsgExecAuction, opts ...grpc.CallOption) (*MsgExecAuctionResponse, error)
+	// UpdateParams updates the module parameters. It can only be called by the module authority.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -645,6 +868,15 @@ func (c *msgClient) StartAuction(ctx context.Context, in *MsgStartAuction, opts
 	return out, nil
 }
 
+func (c *msgClient) CancelAuction(ctx context.Context, in *MsgCancelAuction, opts ...grpc.CallOption) (*MsgCancelAuctionResponse, error) {
+	out := new(MsgCancelAuctionResponse)
+	err := c.cc.Invoke(ctx, "/fatal_fruit.auction.v1.Msg/CancelAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) NewBid(ctx context.Context, in *MsgNewBid, opts ...grpc.CallOption) (*MsgNewBidResponse, error) {
 	out := new(MsgNewBidResponse)
 	err := c.cc.Invoke(ctx, "/fatal_fruit.auction.v1.Msg/NewBid", in, out, opts...)
@@ -654,6 +886,15 @@ func (c *msgClient) NewBid(ctx context.Context, in *MsgNewBid, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) RevealBid(ctx context.Context, in *MsgRevealBid, opts ...grpc.CallOption) (*MsgRevealBidResponse, error) {
+	out := new(MsgRevealBidResponse)
+	err := c.cc.Invoke(ctx, "/fatal_fruit.auction.v1.Msg/RevealBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Exec(ctx context.Context, in *MsgExecAuction, opts ...grpc.CallOption) (*MsgExecAuctionResponse, error) {
 	out := new(MsgExecAuctionResponse)
 	err := c.cc.Invoke(ctx, "/fatal_fruit.auction.v1.Msg/Exec", in, out, opts...)
@@ -663,16 +904,31 @@ func (c *msgClient) Exec(ctx context.Context, in *MsgExecAuction, opts ...grpc.C
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/fatal_fruit.auction.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// NewAuction creates a new auction.
 	NewAuction(context.Context, *MsgNewAuction) (*MsgNewAuctionResponse, error)
 	// StartAuction initializes the auction
 	StartAuction(context.Context, *MsgStartAuction) (*MsgStartAuctionResponse, error)
+	// CancelAuction cancels an active auction that has not received any bids.
+	CancelAuction(context.Context, *MsgCancelAuction) (*MsgCancelAuctionResponse, error)
 	// NewBid places a new bid on an auction.
 	NewBid(context.Context, *MsgNewBid) (*MsgNewBidResponse, error)
+	// RevealBid reveals a sealed bid during the reveal phase of a sealed-bid auction.
+	RevealBid(context.Context, *MsgRevealBid) (*MsgRevealBidResponse, error)
 	// Exec executes an auction, distributing funds and finalizing the auction.
 	Exec(context.Context, *MsgExecAuction) (*MsgExecAuctionResponse, error)
+	// UpdateParams updates the module parameters. It can only be called by the module authority.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -685,12 +941,21 @@ func (*UnimplementedMsgServer) NewAuction(ctx context.Context, req *MsgNewAuctio
 func (*UnimplementedMsgServer) StartAuction(ctx context.Context, req *MsgStartAuction) (*MsgStartAuctionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartAuction not implemented")
 }
+func (*UnimplementedMsgServer) CancelAuction(ctx context.Context, req *MsgCancelAuction) (*MsgCancelAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAuction not implemented")
+}
 func (*UnimplementedMsgServer) NewBid(ctx context.Context, req *MsgNewBid) (*MsgNewBidResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NewBid not implemented")
 }
+func (*UnimplementedMsgServer) RevealBid(ctx context.Context, req *MsgRevealBid) (*MsgRevealBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealBid not implemented")
+}
 func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExecAuction) (*MsgExecAuctionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Exec not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -732,6 +997,24 @@ func _Msg_StartAuction_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fatal_fruit.auction.v1.Msg/CancelAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAuction(ctx, req.(*MsgCancelAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_NewBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgNewBid)
 	if err := dec(in); err != nil {
@@ -750,6 +1033,24 @@ func _Msg_NewBid_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RevealBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fatal_fruit.auction.v1.Msg/RevealBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealBid(ctx, req.(*MsgRevealBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgExecAuction)
 	if err := dec(in); err != nil {
@@ -768,6 +1069,24 @@ func _Msg_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fatal_fruit.auction.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "fatal_fruit.auction.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -780,14 +1099,26 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StartAuction",
 			Handler:    _Msg_StartAuction_Handler,
 		},
+		{
+			MethodName: "CancelAuction",
+			Handler:    _Msg_CancelAuction_Handler,
+		},
 		{
 			MethodName: "NewBid",
 			Handler:    _Msg_NewBid_Handler,
 		},
+		{
+			MethodName: "RevealBid",
+			Handler:    _Msg_RevealBid_Handler,
+		},
 		{
 			MethodName: "Exec",
 			Handler:    _Msg_Exec_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "fatal_fruit/auction/v1/tx.proto",
@@ -1080,6 +1411,81 @@ func (m *MsgNewBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRevealBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgExecAuction) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1138,17 +1544,80 @@ func (m *MsgExecAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
 func (m *MsgNewAuction) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1269,6 +1738,37 @@ func (m *MsgNewBidResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRevealBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevealBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgExecAuction) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1294,6 +1794,30 @@ func (m *MsgExecAuctionResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2075,6 +2599,224 @@ func (m *MsgNewBidResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRevealBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevealBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevealBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevealBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevealBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevealBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgExecAuction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2226,6 +2968,171 @@ func (m *MsgExecAuctionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
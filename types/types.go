@@ -14,13 +14,59 @@ type Auction interface {
 
 	GetId() uint64
 	GetType() string
+	GetOwner() string
+	GetStatus() AuctionStatus
 	GetAuctionMetadata() AuctionMetadata
 	SetOwner(owner sdk.AccAddress)
-	UpdateStatus(string)
+	GetDeposit() sdk.Coins
+	SetDeposit(deposit sdk.Coins)
+	GetDuration() time.Duration
+	// GetEndTime returns the time bidding ends. It moves when a bid extends or closes the
+	// auction.
+	GetEndTime() time.Time
+	// GetAutoExecute reports whether the EndBlocker executes the auction once it is pending.
+	GetAutoExecute() bool
+	SetAutoExecute(autoExecute bool)
+	// UpdateStatus sets the auction status. Status changes are validated and applied by the
+	// keeper, which moves the auction between queues to match.
+	UpdateStatus(AuctionStatus)
 	StartAuction(blockTime time.Time)
 	SubmitBid(blockTime time.Time, bidMsg *MsgNewBid) error
 	IsExpired(blockTime time.Time) bool
 	HasBids() bool
+	// NumBids returns the number of accepted bids. SubmitBid must increment it for
+	// every accepted bid, as it is used as the sequence of the next stored bid.
+	NumBids() uint64
+	GetLeadingBid() *Bid
+}
+
+// ClosingAuction is implemented by auctions that close as soon as a bid is accepted
+// rather than at the end of their duration. Closed auctions skip the expired queue
+// and are pushed straight to the pending queue.
+type ClosingAuction interface {
+	Auction
+	IsClosed() bool
+}
+
+// DepositAuction is implemented by auctions that place requirements on the deposit, such
+// as batch auctions, which sell the units of their deposit.
+type DepositAuction interface {
+	Auction
+	ValidateDeposit(deposit sdk.Coins) error
+}
+
+// MinimumBidAuction is implemented by auctions whose lowest acceptable bid moves with the
+// bids already placed.
+type MinimumBidAuction interface {
+	Auction
+	NextMinimumBid() sdk.Coin
+}
+
+// RevealingAuction is implemented by sealed-bid auctions, which have a reveal phase
+// between the end of bidding and expiry. IsExpired reports the end of the reveal phase.
+type RevealingAuction interface {
+	Auction
+	IsBiddingClosed(blockTime time.Time) bool
 }
 
 type AuctionMetadata interface {
@@ -31,6 +77,24 @@ type BidMetadata interface {
 	proto.Message
 }
 
+// CollateralizedBid is implemented by bid metadata that locks collateral in place of a
+// disclosed bid amount, such as a sealed bid.
+type CollateralizedBid interface {
+	BidMetadata
+	GetCollateral() sdk.Coin
+}
+
+var _ types.UnpackInterfacesMessage = &Bid{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (b *Bid) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	if b.Data == nil {
+		return nil
+	}
+	var md BidMetadata
+	return unpacker.UnpackAny(b.Data, &md)
+}
+
 type AuctionResolver interface {
 	AddType(key string, h AuctionHandler) (rsv AuctionResolver)
 	HasType(key string) bool
@@ -53,7 +117,19 @@ func NewResolver() AuctionResolver {
 type AuctionHandler interface {
 	CreateAuction(ctx context.Context, id uint64, metadata AuctionMetadata) (Auction, error)
 	SubmitBid(ctx context.Context, auction Auction, bidMsg *MsgNewBid) (Auction, error)
-	ExecAuction(ctx context.Context, a Auction) error
+	ExecAuction(ctx context.Context, a Auction, bids []*Bid) error
+	// CancelAuction returns the funds escrowed for the bids to their bidders and releases
+	// anything left in escrow to the auction owner.
+	CancelAuction(ctx context.Context, a Auction, bids []*Bid) error
+}
+
+// RevealHandler is implemented by the handlers of auction types that accept sealed bids.
+type RevealHandler interface {
+	// RevealBid checks a reveal against the sealed bid it opens and returns the updated
+	// auction and bid.
+	RevealBid(ctx context.Context, a Auction, bid *Bid, revealMsg *MsgRevealBid) (Auction, *Bid, error)
+	// FinalizeReveal settles the sealed bids that were not revealed once the reveal phase ends.
+	FinalizeReveal(ctx context.Context, a Auction, bids []*Bid) error
 }
 
 // Seal seals the resolver which prohibits any additionsl auction types to be
@@ -6,7 +6,7 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	_ "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -32,6 +32,60 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AuctionStatus is the lifecycle stage of an auction. Auctions move between statuses only
+// along the transitions allowed by the module's status transition table.
+type AuctionStatus int32
+
+const (
+	// AUCTION_STATUS_UNSPECIFIED is an invalid status.
+	AUCTION_STATUS_UNSPECIFIED AuctionStatus = 0
+	// AUCTION_STATUS_CREATED is an auction that has not been started. It is not held in any
+	// queue.
+	AUCTION_STATUS_CREATED AuctionStatus = 1
+	// AUCTION_STATUS_ACTIVE is an auction accepting bids or, for sealed-bid auctions, reveals.
+	// It is held in the active or reveal queue.
+	AUCTION_STATUS_ACTIVE AuctionStatus = 2
+	// AUCTION_STATUS_EXPIRED is an auction whose bidding has ended. It is held in the expired
+	// queue until it is found to be executable or not.
+	AUCTION_STATUS_EXPIRED AuctionStatus = 3
+	// AUCTION_STATUS_PENDING is an auction with a winning bid waiting to be executed. It is
+	// held in the pending queue.
+	AUCTION_STATUS_PENDING AuctionStatus = 4
+	// AUCTION_STATUS_CANCELLED is an auction that was cancelled or ended without being
+	// executed. It is held in the cancelled queue.
+	AUCTION_STATUS_CANCELLED AuctionStatus = 5
+	// AUCTION_STATUS_SETTLED is an auction that was executed. It is not held in any queue.
+	AUCTION_STATUS_SETTLED AuctionStatus = 6
+)
+
+var AuctionStatus_name = map[int32]string{
+	0: "AUCTION_STATUS_UNSPECIFIED",
+	1: "AUCTION_STATUS_CREATED",
+	2: "AUCTION_STATUS_ACTIVE",
+	3: "AUCTION_STATUS_EXPIRED",
+	4: "AUCTION_STATUS_PENDING",
+	5: "AUCTION_STATUS_CANCELLED",
+	6: "AUCTION_STATUS_SETTLED",
+}
+
+var AuctionStatus_value = map[string]int32{
+	"AUCTION_STATUS_UNSPECIFIED": 0,
+	"AUCTION_STATUS_CREATED":     1,
+	"AUCTION_STATUS_ACTIVE":      2,
+	"AUCTION_STATUS_EXPIRED":     3,
+	"AUCTION_STATUS_PENDING":     4,
+	"AUCTION_STATUS_CANCELLED":   5,
+	"AUCTION_STATUS_SETTLED":     6,
+}
+
+func (x AuctionStatus) String() string {
+	return proto.EnumName(AuctionStatus_name, int32(x))
+}
+
+func (AuctionStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4feca4e902ee96b9, []int{0}
+}
+
 type OwnerAuctions struct {
 	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
 }
@@ -125,6 +179,8 @@ type Bid struct {
 	Bidder    string     `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
 	BidPrice  types.Coin `protobuf:"bytes,3,opt,name=bid_price,json=bidPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bid_price"`
 	Timestamp time.Time  `protobuf:"bytes,4,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	// data is the auction type specific bid metadata submitted with the bid.
+	Data *types1.Any `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
 }
 
 func (m *Bid) Reset()         { *m = Bid{} }
@@ -188,7 +244,15 @@ func (m *Bid) GetTimestamp() time.Time {
 	return time.Time{}
 }
 
+func (m *Bid) GetData() *types1.Any {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("fatal_fruit.auction.v1.AuctionStatus", AuctionStatus_name, AuctionStatus_value)
 	proto.RegisterType((*OwnerAuctions)(nil), "fatal_fruit.auction.v1.OwnerAuctions")
 	proto.RegisterType((*AuctionIds)(nil), "fatal_fruit.auction.v1.AuctionIds")
 	proto.RegisterType((*Bid)(nil), "fatal_fruit.auction.v1.Bid")
@@ -199,35 +263,45 @@ func init() {
 }
 
 var fileDescriptor_4feca4e902ee96b9 = []byte{
-	// 444 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x31, 0x6f, 0x13, 0x31,
-	0x18, 0x8d, 0x9b, 0xa8, 0x22, 0x86, 0x4a, 0x70, 0xaa, 0xd0, 0x25, 0x12, 0x4e, 0x88, 0x18, 0x4e,
-	0x95, 0x62, 0x13, 0xd8, 0x91, 0x7a, 0x48, 0x20, 0x26, 0x50, 0x60, 0x62, 0x39, 0xd9, 0x67, 0xe7,
-	0xb0, 0xc8, 0x9d, 0x23, 0xdb, 0x17, 0x94, 0x85, 0xdf, 0xd0, 0x99, 0x85, 0x15, 0x31, 0x75, 0xe0,
-	0x47, 0x74, 0xac, 0x98, 0x98, 0x28, 0x4a, 0x86, 0xfe, 0x0d, 0x74, 0xb6, 0x0f, 0x4a, 0xc5, 0x72,
-	0x67, 0x7f, 0xef, 0x3d, 0x7f, 0xcf, 0xef, 0x33, 0x9c, 0x2c, 0xa8, 0xa5, 0xcb, 0x6c, 0xa1, 0x6b,
-	0x69, 0x09, 0xad, 0x73, 0x2b, 0x55, 0x45, 0xd6, 0x33, 0x62, 0x37, 0x2b, 0x61, 0xf0, 0x4a, 0x2b,
-	0xab, 0xa2, 0xbb, 0x57, 0x38, 0x38, 0x70, 0xf0, 0x7a, 0x36, 0x1c, 0xe4, 0xca, 0x94, 0xca, 0x64,
-	0x8e, 0x45, 0xfc, 0xc6, 0x4b, 0x86, 0x87, 0x85, 0x2a, 0x94, 0xaf, 0x37, 0xab, 0x50, 0x45, 0x9e,
-	0x43, 0x18, 0x35, 0x82, 0xac, 0x67, 0x4c, 0x58, 0x3a, 0x23, 0xb9, 0x92, 0x55, 0xc0, 0xef, 0xd0,
-	0x52, 0x56, 0x8a, 0xb8, 0x6f, 0x28, 0x8d, 0x0a, 0xa5, 0x8a, 0xa5, 0x20, 0x6e, 0xc7, 0xea, 0x05,
-	0xb1, 0xb2, 0x14, 0xc6, 0xd2, 0x72, 0xd5, 0x9e, 0x79, 0x9d, 0xc0, 0x6b, 0x4d, 0x9d, 0x43, 0x8f,
-	0x0f, 0xae, 0xe3, 0xb4, 0xda, 0x78, 0x68, 0x72, 0x1f, 0x1e, 0xbc, 0xfc, 0x50, 0x09, 0x7d, 0xec,
-	0xaf, 0x64, 0xa2, 0xdb, 0xb0, 0x2b, 0xb9, 0x89, 0xc1, 0xb8, 0x9b, 0xf4, 0xe6, 0xcd, 0x72, 0x82,
-	0x20, 0x0c, 0xe8, 0x0b, 0xfe, 0x3f, 0xfc, 0xf3, 0x1e, 0xec, 0xa6, 0x92, 0x47, 0xf7, 0x20, 0x0c,
-	0xc1, 0x64, 0x92, 0xc7, 0x60, 0x0c, 0x92, 0xde, 0xbc, 0x4f, 0x5b, 0x65, 0xf4, 0x10, 0xee, 0x33,
-	0xc9, 0xb9, 0xd0, 0xf1, 0xde, 0x18, 0x24, 0xfd, 0x34, 0xfe, 0xfe, 0x6d, 0x7a, 0x18, 0x02, 0x3b,
-	0xe6, 0x5c, 0x0b, 0x63, 0x5e, 0x5b, 0x2d, 0xab, 0x62, 0x1e, 0x78, 0xd1, 0x47, 0xd8, 0x67, 0x92,
-	0x67, 0x2b, 0x2d, 0x73, 0x11, 0x77, 0xc7, 0x20, 0xb9, 0xf9, 0x68, 0x80, 0x83, 0xa2, 0x89, 0x0f,
-	0x87, 0xf8, 0xf0, 0x53, 0x25, 0xab, 0xf4, 0xd9, 0xd9, 0xcf, 0x51, 0xe7, 0xeb, 0xc5, 0x28, 0x29,
-	0xa4, 0x7d, 0x57, 0x33, 0x9c, 0xab, 0x32, 0xcc, 0x23, 0xfc, 0xa6, 0x86, 0xbf, 0x0f, 0x33, 0x6d,
-	0x04, 0xe6, 0xd3, 0xe5, 0xe9, 0xd1, 0xad, 0xa5, 0x28, 0x68, 0xbe, 0xc9, 0x9a, 0x01, 0x98, 0x2f,
-	0x97, 0xa7, 0x47, 0x60, 0x7e, 0x83, 0x49, 0xfe, 0xaa, 0x69, 0x19, 0x3d, 0x87, 0xfd, 0x3f, 0x49,
-	0xc7, 0x3d, 0xd7, 0x7f, 0x88, 0x7d, 0x94, 0xb8, 0x8d, 0x12, 0xbf, 0x69, 0x19, 0xe9, 0x41, 0x63,
-	0xe0, 0xe4, 0x62, 0x04, 0xfc, 0x39, 0x7f, 0xb5, 0xe9, 0x93, 0xb3, 0x2d, 0x02, 0xe7, 0x5b, 0x04,
-	0x7e, 0x6d, 0x11, 0x38, 0xd9, 0xa1, 0xce, 0xf9, 0x0e, 0x75, 0x7e, 0xec, 0x50, 0xe7, 0xed, 0x83,
-	0x2b, 0x66, 0xdd, 0x0b, 0x9b, 0xfe, 0xfb, 0x0a, 0x9d, 0x5d, 0xb6, 0xef, 0xba, 0x3d, 0xfe, 0x1d,
-	0x00, 0x00, 0xff, 0xff, 0x6b, 0x54, 0xba, 0x62, 0xa9, 0x02, 0x00, 0x00,
+	// 599 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x3f, 0x6f, 0xd3, 0x4c,
+	0x1c, 0xce, 0x35, 0x69, 0xf5, 0xe6, 0x5e, 0x2a, 0x19, 0xab, 0x54, 0x4e, 0x04, 0x4e, 0x88, 0x10,
+	0x8a, 0x2a, 0xc5, 0x26, 0xb0, 0x23, 0xd9, 0x89, 0x5b, 0x59, 0x2a, 0x69, 0x64, 0xbb, 0x08, 0xb1,
+	0x58, 0x67, 0x9f, 0x6b, 0x4e, 0x34, 0xbe, 0xc8, 0x77, 0x2e, 0xca, 0xc2, 0xcc, 0xd8, 0x99, 0x95,
+	0x05, 0x31, 0x75, 0xe8, 0x87, 0xa8, 0x98, 0x2a, 0x26, 0xa6, 0x16, 0xb5, 0x43, 0x67, 0xbe, 0x01,
+	0xb2, 0x7d, 0x81, 0x12, 0xb2, 0x24, 0x77, 0xf7, 0xfc, 0xf9, 0x9d, 0x9f, 0xc7, 0x86, 0x9d, 0x03,
+	0xc4, 0xd1, 0xa1, 0x7f, 0x90, 0x66, 0x84, 0xeb, 0x28, 0x0b, 0x39, 0xa1, 0x89, 0x7e, 0xd4, 0xd7,
+	0xf9, 0x6c, 0x1a, 0x31, 0x6d, 0x9a, 0x52, 0x4e, 0xe5, 0xcd, 0x5b, 0x1c, 0x4d, 0x70, 0xb4, 0xa3,
+	0x7e, 0xb3, 0x11, 0x52, 0x36, 0xa1, 0xcc, 0x2f, 0x58, 0x7a, 0xb9, 0x29, 0x25, 0xcd, 0x8d, 0x98,
+	0xc6, 0xb4, 0x3c, 0xcf, 0x57, 0xe2, 0x54, 0x2d, 0x39, 0x7a, 0x80, 0x58, 0xa4, 0x1f, 0xf5, 0x83,
+	0x88, 0xa3, 0xbe, 0x1e, 0x52, 0x92, 0x08, 0xfc, 0x2e, 0x9a, 0x90, 0x84, 0xea, 0xc5, 0xaf, 0x38,
+	0x6a, 0xc5, 0x94, 0xc6, 0x87, 0x91, 0x5e, 0xec, 0x82, 0xec, 0x40, 0xe7, 0x64, 0x12, 0x31, 0x8e,
+	0x26, 0xd3, 0xb9, 0xe7, 0x22, 0x01, 0x67, 0x29, 0x2a, 0x6e, 0x58, 0xe2, 0x8d, 0x45, 0x1c, 0x25,
+	0xb3, 0x12, 0xea, 0x3c, 0x84, 0xeb, 0x7b, 0xef, 0x92, 0x28, 0x35, 0xca, 0x47, 0x62, 0xb2, 0x04,
+	0xab, 0x04, 0x33, 0x05, 0xb4, 0xab, 0xdd, 0x9a, 0x93, 0x2f, 0x3b, 0x2a, 0x84, 0x02, 0xb5, 0xf1,
+	0x32, 0xfc, 0xe7, 0x0a, 0xac, 0x9a, 0x04, 0xcb, 0x0f, 0x20, 0x14, 0xc1, 0xf8, 0x04, 0x2b, 0xa0,
+	0x0d, 0xba, 0x35, 0xa7, 0x8e, 0xe6, 0x4a, 0xf9, 0x09, 0x5c, 0x0b, 0x08, 0xc6, 0x51, 0xaa, 0xac,
+	0xb4, 0x41, 0xb7, 0x6e, 0x2a, 0xdf, 0x4e, 0x7b, 0x1b, 0x22, 0x30, 0x03, 0xe3, 0x34, 0x62, 0xcc,
+	0xe5, 0x29, 0x49, 0x62, 0x47, 0xf0, 0xe4, 0xf7, 0xb0, 0x1e, 0x10, 0xec, 0x4f, 0x53, 0x12, 0x46,
+	0x4a, 0xb5, 0x0d, 0xba, 0xff, 0x3f, 0x6d, 0x68, 0x42, 0x91, 0xc7, 0xa7, 0x89, 0xf8, 0xb4, 0x01,
+	0x25, 0x89, 0xb9, 0x7d, 0x76, 0xd1, 0xaa, 0x7c, 0xb9, 0x6c, 0x75, 0x63, 0xc2, 0xdf, 0x64, 0x81,
+	0x16, 0xd2, 0x89, 0xe8, 0x43, 0xfc, 0xf5, 0x18, 0x7e, 0x2b, 0x3a, 0xcd, 0x05, 0xec, 0xe3, 0xcd,
+	0xc9, 0xd6, 0x9d, 0xc3, 0x28, 0x46, 0xe1, 0xcc, 0xcf, 0x0b, 0x60, 0x9f, 0x6f, 0x4e, 0xb6, 0x80,
+	0xf3, 0x5f, 0x40, 0xf0, 0x38, 0x1f, 0x29, 0xef, 0xc0, 0xfa, 0xef, 0xa4, 0x95, 0x5a, 0x31, 0xbf,
+	0xa9, 0x95, 0x51, 0x6a, 0xf3, 0x28, 0x35, 0x6f, 0xce, 0x30, 0xd7, 0xf3, 0x0b, 0x1c, 0x5f, 0xb6,
+	0x40, 0xe9, 0xf3, 0x47, 0x2b, 0x8f, 0x61, 0x0d, 0x23, 0x8e, 0x94, 0xd5, 0xc2, 0x63, 0xe3, 0x1f,
+	0x0f, 0x23, 0x99, 0x99, 0x8f, 0xbf, 0x9e, 0xf6, 0x3a, 0xcb, 0x5f, 0x32, 0xcd, 0x24, 0xf8, 0x45,
+	0xc4, 0x51, 0xee, 0xe1, 0x14, 0x4e, 0x5b, 0x17, 0x00, 0xae, 0x8b, 0x52, 0x5c, 0x8e, 0x78, 0xc6,
+	0x64, 0x15, 0x36, 0x8d, 0xfd, 0x81, 0x67, 0xef, 0x8d, 0x7c, 0xd7, 0x33, 0xbc, 0x7d, 0xd7, 0xdf,
+	0x1f, 0xb9, 0x63, 0x6b, 0x60, 0x6f, 0xdb, 0xd6, 0x50, 0xaa, 0xc8, 0x4d, 0xb8, 0xb9, 0x80, 0x0f,
+	0x1c, 0xcb, 0xf0, 0xac, 0xa1, 0x04, 0xe4, 0x06, 0xbc, 0xb7, 0x80, 0x19, 0x03, 0xcf, 0x7e, 0x69,
+	0x49, 0x2b, 0x4b, 0x64, 0xd6, 0xab, 0xb1, 0xed, 0x58, 0x43, 0xa9, 0xba, 0x04, 0x1b, 0x5b, 0xa3,
+	0xa1, 0x3d, 0xda, 0x91, 0x6a, 0xf2, 0x7d, 0xa8, 0x2c, 0x8e, 0x33, 0x46, 0x03, 0x6b, 0x77, 0xd7,
+	0x1a, 0x4a, 0xab, 0x4b, 0x94, 0xae, 0xe5, 0x79, 0x39, 0xb6, 0xd6, 0xac, 0x7d, 0xf8, 0xa4, 0x56,
+	0xcc, 0xe7, 0x67, 0x57, 0x2a, 0x38, 0xbf, 0x52, 0xc1, 0x8f, 0x2b, 0x15, 0x1c, 0x5f, 0xab, 0x95,
+	0xf3, 0x6b, 0xb5, 0xf2, 0xfd, 0x5a, 0xad, 0xbc, 0x7e, 0x74, 0xab, 0xdf, 0x22, 0xaf, 0xde, 0xdf,
+	0x1f, 0x6e, 0xd1, 0x70, 0xb0, 0x56, 0x84, 0xfb, 0xec, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xaf,
+	0x30, 0x7d, 0xbd, 0xdc, 0x03, 0x00, 0x00,
 }
 
 func (m *OwnerAuctions) Marshal() (dAtA []byte, err error) {
@@ -332,12 +406,24 @@ func (m *Bid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
-	if err5 != nil {
-		return 0, err5
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	i -= n5
-	i = encodeVarintTypes(dAtA, i, uint64(n5))
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTypes(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x22
 	{
@@ -425,6 +511,10 @@ func (m *Bid) Size() (n int) {
 	n += 1 + l + sovTypes(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
 	n += 1 + l + sovTypes(uint64(l))
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -832,6 +922,42 @@ func (m *Bid) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &types1.Any{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])